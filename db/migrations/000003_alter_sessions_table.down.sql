@@ -0,0 +1,14 @@
+DELETE FROM `sessions`;
+
+ALTER TABLE `sessions`
+DROP FOREIGN KEY `fk_sessions_account_id`;
+
+ALTER TABLE `sessions`
+DROP PRIMARY KEY,
+DROP INDEX `idx_sessions_account_id`,
+DROP COLUMN `id`,
+DROP COLUMN `user_agent`,
+DROP COLUMN `ip_address`,
+DROP COLUMN `created_at`,
+ADD PRIMARY KEY (`account_id`),
+ADD CONSTRAINT `fk_sessions_account_id` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE CASCADE ON DELETE CASCADE;
@@ -0,0 +1,12 @@
+ALTER TABLE `sessions`
+DROP FOREIGN KEY `fk_sessions_account_id`;
+
+ALTER TABLE `sessions`
+DROP PRIMARY KEY,
+ADD COLUMN `id` CHAR(36) NOT NULL DEFAULT (UUID()) COMMENT "ID" FIRST,
+ADD COLUMN `user_agent` VARCHAR(255) NOT NULL DEFAULT "" COMMENT "ユーザーエージェント" AFTER `token`,
+ADD COLUMN `ip_address` VARCHAR(45) NOT NULL DEFAULT "" COMMENT "IPアドレス" AFTER `user_agent`,
+ADD COLUMN `created_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日時",
+ADD PRIMARY KEY (`id`),
+ADD INDEX `idx_sessions_account_id` (`account_id`),
+ADD CONSTRAINT `fk_sessions_account_id` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE CASCADE ON DELETE CASCADE;
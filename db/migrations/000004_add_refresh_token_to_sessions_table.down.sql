@@ -0,0 +1,4 @@
+ALTER TABLE `sessions`
+DROP INDEX `uq_sessions_refresh_token`,
+DROP COLUMN `refresh_token`,
+DROP COLUMN `refresh_expires_at`;
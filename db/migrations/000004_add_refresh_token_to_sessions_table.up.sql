@@ -0,0 +1,10 @@
+ALTER TABLE `sessions`
+ADD COLUMN `refresh_token` CHAR(32) NULL COMMENT "リフレッシュトークン" AFTER `token`,
+ADD COLUMN `refresh_expires_at` DATETIME (6) NULL COMMENT "リフレッシュトークン有効期限" AFTER `expires_at`;
+
+UPDATE `sessions` SET `refresh_token` = REPLACE(UUID(), "-", ""), `refresh_expires_at` = `expires_at`;
+
+ALTER TABLE `sessions`
+MODIFY COLUMN `refresh_token` CHAR(32) NOT NULL COMMENT "リフレッシュトークン",
+MODIFY COLUMN `refresh_expires_at` DATETIME (6) NOT NULL COMMENT "リフレッシュトークン有効期限",
+ADD UNIQUE `uq_sessions_refresh_token` (`refresh_token`);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS `used_refresh_tokens` (
+  `token` CHAR(32) NOT NULL COMMENT "使用済みリフレッシュトークン",
+  `session_id` CHAR(36) NOT NULL COMMENT "セッションID",
+  `used_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "使用日時",
+  PRIMARY KEY (`token`),
+  CONSTRAINT `fk_used_refresh_tokens_session_id` FOREIGN KEY (`session_id`) REFERENCES `sessions` (`id`) ON UPDATE CASCADE ON DELETE CASCADE
+);
@@ -0,0 +1,8 @@
+DELETE FROM `sessions`;
+
+ALTER TABLE `used_refresh_tokens`
+MODIFY COLUMN `token` CHAR(32) NOT NULL COMMENT "使用済みリフレッシュトークン";
+
+ALTER TABLE `sessions`
+MODIFY COLUMN `token` CHAR(32) NOT NULL COMMENT "トークン",
+MODIFY COLUMN `refresh_token` CHAR(32) NOT NULL COMMENT "リフレッシュトークン";
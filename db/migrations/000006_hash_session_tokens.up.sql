@@ -0,0 +1,8 @@
+DELETE FROM `sessions`;
+
+ALTER TABLE `sessions`
+MODIFY COLUMN `token` CHAR(64) NOT NULL COMMENT "トークン(ハッシュ値)",
+MODIFY COLUMN `refresh_token` CHAR(64) NOT NULL COMMENT "リフレッシュトークン(ハッシュ値)";
+
+ALTER TABLE `used_refresh_tokens`
+MODIFY COLUMN `token` CHAR(64) NOT NULL COMMENT "使用済みリフレッシュトークン(ハッシュ値)";
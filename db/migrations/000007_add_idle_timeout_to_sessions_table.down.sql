@@ -0,0 +1,3 @@
+ALTER TABLE `sessions`
+DROP COLUMN `idle_timeout`,
+DROP COLUMN `last_used_at`;
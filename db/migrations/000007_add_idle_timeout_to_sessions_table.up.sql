@@ -0,0 +1,3 @@
+ALTER TABLE `sessions`
+ADD COLUMN `idle_timeout` INT UNSIGNED NOT NULL DEFAULT 7200 COMMENT "無操作タイムアウト(秒)" AFTER `ip_address`,
+ADD COLUMN `last_used_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "最終利用日時" AFTER `refresh_expires_at`;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS `login_failures` (
+  `target_type` VARCHAR(16) NOT NULL COMMENT "対象種別",
+  `target` VARCHAR(255) NOT NULL COMMENT "対象",
+  `count` INT NOT NULL COMMENT "失敗回数",
+  `locked_until` DATETIME (6) COMMENT "ロック解除日時",
+  `last_failed_at` DATETIME (6) NOT NULL COMMENT "最終失敗日時",
+  PRIMARY KEY (`target_type`, `target`)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE `accounts`
+MODIFY COLUMN `password` VARCHAR(60) NOT NULL COMMENT "パスワード";
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS `totps` (
+  `account_id` CHAR(36) NOT NULL COMMENT "アカウントID",
+  `secret` VARBINARY(64) NOT NULL COMMENT "暗号化されたシークレット",
+  `enabled` BOOLEAN NOT NULL DEFAULT FALSE COMMENT "有効化済みか",
+  `last_used_step` BIGINT NOT NULL DEFAULT 0 COMMENT "最後に使用したステップ",
+  `created_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日時",
+  `updated_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT "更新日時",
+  PRIMARY KEY (`account_id`),
+  CONSTRAINT `fk_totps_account_id` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE CASCADE ON DELETE CASCADE
+);
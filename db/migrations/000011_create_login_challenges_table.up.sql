@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `login_challenges` (
+  `id` CHAR(36) NOT NULL COMMENT "ID",
+  `account_id` CHAR(36) NOT NULL COMMENT "アカウントID",
+  `token` CHAR(64) NOT NULL COMMENT "トークン",
+  `remember_me` BOOLEAN NOT NULL DEFAULT FALSE COMMENT "ログイン状態を保持するか",
+  `attempts` INT NOT NULL DEFAULT 0 COMMENT "試行回数",
+  `expires_at` DATETIME (6) NOT NULL COMMENT "有効期限",
+  `created_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日時",
+  PRIMARY KEY (`id`),
+  UNIQUE `uq_login_challenges_token` (`token`),
+  INDEX `idx_login_challenges_account_id` (`account_id`),
+  CONSTRAINT `fk_login_challenges_account_id` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE CASCADE ON DELETE CASCADE
+);
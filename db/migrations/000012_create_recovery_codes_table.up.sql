@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `recovery_codes` (
+  `id` CHAR(36) NOT NULL COMMENT "ID",
+  `account_id` CHAR(36) NOT NULL COMMENT "アカウントID",
+  `code` CHAR(64) NOT NULL COMMENT "リカバリーコード",
+  `created_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日時",
+  PRIMARY KEY (`id`),
+  UNIQUE `uq_recovery_codes_account_id_code` (`account_id`, `code`),
+  CONSTRAINT `fk_recovery_codes_account_id` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE CASCADE ON DELETE CASCADE
+);
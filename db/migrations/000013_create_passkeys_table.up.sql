@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `passkeys` (
+  `id` CHAR(36) NOT NULL COMMENT "ID",
+  `account_id` CHAR(36) NOT NULL COMMENT "アカウントID",
+  `credential_id` VARBINARY(255) NOT NULL COMMENT "認証情報ID",
+  `public_key` VARBINARY(1024) NOT NULL COMMENT "公開鍵",
+  `sign_count` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT "署名カウンタ",
+  `name` VARCHAR(64) NOT NULL COMMENT "名前",
+  `last_used_at` DATETIME (6) NULL COMMENT "最終利用日時",
+  `created_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日時",
+  PRIMARY KEY (`id`),
+  UNIQUE `uq_passkeys_credential_id` (`credential_id`),
+  INDEX `idx_passkeys_account_id` (`account_id`),
+  CONSTRAINT `fk_passkeys_account_id` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE CASCADE ON DELETE CASCADE
+);
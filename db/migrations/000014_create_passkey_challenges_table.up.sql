@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `passkey_challenges` (
+  `id` CHAR(36) NOT NULL COMMENT "ID",
+  `account_id` CHAR(36) NULL COMMENT "アカウントID",
+  `purpose` VARCHAR(16) NOT NULL COMMENT "用途",
+  `challenge` CHAR(64) NOT NULL COMMENT "チャレンジ",
+  `expires_at` DATETIME (6) NOT NULL COMMENT "有効期限",
+  `created_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日時",
+  PRIMARY KEY (`id`),
+  UNIQUE `uq_passkey_challenges_challenge` (`challenge`),
+  INDEX `idx_passkey_challenges_account_id` (`account_id`),
+  CONSTRAINT `fk_passkey_challenges_account_id` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE CASCADE ON DELETE CASCADE
+);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS `account_name_tombstones` (
+  `name` VARCHAR(24) NOT NULL COMMENT "アカウント名",
+  `released_at` DATETIME (6) NOT NULL COMMENT "解放日時",
+  PRIMARY KEY (`name`),
+  INDEX `idx_account_name_tombstones_released_at` (`released_at`)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE `accounts`
+DROP INDEX `uq_accounts_email`,
+DROP COLUMN `email`;
@@ -0,0 +1,3 @@
+ALTER TABLE `accounts`
+ADD COLUMN `email` VARCHAR(254) NULL COMMENT "メールアドレス" AFTER `password`,
+ADD UNIQUE `uq_accounts_email` (`email`);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS `email_verifications` (
+  `account_id` CHAR(36) NOT NULL COMMENT "アカウントID",
+  `email` VARCHAR(254) NOT NULL COMMENT "確認待ちのメールアドレス",
+  `token` CHAR(64) NOT NULL COMMENT "確認トークン",
+  `expires_at` DATETIME (6) NOT NULL COMMENT "有効期限",
+  `created_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日時",
+  PRIMARY KEY (`account_id`),
+  UNIQUE `uq_email_verifications_token` (`token`),
+  INDEX `idx_email_verifications_expires_at` (`expires_at`),
+  CONSTRAINT `fk_email_verifications_account_id` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE CASCADE ON DELETE CASCADE
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS `password_resets` (
+  `account_id` CHAR(36) NOT NULL COMMENT "アカウントID",
+  `token` CHAR(64) NOT NULL COMMENT "再設定トークン",
+  `expires_at` DATETIME (6) NOT NULL COMMENT "有効期限",
+  `created_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日時",
+  PRIMARY KEY (`account_id`),
+  UNIQUE `uq_password_resets_token` (`token`),
+  INDEX `idx_password_resets_expires_at` (`expires_at`),
+  CONSTRAINT `fk_password_resets_account_id` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE CASCADE ON DELETE CASCADE
+);
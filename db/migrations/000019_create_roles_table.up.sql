@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS `roles` (
+  `id` CHAR(36) NOT NULL COMMENT "ID",
+  `name` VARCHAR(32) NOT NULL COMMENT "ロール名",
+  `created_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日時",
+  PRIMARY KEY (`id`),
+  UNIQUE `uq_roles_name` (`name`)
+);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS `role_permissions` (
+  `role_id` CHAR(36) NOT NULL COMMENT "ロールID",
+  `permission` VARCHAR(64) NOT NULL COMMENT "権限",
+  PRIMARY KEY (`role_id`, `permission`),
+  CONSTRAINT `fk_role_permissions_role_id` FOREIGN KEY (`role_id`) REFERENCES `roles` (`id`) ON UPDATE CASCADE ON DELETE CASCADE
+);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `account_roles` (
+  `account_id` CHAR(36) NOT NULL COMMENT "アカウントID",
+  `role_id` CHAR(36) NOT NULL COMMENT "ロールID",
+  `created_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日時",
+  PRIMARY KEY (`account_id`, `role_id`),
+  INDEX `idx_account_roles_role_id` (`role_id`),
+  CONSTRAINT `fk_account_roles_account_id` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE CASCADE ON DELETE CASCADE,
+  CONSTRAINT `fk_account_roles_role_id` FOREIGN KEY (`role_id`) REFERENCES `roles` (`id`) ON UPDATE CASCADE ON DELETE CASCADE
+);
@@ -0,0 +1,5 @@
+INSERT INTO `roles` (`id`, `name`) VALUES ("00000000-0000-0000-0000-000000000001", "admin");
+
+INSERT INTO `role_permissions` (`role_id`, `permission`) VALUES
+("00000000-0000-0000-0000-000000000001", "accounts:read"),
+("00000000-0000-0000-0000-000000000001", "accounts:write");
@@ -0,0 +1,2 @@
+ALTER TABLE `accounts`
+DROP INDEX `idx_accounts_created_at_id`;
@@ -0,0 +1,2 @@
+ALTER TABLE `accounts`
+ADD INDEX `idx_accounts_created_at_id` (`created_at`, `id`);
@@ -0,0 +1,2 @@
+ALTER TABLE `sessions`
+ADD COLUMN `issued_at` DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "トークン発行日時" AFTER `refresh_expires_at`;
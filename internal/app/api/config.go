@@ -1,15 +1,98 @@
 package api
 
-import "os"
+import (
+	stderr "errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrSessionTokenSecretNotSet     = stderr.New("SESSION_TOKEN_SECRET is not set")
+	ErrInvalidSessionCookieSameSite = stderr.New("invalid SESSION_COOKIE_SAME_SITE: none requires SESSION_COOKIE_SECURE")
+	ErrInvalidPasswordLength        = stderr.New("invalid PASSWORD_MIN_LENGTH or PASSWORD_MAX_LENGTH: must satisfy min <= max <= 1024")
+	ErrInvalidPasswordHashAlgorithm = stderr.New("invalid PASSWORD_HASH_ALGORITHM: must be argon2id or bcrypt")
+	ErrInvalidBcryptCost            = stderr.New("invalid PASSWORD_BCRYPT_COST: must be between 4 and 31")
+	ErrInvalidIntrospectionClient   = stderr.New("invalid INTROSPECTION_CLIENTS: must be comma separated id:secret pairs")
+	ErrInvalidMailBackend           = stderr.New("invalid MAIL_BACKEND: must be smtp or file")
+	ErrMailSMTPHostNotSet           = stderr.New("MAIL_SMTP_HOST is not set")
+)
 
 type serverConfig struct {
-	database databaseConfig
+	database      databaseConfig
+	session       sessionConfig
+	password      passwordConfig
+	accessToken   accessTokenConfig
+	introspection introspectionConfig
+	login         loginConfig
+	totp          totpConfig
+	webauthn      webauthnConfig
+	mail          mailConfig
+	rateLimit     rateLimitConfig
+	job           jobConfig
+	admin         adminConfig
+	http          httpConfig
 }
 
-func loadServerConfig() *serverConfig {
-	return &serverConfig{
-		database: *loadDatabaseConfig(),
+func loadServerConfig() (*serverConfig, error) {
+	session, err := loadSessionConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := loadPasswordConfig()
+	if err != nil {
+		return nil, err
 	}
+
+	accessToken, err := loadAccessTokenConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	introspection, err := loadIntrospectionConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	login, err := loadLoginConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	mail, err := loadMailConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimit, err := loadRateLimitConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := loadJobConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return &serverConfig{
+		database:      *loadDatabaseConfig(),
+		session:       *session,
+		password:      *password,
+		accessToken:   *accessToken,
+		introspection: *introspection,
+		login:         *login,
+		totp:          *loadTOTPConfig(),
+		webauthn:      *loadWebAuthnConfig(),
+		mail:          *mail,
+		rateLimit:     *rateLimit,
+		job:           *job,
+		admin:         *loadAdminConfig(),
+		http:          *loadHTTPConfig(),
+	}, nil
 }
 
 type databaseConfig struct {
@@ -29,3 +112,519 @@ func loadDatabaseConfig() *databaseConfig {
 		Password: os.Getenv("MYSQL_PASSWORD"),
 	}
 }
+
+type sessionConfig struct {
+	TokenSecret           string
+	Lifetime              time.Duration
+	IdleTimeout           time.Duration
+	RememberMeLifetime    time.Duration
+	RememberMeIdleTimeout time.Duration
+	CacheTTL              time.Duration
+	CacheSize             int
+	CookieDomain          string
+	CookieSecure          bool
+	CookieSameSite        http.SameSite
+}
+
+func loadSessionConfig() (*sessionConfig, error) {
+	conf := &sessionConfig{
+		TokenSecret:  os.Getenv("SESSION_TOKEN_SECRET"),
+		CookieDomain: os.Getenv("SESSION_COOKIE_DOMAIN"),
+	}
+	if conf.TokenSecret == "" {
+		return nil, ErrSessionTokenSecretNotSet
+	}
+
+	var err error
+	if conf.Lifetime, err = getDurationEnv("SESSION_LIFETIME", time.Hour*24); err != nil {
+		return nil, err
+	}
+	if conf.IdleTimeout, err = getDurationEnv("SESSION_IDLE_TIMEOUT", time.Hour*2); err != nil {
+		return nil, err
+	}
+	if conf.RememberMeLifetime, err = getDurationEnv("SESSION_REMEMBER_ME_LIFETIME", time.Hour*24*30); err != nil {
+		return nil, err
+	}
+	if conf.RememberMeIdleTimeout, err = getDurationEnv("SESSION_REMEMBER_ME_IDLE_TIMEOUT", time.Hour*24*7); err != nil {
+		return nil, err
+	}
+	if conf.CacheTTL, err = getDurationEnv("SESSION_CACHE_TTL", time.Second*30); err != nil {
+		return nil, err
+	}
+	if conf.CacheSize, err = getIntEnv("SESSION_CACHE_SIZE", 10000, 1); err != nil {
+		return nil, err
+	}
+	if conf.CookieSecure, err = getBoolEnv("SESSION_COOKIE_SECURE", true); err != nil {
+		return nil, err
+	}
+	if conf.CookieSameSite, err = getSameSiteEnv("SESSION_COOKIE_SAME_SITE", http.SameSiteLaxMode); err != nil {
+		return nil, err
+	}
+	// NOTE: ブラウザはSecure属性のないSameSite=NoneのCookieを拒否するため, 起動時に設定の誤りとして扱う.
+	if conf.CookieSameSite == http.SameSiteNoneMode && !conf.CookieSecure {
+		return nil, ErrInvalidSessionCookieSameSite
+	}
+
+	return conf, nil
+}
+
+type passwordConfig struct {
+	MinLength                 int
+	MaxLength                 int
+	RequireUpper              bool
+	RequireLower              bool
+	RequireDigit              bool
+	RequireSymbol             bool
+	MinEntropy                float64
+	DenylistFile              string
+	DenylistFalsePositiveRate float64
+	HashAlgorithm             string
+	Argon2Memory              uint32
+	Argon2Iterations          uint32
+	Argon2Parallelism         uint8
+	BcryptCost                int
+}
+
+// NOTE: 文字数はNFKCで正規化した後の文字単位で数え, ハッシュ化の負荷を抑えるため最大長は1024以下とする.
+func loadPasswordConfig() (*passwordConfig, error) {
+	conf := &passwordConfig{
+		DenylistFile: os.Getenv("PASSWORD_DENYLIST_FILE"),
+	}
+
+	var err error
+	if conf.MinLength, err = getIntEnv("PASSWORD_MIN_LENGTH", 8, 1); err != nil {
+		return nil, err
+	}
+	if conf.MaxLength, err = getIntEnv("PASSWORD_MAX_LENGTH", 128, 1); err != nil {
+		return nil, err
+	}
+	if conf.MaxLength < conf.MinLength || 1024 < conf.MaxLength {
+		return nil, ErrInvalidPasswordLength
+	}
+	if conf.RequireUpper, err = getBoolEnv("PASSWORD_REQUIRE_UPPER", false); err != nil {
+		return nil, err
+	}
+	if conf.RequireLower, err = getBoolEnv("PASSWORD_REQUIRE_LOWER", false); err != nil {
+		return nil, err
+	}
+	if conf.RequireDigit, err = getBoolEnv("PASSWORD_REQUIRE_DIGIT", false); err != nil {
+		return nil, err
+	}
+	if conf.RequireSymbol, err = getBoolEnv("PASSWORD_REQUIRE_SYMBOL", false); err != nil {
+		return nil, err
+	}
+	if conf.MinEntropy, err = getFloatEnv("PASSWORD_MIN_ENTROPY", 30); err != nil {
+		return nil, err
+	}
+	if conf.DenylistFalsePositiveRate, err = getFloatEnv("PASSWORD_DENYLIST_FALSE_POSITIVE_RATE", 0.001); err != nil {
+		return nil, err
+	}
+	if err := loadPasswordHashConfig(conf); err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+// NOTE: Argon2idのメモリ量はKiB単位で指定する.
+func loadPasswordHashConfig(conf *passwordConfig) error {
+	conf.HashAlgorithm = os.Getenv("PASSWORD_HASH_ALGORITHM")
+	if conf.HashAlgorithm == "" {
+		conf.HashAlgorithm = passwordHashAlgorithmArgon2id
+	}
+	if conf.HashAlgorithm != passwordHashAlgorithmArgon2id && conf.HashAlgorithm != passwordHashAlgorithmBcrypt {
+		return ErrInvalidPasswordHashAlgorithm
+	}
+
+	var err error
+	if conf.Argon2Memory, err = getUint32Env("PASSWORD_ARGON2_MEMORY", 64*1024); err != nil {
+		return err
+	}
+	if conf.Argon2Iterations, err = getUint32Env("PASSWORD_ARGON2_ITERATIONS", 3); err != nil {
+		return err
+	}
+	if conf.Argon2Parallelism, err = getUint8Env("PASSWORD_ARGON2_PARALLELISM", 4); err != nil {
+		return err
+	}
+	if conf.BcryptCost, err = getIntEnv("PASSWORD_BCRYPT_COST", 10, 1); err != nil {
+		return err
+	}
+	if conf.BcryptCost < 4 || 31 < conf.BcryptCost {
+		return ErrInvalidBcryptCost
+	}
+
+	return nil
+}
+
+type accessTokenConfig struct {
+	KeyFiles []string
+	Issuer   string
+	Lifetime time.Duration
+}
+
+// NOTE: ACCESS_TOKEN_KEY_FILESはカンマ区切りで指定し, 先頭の鍵を署名に使用する.
+func loadAccessTokenConfig() (*accessTokenConfig, error) {
+	conf := &accessTokenConfig{
+		Issuer: os.Getenv("ACCESS_TOKEN_ISSUER"),
+	}
+	if conf.Issuer == "" {
+		conf.Issuer = "holos-account-api"
+	}
+
+	for _, keyFile := range strings.Split(os.Getenv("ACCESS_TOKEN_KEY_FILES"), ",") {
+		if keyFile = strings.TrimSpace(keyFile); keyFile != "" {
+			conf.KeyFiles = append(conf.KeyFiles, keyFile)
+		}
+	}
+
+	var err error
+	if conf.Lifetime, err = getDurationEnv("ACCESS_TOKEN_LIFETIME", time.Minute*5); err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+type introspectionConfig struct {
+	Clients map[string]string
+}
+
+// NOTE: INTROSPECTION_CLIENTSは`クライアントID:シークレット`をカンマ区切りで指定する.
+func loadIntrospectionConfig() (*introspectionConfig, error) {
+	conf := &introspectionConfig{
+		Clients: make(map[string]string),
+	}
+
+	for _, client := range strings.Split(os.Getenv("INTROSPECTION_CLIENTS"), ",") {
+		if client = strings.TrimSpace(client); client == "" {
+			continue
+		}
+
+		id, secret, ok := strings.Cut(client, ":")
+		if !ok || id == "" || secret == "" {
+			return nil, ErrInvalidIntrospectionClient
+		}
+		conf.Clients[id] = secret
+	}
+
+	return conf, nil
+}
+
+type loginConfig struct {
+	AccountThreshold int
+	IPThreshold      int
+	Lockout          time.Duration
+	MaxLockout       time.Duration
+	FailureWindow    time.Duration
+}
+
+// NOTE: 閾値に0を指定した場合は対象毎のログイン試行の制限を無効にする.
+func loadLoginConfig() (*loginConfig, error) {
+	var conf loginConfig
+
+	var err error
+	if conf.AccountThreshold, err = getIntEnv("LOGIN_ACCOUNT_THRESHOLD", 5, 0); err != nil {
+		return nil, err
+	}
+	if conf.IPThreshold, err = getIntEnv("LOGIN_IP_THRESHOLD", 20, 0); err != nil {
+		return nil, err
+	}
+	if conf.Lockout, err = getDurationEnv("LOGIN_LOCKOUT", time.Minute); err != nil {
+		return nil, err
+	}
+	if conf.MaxLockout, err = getDurationEnv("LOGIN_MAX_LOCKOUT", time.Hour); err != nil {
+		return nil, err
+	}
+	if conf.FailureWindow, err = getDurationEnv("LOGIN_FAILURE_WINDOW", time.Minute*15); err != nil {
+		return nil, err
+	}
+
+	return &conf, nil
+}
+
+type totpConfig struct {
+	Issuer string
+}
+
+func loadTOTPConfig() *totpConfig {
+	conf := totpConfig{
+		Issuer: os.Getenv("TOTP_ISSUER"),
+	}
+	if conf.Issuer == "" {
+		conf.Issuer = "holos"
+	}
+
+	return &conf
+}
+
+type webauthnConfig struct {
+	RPID    string
+	RPName  string
+	Origins []string
+}
+
+// NOTE: WEBAUTHN_ORIGINSはパスキーの操作を許可するオリジンをカンマ区切りで指定する.
+func loadWebAuthnConfig() *webauthnConfig {
+	conf := webauthnConfig{
+		RPID:   os.Getenv("WEBAUTHN_RP_ID"),
+		RPName: os.Getenv("WEBAUTHN_RP_NAME"),
+	}
+	if conf.RPID == "" {
+		conf.RPID = "localhost"
+	}
+	if conf.RPName == "" {
+		conf.RPName = "holos"
+	}
+
+	for _, origin := range strings.Split(os.Getenv("WEBAUTHN_ORIGINS"), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			conf.Origins = append(conf.Origins, origin)
+		}
+	}
+	if len(conf.Origins) == 0 {
+		conf.Origins = []string{"http://localhost"}
+	}
+
+	return &conf
+}
+
+type mailConfig struct {
+	Backend      string
+	From         string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	FileDir      string
+}
+
+// NOTE: MAIL_BACKENDにfileを指定した場合はメールを送信せず, MAIL_FILE_DIRへファイルとして書き出す.
+func loadMailConfig() (*mailConfig, error) {
+	conf := &mailConfig{
+		Backend:      os.Getenv("MAIL_BACKEND"),
+		From:         os.Getenv("MAIL_FROM"),
+		SMTPHost:     os.Getenv("MAIL_SMTP_HOST"),
+		SMTPPort:     os.Getenv("MAIL_SMTP_PORT"),
+		SMTPUsername: os.Getenv("MAIL_SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("MAIL_SMTP_PASSWORD"),
+		FileDir:      os.Getenv("MAIL_FILE_DIR"),
+	}
+	if conf.Backend == "" {
+		conf.Backend = mailBackendFile
+	}
+	if conf.From == "" {
+		conf.From = "noreply@localhost"
+	}
+	if conf.SMTPPort == "" {
+		conf.SMTPPort = "587"
+	}
+	if conf.FileDir == "" {
+		conf.FileDir = "tmp/mails"
+	}
+
+	switch conf.Backend {
+	case mailBackendSMTP:
+		if conf.SMTPHost == "" {
+			return nil, ErrMailSMTPHostNotSet
+		}
+	case mailBackendFile:
+	default:
+		return nil, ErrInvalidMailBackend
+	}
+
+	return conf, nil
+}
+
+type rateLimitConfig struct {
+	RedisURL      string
+	AccountLimit  int
+	AccountPeriod time.Duration
+	SessionLimit  int
+	SessionPeriod time.Duration
+}
+
+// NOTE: RATE_LIMIT_REDIS_URLが設定されていない場合はプロセス内にバケットを保持する.
+// 上限に0を指定した場合はルートグループ毎のレート制限を無効にする.
+func loadRateLimitConfig() (*rateLimitConfig, error) {
+	conf := &rateLimitConfig{
+		RedisURL: os.Getenv("RATE_LIMIT_REDIS_URL"),
+	}
+
+	var err error
+	if conf.AccountLimit, err = getIntEnv("RATE_LIMIT_ACCOUNTS_LIMIT", 30, 0); err != nil {
+		return nil, err
+	}
+	if conf.AccountPeriod, err = getDurationEnv("RATE_LIMIT_ACCOUNTS_PERIOD", time.Minute); err != nil {
+		return nil, err
+	}
+	if conf.SessionLimit, err = getIntEnv("RATE_LIMIT_SESSIONS_LIMIT", 60, 0); err != nil {
+		return nil, err
+	}
+	if conf.SessionPeriod, err = getDurationEnv("RATE_LIMIT_SESSIONS_PERIOD", time.Minute); err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+type jobConfig struct {
+	SessionPurgeInterval      time.Duration
+	AccountPurgeInterval      time.Duration
+	LoginFailurePurgeInterval time.Duration
+	AccountRetention          time.Duration
+	AccountNameCooldown       time.Duration
+}
+
+func loadJobConfig() (*jobConfig, error) {
+	var conf jobConfig
+
+	var err error
+	if conf.SessionPurgeInterval, err = getDurationEnv("JOB_SESSION_PURGE_INTERVAL", time.Hour); err != nil {
+		return nil, err
+	}
+	if conf.AccountPurgeInterval, err = getDurationEnv("JOB_ACCOUNT_PURGE_INTERVAL", time.Hour*24); err != nil {
+		return nil, err
+	}
+	if conf.LoginFailurePurgeInterval, err = getDurationEnv("JOB_LOGIN_FAILURE_PURGE_INTERVAL", time.Hour); err != nil {
+		return nil, err
+	}
+	if conf.AccountRetention, err = getDurationEnv("ACCOUNT_RETENTION", time.Hour*24*30); err != nil {
+		return nil, err
+	}
+	if conf.AccountNameCooldown, err = getDurationEnv("ACCOUNT_NAME_COOLDOWN", time.Hour*24*90); err != nil {
+		return nil, err
+	}
+
+	return &conf, nil
+}
+
+// NOTE: 管理者が存在しない場合のみ, 起動時にBootstrapAccountのアカウントへ管理者ロールを割り当てる.
+type adminConfig struct {
+	BootstrapAccount string
+}
+
+func loadAdminConfig() *adminConfig {
+	return &adminConfig{
+		BootstrapAccount: os.Getenv("ADMIN_BOOTSTRAP_ACCOUNT"),
+	}
+}
+
+type httpConfig struct {
+	TrustedProxies []string
+}
+
+// NOTE: TRUSTED_PROXIESは信頼するプロキシのIPアドレスまたはCIDRをカンマ区切りで指定する.
+// 未設定の場合はX-Forwarded-Forを信頼せず, 接続元のIPアドレスを送信元とする.
+func loadHTTPConfig() *httpConfig {
+	var conf httpConfig
+
+	for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			conf.TrustedProxies = append(conf.TrustedProxies, proxy)
+		}
+	}
+
+	return &conf
+}
+
+func getDurationEnv(key string, defaultValue time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid %s: must be positive", key)
+	}
+	return d, nil
+}
+
+func getIntEnv(key string, defaultValue, minValue int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	if n < minValue {
+		return 0, fmt.Errorf("invalid %s: must be at least %d", key, minValue)
+	}
+	return n, nil
+}
+
+func getUint32Env(key string, defaultValue uint32) (uint32, error) {
+	n, err := getUintEnv(key, uint64(defaultValue), 32)
+	return uint32(n), err
+}
+
+func getUint8Env(key string, defaultValue uint8) (uint8, error) {
+	n, err := getUintEnv(key, uint64(defaultValue), 8)
+	return uint8(n), err
+}
+
+func getUintEnv(key string, defaultValue uint64, bitSize int) (uint64, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	n, err := strconv.ParseUint(value, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("invalid %s: must be positive", key)
+	}
+	return n, nil
+}
+
+func getFloatEnv(key string, defaultValue float64) (float64, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	if f < 0 {
+		return 0, fmt.Errorf("invalid %s: must not be negative", key)
+	}
+	return f, nil
+}
+
+func getBoolEnv(key string, defaultValue bool) (bool, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return b, nil
+}
+
+func getSameSiteEnv(key string, defaultValue http.SameSite) (http.SameSite, error) {
+	switch value := os.Getenv(key); strings.ToLower(value) {
+	case "":
+		return defaultValue, nil
+	case "lax":
+		return http.SameSiteLaxMode, nil
+	case "strict":
+		return http.SameSiteStrictMode, nil
+	case "none":
+		return http.SameSiteNoneMode, nil
+	default:
+		return 0, fmt.Errorf("invalid %s: %s", key, value)
+	}
+}
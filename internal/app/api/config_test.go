@@ -0,0 +1,180 @@
+package api
+
+import (
+	stderr "errors"
+	"net/http"
+	"testing"
+)
+
+func TestLoadSessionConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		env            map[string]string
+		expectSameSite http.SameSite
+		expectError    error
+	}{
+		{
+			name:           "default same site",
+			env:            map[string]string{},
+			expectSameSite: http.SameSiteLaxMode,
+			expectError:    nil,
+		},
+		{
+			name:           "same site none with secure",
+			env:            map[string]string{"SESSION_COOKIE_SAME_SITE": "none", "SESSION_COOKIE_SECURE": "true"},
+			expectSameSite: http.SameSiteNoneMode,
+			expectError:    nil,
+		},
+		{
+			name:        "same site none without secure",
+			env:         map[string]string{"SESSION_COOKIE_SAME_SITE": "none", "SESSION_COOKIE_SECURE": "false"},
+			expectError: ErrInvalidSessionCookieSameSite,
+		},
+		{
+			name:           "same site lax without secure",
+			env:            map[string]string{"SESSION_COOKIE_SAME_SITE": "lax", "SESSION_COOKIE_SECURE": "false"},
+			expectSameSite: http.SameSiteLaxMode,
+			expectError:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SESSION_TOKEN_SECRET", "secret")
+			t.Setenv("SESSION_COOKIE_SAME_SITE", "")
+			t.Setenv("SESSION_COOKIE_SECURE", "")
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			conf, err := loadSessionConfig()
+			if !stderr.Is(err, tt.expectError) {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectError, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if conf.CookieSameSite != tt.expectSameSite {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectSameSite, conf.CookieSameSite)
+			}
+		})
+	}
+}
+
+func TestLoadLoginConfig(t *testing.T) {
+	tests := []struct {
+		name                   string
+		env                    map[string]string
+		expectAccountThreshold int
+		expectIPThreshold      int
+		expectError            bool
+	}{
+		{
+			name:                   "default thresholds",
+			env:                    map[string]string{},
+			expectAccountThreshold: 5,
+			expectIPThreshold:      20,
+			expectError:            false,
+		},
+		{
+			name:                   "zero thresholds",
+			env:                    map[string]string{"LOGIN_ACCOUNT_THRESHOLD": "0", "LOGIN_IP_THRESHOLD": "0"},
+			expectAccountThreshold: 0,
+			expectIPThreshold:      0,
+			expectError:            false,
+		},
+		{
+			name:        "negative account threshold",
+			env:         map[string]string{"LOGIN_ACCOUNT_THRESHOLD": "-1"},
+			expectError: true,
+		},
+		{
+			name:        "negative ip threshold",
+			env:         map[string]string{"LOGIN_IP_THRESHOLD": "-1"},
+			expectError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("LOGIN_ACCOUNT_THRESHOLD", "")
+			t.Setenv("LOGIN_IP_THRESHOLD", "")
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			conf, err := loadLoginConfig()
+			if (err != nil) != tt.expectError {
+				t.Errorf("\nexpect error: %v\ngot: %v", tt.expectError, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if conf.AccountThreshold != tt.expectAccountThreshold {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectAccountThreshold, conf.AccountThreshold)
+			}
+			if conf.IPThreshold != tt.expectIPThreshold {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectIPThreshold, conf.IPThreshold)
+			}
+		})
+	}
+}
+
+func TestLoadRateLimitConfig(t *testing.T) {
+	tests := []struct {
+		name               string
+		env                map[string]string
+		expectAccountLimit int
+		expectSessionLimit int
+		expectError        bool
+	}{
+		{
+			name:               "default limits",
+			env:                map[string]string{},
+			expectAccountLimit: 30,
+			expectSessionLimit: 60,
+			expectError:        false,
+		},
+		{
+			name:               "zero limits",
+			env:                map[string]string{"RATE_LIMIT_ACCOUNTS_LIMIT": "0", "RATE_LIMIT_SESSIONS_LIMIT": "0"},
+			expectAccountLimit: 0,
+			expectSessionLimit: 0,
+			expectError:        false,
+		},
+		{
+			name:        "negative accounts limit",
+			env:         map[string]string{"RATE_LIMIT_ACCOUNTS_LIMIT": "-1"},
+			expectError: true,
+		},
+		{
+			name:        "negative sessions limit",
+			env:         map[string]string{"RATE_LIMIT_SESSIONS_LIMIT": "-1"},
+			expectError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("RATE_LIMIT_ACCOUNTS_LIMIT", "")
+			t.Setenv("RATE_LIMIT_SESSIONS_LIMIT", "")
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			conf, err := loadRateLimitConfig()
+			if (err != nil) != tt.expectError {
+				t.Errorf("\nexpect error: %v\ngot: %v", tt.expectError, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if conf.AccountLimit != tt.expectAccountLimit {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectAccountLimit, conf.AccountLimit)
+			}
+			if conf.SessionLimit != tt.expectSessionLimit {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectSessionLimit, conf.SessionLimit)
+			}
+		})
+	}
+}
@@ -2,29 +2,48 @@ package entity
 
 import (
 	stderr "errors"
+	"net/mail"
 	"regexp"
+	"slices"
+	"time"
 
 	"github.com/atsumarukun/holos-api-pkg/errors"
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/hasher"
 )
 
 var (
-	ErrAccountNameInvalidLength     = stderr.New("account name must be between 3 and 24 characters")
-	ErrAccountNameInvalidChars      = stderr.New("account name contains invalid characters")
-	ErrAccountPasswordMismatch      = stderr.New("passwords do not match")
-	ErrAccountPasswordInvalidLength = stderr.New("password must be between 8 and 72 characters")
-	ErrAccountPasswordInvalidChars  = stderr.New("password contains invalid characters")
-	ErrAccountPasswordIncorrect     = stderr.New("password is incorrect")
+	ErrAccountNameInvalidLength    = stderr.New("account name must be between 3 and 24 characters")
+	ErrAccountNameInvalidChars     = stderr.New("account name contains invalid characters")
+	ErrAccountPasswordMismatch     = stderr.New("passwords do not match")
+	ErrAccountPasswordInvalidChars = stderr.New("password contains invalid characters")
+	ErrAccountPasswordIncorrect    = stderr.New("password is incorrect")
+	ErrAccountEmailInvalidLength   = stderr.New("email must be at most 254 characters")
+	ErrAccountEmailInvalidFormat   = stderr.New("email is not a valid address")
 )
 
+var accountNamePattern = regexp.MustCompile(`^[A-Za-z0-9_]*$`)
+
+// NOTE: どの方式のハッシュとしても解釈できない値とし, 無効化したパスワードは常に誤りとして扱う.
+const invalidatedPassword = "!"
+
+// NOTE: メールアドレスは任意項目のため, 未設定の場合は空文字とする.
+// 更新日時は各項目の設定時に更新する.
+// ロールは割り当てを別テーブルで管理するため, 必要な場合のみ読み込む.
+// 削除日時は論理削除されていない場合はゼロ値とする.
 type Account struct {
-	ID       uuid.UUID
-	Name     string
-	Password string
+	ID        uuid.UUID
+	Name      string
+	Password  string
+	Email     string
+	Roles     []*Role
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt time.Time
 }
 
-func NewAccount(name, password, confirmPassword string) (*Account, error) {
+func NewAccount(name, password, confirmPassword string, policy PasswordPolicy, passwordHasher hasher.PasswordHasher) (*Account, error) {
 	var account Account
 
 	if err := account.generateID(); err != nil {
@@ -33,71 +52,82 @@ func NewAccount(name, password, confirmPassword string) (*Account, error) {
 	if err := account.SetName(name); err != nil {
 		return nil, err
 	}
-	if err := account.SetPassword(password, confirmPassword); err != nil {
+	if err := account.SetPassword(password, confirmPassword, policy, passwordHasher); err != nil {
 		return nil, err
 	}
+	account.CreatedAt = account.UpdatedAt
 
 	return &account, nil
 }
 
-func RestoreAccount(id uuid.UUID, name, password string) *Account {
+func RestoreAccount(id uuid.UUID, name, password, email string, createdAt, updatedAt, deletedAt time.Time) *Account {
 	return &Account{
-		ID:       id,
-		Name:     name,
-		Password: password,
+		ID:        id,
+		Name:      name,
+		Password:  password,
+		Email:     email,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+		DeletedAt: deletedAt,
 	}
 }
 
 func (a *Account) SetName(name string) error {
-	const errMessage = "failed to set account name"
-
-	if len(name) < 3 || 24 < len(name) {
-		return errors.Wrap(ErrAccountNameInvalidLength, errors.CodeInvalidInput, errMessage)
+	if err := ValidateAccountName(name); err != nil {
+		return errors.Wrap(err, errors.CodeInvalidInput, "failed to set account name")
 	}
 
-	if matched, err := regexp.MatchString(`^[A-Za-z0-9_]*$`, name); err != nil {
-		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
-	} else if !matched {
-		return errors.Wrap(ErrAccountNameInvalidChars, errors.CodeInvalidInput, errMessage)
+	a.Name = name
+	a.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// NOTE: 確認済みのメールアドレスのみを設定する.
+// 確認前のメールアドレスはEmailVerificationとして保持する.
+func (a *Account) SetEmail(email string) error {
+	if err := validateEmail(email); err != nil {
+		return errors.Wrap(err, errors.CodeInvalidInput, "failed to set account email")
 	}
 
-	a.Name = name
+	a.Email = email
+	a.UpdatedAt = time.Now()
 
 	return nil
 }
 
-func (a *Account) SetPassword(password, confirmation string) error {
+func (a *Account) SetPassword(password, confirmation string, policy PasswordPolicy, passwordHasher hasher.PasswordHasher) error {
 	const errMessage = "failed to set account password"
 
-	if password != confirmation {
+	password = NormalizePassword(password)
+	if password != NormalizePassword(confirmation) {
 		return errors.Wrap(ErrAccountPasswordMismatch, errors.CodeInvalidInput, errMessage)
 	}
 
-	if len(password) < 8 || 72 < len(password) {
-		return errors.Wrap(ErrAccountPasswordInvalidLength, errors.CodeInvalidInput, errMessage)
+	if err := policy.Validate(password); err != nil {
+		return errors.Wrap(err, errors.CodeInvalidInput, errMessage)
 	}
 
-	if matched, err := regexp.MatchString(`^[A-Za-z0-9!@#$%^&*()_\-+=\[\]{};:'",.<>?/\\|~]*$`, password); err != nil {
-		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
-	} else if !matched {
-		return errors.Wrap(ErrAccountPasswordInvalidChars, errors.CodeInvalidInput, errMessage)
-	}
-
-	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hashed, err := passwordHasher.Hash(password)
 	if err != nil {
 		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
 	}
 
-	a.Password = string(hashed)
+	a.Password = hashed
+	a.UpdatedAt = time.Now()
 
 	return nil
 }
 
-func (a *Account) VerifyPassword(password string) error {
+func (a *Account) VerifyPassword(password string, passwordHasher hasher.PasswordHasher) error {
 	const errMessage = "failed to verify account password"
 
-	if err := bcrypt.CompareHashAndPassword([]byte(a.Password), []byte(password)); err != nil {
-		if stderr.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+	if a.Password == invalidatedPassword {
+		return errors.Wrap(ErrAccountPasswordIncorrect, errors.CodeUnauthenticated, errMessage)
+	}
+
+	if err := passwordHasher.Verify(a.Password, NormalizePassword(password)); err != nil {
+		if stderr.Is(err, hasher.ErrMismatchedHashAndPassword) {
 			return errors.Wrap(ErrAccountPasswordIncorrect, errors.CodeUnauthenticated, errMessage)
 		}
 		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
@@ -105,6 +135,51 @@ func (a *Account) VerifyPassword(password string) error {
 	return nil
 }
 
+// NOTE: 管理者によるパスワード再設定の強制時に, 再設定するまでいずれのパスワードでも認証できないようにする.
+func (a *Account) InvalidatePassword() {
+	a.Password = invalidatedPassword
+	a.UpdatedAt = time.Now()
+}
+
+// NOTE: 検証済みのパスワードを受け取り, ハッシュが現在の方式やパラメータで生成されていない場合のみ再生成する.
+func (a *Account) RehashPassword(password string, passwordHasher hasher.PasswordHasher) (bool, error) {
+	if !passwordHasher.NeedsRehash(a.Password) {
+		return false, nil
+	}
+
+	hashed, err := passwordHasher.Hash(NormalizePassword(password))
+	if err != nil {
+		return false, errors.Wrap(err, errors.CodeInternalServerError, "failed to rehash account password")
+	}
+
+	a.Password = hashed
+	a.UpdatedAt = time.Now()
+
+	return true, nil
+}
+
+func (a *Account) HasPermission(permission Permission) bool {
+	for _, role := range a.Roles {
+		if role.HasPermission(permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// NOTE: 複数のロールに含まれる権限は1つにまとめ, ロールの順序で返す.
+func (a *Account) Permissions() []Permission {
+	var permissions []Permission
+	for _, role := range a.Roles {
+		for _, permission := range role.Permissions {
+			if !slices.Contains(permissions, permission) {
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+	return permissions
+}
+
 func (a *Account) generateID() error {
 	id, err := uuid.NewRandom()
 	if err != nil {
@@ -113,3 +188,28 @@ func (a *Account) generateID() error {
 	a.ID = id
 	return nil
 }
+
+// NOTE: アカウントを作成せずに名前の妥当性のみを判定する場合にも用いる.
+func ValidateAccountName(name string) error {
+	if len(name) < 3 || 24 < len(name) {
+		return ErrAccountNameInvalidLength
+	}
+	if !accountNamePattern.MatchString(name) {
+		return ErrAccountNameInvalidChars
+	}
+	return nil
+}
+
+// NOTE: 表示名やコメントを含む形式は受け付けず, アドレスのみを許容する.
+func validateEmail(email string) error {
+	if 254 < len(email) {
+		return ErrAccountEmailInvalidLength
+	}
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return ErrAccountEmailInvalidFormat
+	}
+
+	return nil
+}
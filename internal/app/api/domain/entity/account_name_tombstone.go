@@ -0,0 +1,40 @@
+package entity
+
+import (
+	stderr "errors"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+)
+
+var ErrAccountNameTombstoneNilAccount = stderr.New("account must not be nil")
+
+// NOTE: 削除したアカウントの名前は, 保持期間中は復元のために, 物理削除後はクールダウン期間中は成りすまし防止のために使用中とみなす.
+type AccountRetentionPolicy struct {
+	Retention    time.Duration
+	NameCooldown time.Duration
+}
+
+// NOTE: 物理削除したアカウントの名前を, クールダウン期間中に再登録されないよう記録する.
+type AccountNameTombstone struct {
+	Name       string
+	ReleasedAt time.Time
+}
+
+func NewAccountNameTombstone(account *Account) (*AccountNameTombstone, error) {
+	if account == nil {
+		return nil, errors.Wrap(ErrAccountNameTombstoneNilAccount, errors.CodeInternalServerError, "failed to initialize account name tombstone")
+	}
+
+	return &AccountNameTombstone{
+		Name:       account.Name,
+		ReleasedAt: time.Now(),
+	}, nil
+}
+
+func RestoreAccountNameTombstone(name string, releasedAt time.Time) *AccountNameTombstone {
+	return &AccountNameTombstone{
+		Name:       name,
+		ReleasedAt: releasedAt,
+	}
+}
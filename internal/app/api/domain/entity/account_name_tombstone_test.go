@@ -0,0 +1,42 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+)
+
+func TestNewAccountNameTombstone(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+
+	tests := []struct {
+		name         string
+		inputAccount *entity.Account
+		expectError  error
+	}{
+		{name: "successfully initialized", inputAccount: account, expectError: nil},
+		{name: "nil account", inputAccount: nil, expectError: entity.ErrAccountNameTombstoneNilAccount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tombstone, err := entity.NewAccountNameTombstone(tt.inputAccount)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectError == nil {
+				if tombstone.Name != account.Name {
+					t.Errorf("\nexpect: %v\ngot: %v", account.Name, tombstone.Name)
+				}
+				if tombstone.ReleasedAt.IsZero() {
+					t.Error("released at is not set")
+				}
+			}
+		})
+	}
+}
@@ -3,13 +3,31 @@ package entity_test
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/hasher"
 	"github.com/atsumarukun/holos-account-api/test/assert"
 )
 
+var (
+	passwordPolicy = entity.PasswordPolicy{
+		MinLength: 8,
+		MaxLength: 72,
+	}
+	argon2idParams = hasher.Argon2idParams{
+		Memory:      64,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+	passwordHasher = hasher.NewPasswordHasher(hasher.NewArgon2idHasher(argon2idParams), hasher.NewBcryptHasher(10))
+)
+
 func TestNewAccount(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -20,11 +38,11 @@ func TestNewAccount(t *testing.T) {
 	}{
 		{name: "successfully initialized", inputName: "name", inputPassword: "password", inputConfirmation: "password", expectError: nil},
 		{name: "invalid name", inputName: "", inputPassword: "password", inputConfirmation: "password", expectError: entity.ErrAccountNameInvalidLength},
-		{name: "invalid password", inputName: "name", inputPassword: "", inputConfirmation: "", expectError: entity.ErrAccountPasswordInvalidLength},
+		{name: "invalid password", inputName: "name", inputPassword: "", inputConfirmation: "", expectError: entity.ErrPasswordTooShort},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			account, err := entity.NewAccount(tt.inputName, tt.inputPassword, tt.inputConfirmation)
+			account, err := entity.NewAccount(tt.inputName, tt.inputPassword, tt.inputConfirmation, passwordPolicy, passwordHasher)
 			assert.Error(t, err, tt.expectError)
 
 			if tt.expectError == nil {
@@ -40,8 +58,11 @@ func TestNewAccount(t *testing.T) {
 					if account.Password == "" {
 						t.Error("password is not set")
 					}
-					if len(account.Password) != 60 {
-						t.Error("password length is not 60")
+					if err := account.VerifyPassword(tt.inputPassword, passwordHasher); err != nil {
+						t.Error("password is not hashed")
+					}
+					if account.CreatedAt.IsZero() || !account.CreatedAt.Equal(account.UpdatedAt) {
+						t.Errorf("\ncreated_at: %v\nupdated_at: %v", account.CreatedAt, account.UpdatedAt)
 					}
 				}
 			}
@@ -72,8 +93,48 @@ func TestAccount_SetName(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			account.UpdatedAt = time.Time{}
+
 			err := account.SetName(tt.inputName)
 			assert.Error(t, err, tt.expectError)
+
+			if (tt.expectError == nil) == account.UpdatedAt.IsZero() {
+				t.Errorf("unexpected updated_at: %v", account.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestAccount_SetEmail(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+
+	tests := []struct {
+		name        string
+		inputEmail  string
+		expectError error
+	}{
+		{name: "valid address", inputEmail: "name@example.com", expectError: nil},
+		{name: "include plus sign", inputEmail: "name+tag@example.com", expectError: nil},
+		{name: "empty", inputEmail: "", expectError: entity.ErrAccountEmailInvalidFormat},
+		{name: "without at sign", inputEmail: "example.com", expectError: entity.ErrAccountEmailInvalidFormat},
+		{name: "with display name", inputEmail: "Name <name@example.com>", expectError: entity.ErrAccountEmailInvalidFormat},
+		{name: "with surrounding spaces", inputEmail: " name@example.com ", expectError: entity.ErrAccountEmailInvalidFormat},
+		{name: "with line break", inputEmail: "name@example.com\r\nBcc: other@example.com", expectError: entity.ErrAccountEmailInvalidFormat},
+		{name: "254 characters", inputEmail: "a@" + strings.Repeat("b", 248) + ".com", expectError: nil},
+		{name: "255 characters", inputEmail: "a@" + strings.Repeat("b", 249) + ".com", expectError: entity.ErrAccountEmailInvalidLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := account.SetEmail(tt.inputEmail)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectError == nil && account.Email != tt.inputEmail {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.inputEmail, account.Email)
+			}
 		})
 	}
 }
@@ -96,16 +157,20 @@ func TestAccount_SetPassword(t *testing.T) {
 		{name: "number only", inputPassword: "12345678", inputConfirmation: "12345678", expectError: nil},
 		{name: "mixed lower case and upper case and number", inputPassword: "accountPassword1234", inputConfirmation: "accountPassword1234", expectError: nil},
 		{name: "all symbols", inputPassword: "!@#$%^&*()_-+=[]{};:'\",.<>?/|~", inputConfirmation: "!@#$%^&*()_-+=[]{};:'\",.<>?/|~", expectError: nil},
-		{name: "full-width characters", inputPassword: "認証パスワードぱすわーど", inputConfirmation: "認証パスワードぱすわーど", expectError: entity.ErrAccountPasswordInvalidChars},
-		{name: "7 characters", inputPassword: strings.Repeat("a", 7), inputConfirmation: strings.Repeat("a", 7), expectError: entity.ErrAccountPasswordInvalidLength},
+		{name: "japanese characters", inputPassword: "認証パスワードぱすわーど", inputConfirmation: "認証パスワードぱすわーど", expectError: nil},
+		{name: "passphrase with spaces", inputPassword: "correct horse battery staple", inputConfirmation: "correct horse battery staple", expectError: nil},
+		{name: "full-width latin normalized", inputPassword: "ｐａｓｓｗｏｒｄ", inputConfirmation: "password", expectError: nil},
+		{name: "control characters", inputPassword: "pass\tword", inputConfirmation: "pass\tword", expectError: entity.ErrAccountPasswordInvalidChars},
+		{name: "72 multi-byte characters", inputPassword: strings.Repeat("あ", 72), inputConfirmation: strings.Repeat("あ", 72), expectError: nil},
+		{name: "7 characters", inputPassword: strings.Repeat("a", 7), inputConfirmation: strings.Repeat("a", 7), expectError: entity.ErrPasswordTooShort},
 		{name: "8 characters", inputPassword: strings.Repeat("a", 8), inputConfirmation: strings.Repeat("a", 8), expectError: nil},
 		{name: "72 characters", inputPassword: strings.Repeat("a", 72), inputConfirmation: strings.Repeat("a", 72), expectError: nil},
-		{name: "73 characters", inputPassword: strings.Repeat("a", 73), inputConfirmation: strings.Repeat("a", 73), expectError: entity.ErrAccountPasswordInvalidLength},
+		{name: "73 characters", inputPassword: strings.Repeat("a", 73), inputConfirmation: strings.Repeat("a", 73), expectError: entity.ErrPasswordTooLong},
 		{name: "dose not matched", inputPassword: "password", inputConfirmation: "PASSWORD", expectError: entity.ErrAccountPasswordMismatch},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := account.SetPassword(tt.inputPassword, tt.inputConfirmation)
+			err := account.SetPassword(tt.inputPassword, tt.inputConfirmation, passwordPolicy, passwordHasher)
 			assert.Error(t, err, tt.expectError)
 
 			if tt.expectError == nil {
@@ -134,6 +199,11 @@ func TestAccount_VerifyPassword(t *testing.T) {
 			inputPassword: "password",
 			expectError:   nil,
 		},
+		{
+			name:          "successfully verified with normalization",
+			inputPassword: "ｐａｓｓｗｏｒｄ",
+			expectError:   nil,
+		},
 		{
 			name:          "faild",
 			inputPassword: "PASSWORD",
@@ -142,8 +212,128 @@ func TestAccount_VerifyPassword(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := account.VerifyPassword(tt.inputPassword)
+			err := account.VerifyPassword(tt.inputPassword, passwordHasher)
 			assert.Error(t, err, tt.expectError)
 		})
 	}
 }
+
+func TestAccount_InvalidatePassword(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+
+	account.InvalidatePassword()
+
+	err := account.VerifyPassword("password", passwordHasher)
+	assert.Error(t, err, entity.ErrAccountPasswordIncorrect)
+}
+
+func TestAccount_RehashPassword(t *testing.T) {
+	hashed, err := passwordHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		inputHash      string
+		expectRehashed bool
+	}{
+		{
+			name:           "legacy hash",
+			inputHash:      "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+			expectRehashed: true,
+		},
+		{
+			name:           "current hash",
+			inputHash:      hashed,
+			expectRehashed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := &entity.Account{
+				ID:       uuid.New(),
+				Name:     "name",
+				Password: tt.inputHash,
+			}
+
+			rehashed, err := account.RehashPassword("password", passwordHasher)
+			assert.Error(t, err, nil)
+
+			if rehashed != tt.expectRehashed {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectRehashed, rehashed)
+			}
+			if rehashed == (account.Password == tt.inputHash) {
+				t.Error("password hash is not updated as expected")
+			}
+			if err := account.VerifyPassword("password", passwordHasher); err != nil {
+				t.Error(err.Error())
+			}
+		})
+	}
+}
+
+func TestAccount_HasPermission(t *testing.T) {
+	tests := []struct {
+		name            string
+		inputRoles      []*entity.Role
+		inputPermission entity.Permission
+		expectResult    bool
+	}{
+		{
+			name: "has permission",
+			inputRoles: []*entity.Role{
+				{ID: uuid.New(), Name: "viewer", Permissions: []entity.Permission{entity.PermissionAccountsRead}},
+			},
+			inputPermission: entity.PermissionAccountsRead,
+			expectResult:    true,
+		},
+		{
+			name: "does not have permission",
+			inputRoles: []*entity.Role{
+				{ID: uuid.New(), Name: "viewer", Permissions: []entity.Permission{entity.PermissionAccountsRead}},
+			},
+			inputPermission: entity.PermissionAccountsWrite,
+			expectResult:    false,
+		},
+		{
+			name:            "no roles",
+			inputRoles:      nil,
+			inputPermission: entity.PermissionAccountsRead,
+			expectResult:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := &entity.Account{
+				ID:    uuid.New(),
+				Name:  "name",
+				Roles: tt.inputRoles,
+			}
+
+			if result := account.HasPermission(tt.inputPermission); result != tt.expectResult {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectResult, result)
+			}
+		})
+	}
+}
+
+func TestAccount_Permissions(t *testing.T) {
+	account := &entity.Account{
+		ID:   uuid.New(),
+		Name: "name",
+		Roles: []*entity.Role{
+			{ID: uuid.New(), Name: "admin", Permissions: []entity.Permission{entity.PermissionAccountsRead, entity.PermissionAccountsWrite}},
+			{ID: uuid.New(), Name: "viewer", Permissions: []entity.Permission{entity.PermissionAccountsRead}},
+		},
+	}
+
+	expect := []entity.Permission{entity.PermissionAccountsRead, entity.PermissionAccountsWrite}
+	if diff := cmp.Diff(expect, account.Permissions()); diff != "" {
+		t.Error(diff)
+	}
+}
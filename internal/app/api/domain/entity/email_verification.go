@@ -0,0 +1,60 @@
+package entity
+
+import (
+	stderr "errors"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+)
+
+var ErrEmailVerificationNilAccount = stderr.New("account must not be nil")
+
+const emailVerificationLifetime = time.Hour * 24
+
+// NOTE: 確認待ちのメールアドレスを表し, 確認されるまでアカウントのメールアドレスは変更しない.
+// アカウント毎に1件のみ保持し, 再度変更を要求した場合は上書きする.
+type EmailVerification struct {
+	AccountID uuid.UUID
+	Email     string
+	Token     string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func NewEmailVerification(account *Account, email string) (*EmailVerification, error) {
+	const errMessage = "failed to initialize email verification"
+
+	if account == nil {
+		return nil, errors.Wrap(ErrEmailVerificationNilAccount, errors.CodeInternalServerError, errMessage)
+	}
+
+	if err := validateEmail(email); err != nil {
+		return nil, errors.Wrap(err, errors.CodeInvalidInput, errMessage)
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	now := time.Now()
+
+	return &EmailVerification{
+		AccountID: account.ID,
+		Email:     email,
+		Token:     token,
+		ExpiresAt: now.Add(emailVerificationLifetime),
+		CreatedAt: now,
+	}, nil
+}
+
+func RestoreEmailVerification(accountID uuid.UUID, email, token string, expiresAt, createdAt time.Time) *EmailVerification {
+	return &EmailVerification{
+		AccountID: accountID,
+		Email:     email,
+		Token:     token,
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+	}
+}
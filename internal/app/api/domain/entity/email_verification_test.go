@@ -0,0 +1,55 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+)
+
+func TestNewEmailVerification(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		Email:    "old@example.com",
+	}
+
+	tests := []struct {
+		name         string
+		inputAccount *entity.Account
+		inputEmail   string
+		expectError  error
+	}{
+		{name: "successfully initialized", inputAccount: account, inputEmail: "new@example.com", expectError: nil},
+		{name: "nil account", inputAccount: nil, inputEmail: "new@example.com", expectError: entity.ErrEmailVerificationNilAccount},
+		{name: "invalid email", inputAccount: account, inputEmail: "example.com", expectError: entity.ErrAccountEmailInvalidFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verification, err := entity.NewEmailVerification(tt.inputAccount, tt.inputEmail)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectError == nil {
+				if verification.AccountID != account.ID {
+					t.Error("account id is not set")
+				}
+				if verification.Email != tt.inputEmail {
+					t.Errorf("\nexpect: %v\ngot: %v", tt.inputEmail, verification.Email)
+				}
+				if len(verification.Token) != 32 {
+					t.Error("token length is not 32")
+				}
+				if lifetime := verification.ExpiresAt.Sub(verification.CreatedAt); lifetime != time.Hour*24 {
+					t.Errorf("\nexpect: %v\ngot: %v", time.Hour*24, lifetime)
+				}
+				if account.Email != "old@example.com" {
+					t.Error("account email is changed")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package entity
+
+import (
+	stderr "errors"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+)
+
+var ErrLoginChallengeNilAccount = stderr.New("account must not be nil")
+
+const (
+	LoginChallengeMethodTOTP = "totp"
+
+	loginChallengeLifetime    = time.Minute * 5
+	loginChallengeMaxAttempts = 5
+)
+
+// NOTE: パスワード認証に成功し, 2要素目の認証を待っている状態のログインを表す.
+type LoginChallenge struct {
+	ID         uuid.UUID
+	AccountID  uuid.UUID
+	Token      string
+	RememberMe bool
+	Attempts   int
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
+
+func NewLoginChallenge(account *Account, rememberMe bool) (*LoginChallenge, error) {
+	const errMessage = "failed to initialize login challenge"
+
+	if account == nil {
+		return nil, errors.Wrap(ErrLoginChallengeNilAccount, errors.CodeInternalServerError, errMessage)
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	now := time.Now()
+
+	return &LoginChallenge{
+		ID:         id,
+		AccountID:  account.ID,
+		Token:      token,
+		RememberMe: rememberMe,
+		ExpiresAt:  now.Add(loginChallengeLifetime),
+		CreatedAt:  now,
+	}, nil
+}
+
+func RestoreLoginChallenge(id, accountID uuid.UUID, token string, rememberMe bool, attempts int, expiresAt, createdAt time.Time) *LoginChallenge {
+	return &LoginChallenge{
+		ID:         id,
+		AccountID:  accountID,
+		Token:      token,
+		RememberMe: rememberMe,
+		Attempts:   attempts,
+		ExpiresAt:  expiresAt,
+		CreatedAt:  createdAt,
+	}
+}
+
+// NOTE: 1つのチャレンジで総当たりされないよう, 失敗回数が上限に達したチャレンジは破棄する.
+func (c *LoginChallenge) RecordAttempt() {
+	c.Attempts++
+}
+
+func (c *LoginChallenge) Exhausted() bool {
+	return c.Attempts >= loginChallengeMaxAttempts
+}
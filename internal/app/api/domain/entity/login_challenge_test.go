@@ -0,0 +1,77 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+)
+
+func TestNewLoginChallenge(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+
+	tests := []struct {
+		name            string
+		inputAccount    *entity.Account
+		inputRememberMe bool
+		expectError     error
+	}{
+		{name: "successfully initialized", inputAccount: account, inputRememberMe: true, expectError: nil},
+		{name: "nil account", inputAccount: nil, inputRememberMe: false, expectError: entity.ErrLoginChallengeNilAccount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			challenge, err := entity.NewLoginChallenge(tt.inputAccount, tt.inputRememberMe)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectError == nil {
+				if challenge.ID == uuid.Nil {
+					t.Error("id is not set")
+				}
+				if challenge.AccountID != account.ID {
+					t.Error("account id is not set")
+				}
+				if len(challenge.Token) != 32 {
+					t.Error("token length is not 32")
+				}
+				if challenge.RememberMe != tt.inputRememberMe {
+					t.Error("remember me is not set")
+				}
+				if lifetime := challenge.ExpiresAt.Sub(challenge.CreatedAt); lifetime != time.Minute*5 {
+					t.Errorf("\nexpect: %v\ngot: %v", time.Minute*5, lifetime)
+				}
+			}
+		})
+	}
+}
+
+func TestLoginChallenge_RecordAttempt(t *testing.T) {
+	tests := []struct {
+		name            string
+		inputAttempts   int
+		expectExhausted bool
+	}{
+		{name: "first attempt", inputAttempts: 0, expectExhausted: false},
+		{name: "reached max attempts", inputAttempts: 4, expectExhausted: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			challenge := entity.RestoreLoginChallenge(uuid.New(), uuid.New(), "token", false, tt.inputAttempts, time.Now().Add(time.Minute), time.Now())
+			challenge.RecordAttempt()
+
+			if challenge.Attempts != tt.inputAttempts+1 {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.inputAttempts+1, challenge.Attempts)
+			}
+			if exhausted := challenge.Exhausted(); exhausted != tt.expectExhausted {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectExhausted, exhausted)
+			}
+		})
+	}
+}
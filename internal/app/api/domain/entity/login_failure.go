@@ -0,0 +1,99 @@
+package entity
+
+import (
+	stderr "errors"
+	"time"
+)
+
+var ErrLoginLocked = stderr.New("too many failed login attempts")
+
+const (
+	LoginFailureTargetAccount = "account"
+	LoginFailureTargetIP      = "ip"
+)
+
+type LoginLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginLockedError) Error() string {
+	return ErrLoginLocked.Error()
+}
+
+func (e *LoginLockedError) Unwrap() error {
+	return ErrLoginLocked
+}
+
+type LoginThrottlePolicy struct {
+	Threshold  int
+	Lockout    time.Duration
+	MaxLockout time.Duration
+	Window     time.Duration
+}
+
+type LoginFailure struct {
+	TargetType   string
+	Target       string
+	Count        int
+	LockedUntil  time.Time
+	LastFailedAt time.Time
+}
+
+func NewLoginFailure(targetType, target string) *LoginFailure {
+	return &LoginFailure{
+		TargetType: targetType,
+		Target:     target,
+	}
+}
+
+func RestoreLoginFailure(targetType, target string, count int, lockedUntil, lastFailedAt time.Time) *LoginFailure {
+	return &LoginFailure{
+		TargetType:   targetType,
+		Target:       target,
+		Count:        count,
+		LockedUntil:  lockedUntil,
+		LastFailedAt: lastFailedAt,
+	}
+}
+
+func (f *LoginFailure) RetryAfter() time.Duration {
+	if d := time.Until(f.LockedUntil); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// NOTE: 閾値を超えた失敗はロック期間を倍増させる.
+// 最終失敗日時またはロック解除日時から集計期間を経過した場合のみ失敗回数をリセットし, ロック解除直後の失敗でバックオフが戻らないようにする.
+func (f *LoginFailure) Record(policy LoginThrottlePolicy) {
+	now := time.Now()
+
+	since := f.LastFailedAt
+	if f.LockedUntil.After(since) {
+		since = f.LockedUntil
+	}
+	if now.Sub(since) > policy.Window {
+		f.Count = 0
+	}
+
+	f.Count++
+	f.LastFailedAt = now
+
+	if f.Count >= policy.Threshold {
+		f.LockedUntil = now.Add(policy.lockout(f.Count - policy.Threshold))
+	}
+}
+
+func (p LoginThrottlePolicy) lockout(exceeded int) time.Duration {
+	d := p.Lockout
+	for range exceeded {
+		if d >= p.MaxLockout {
+			break
+		}
+		d *= 2
+	}
+	if d > p.MaxLockout {
+		return p.MaxLockout
+	}
+	return d
+}
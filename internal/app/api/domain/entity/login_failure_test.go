@@ -0,0 +1,77 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+func TestLoginFailure_Record(t *testing.T) {
+	policy := entity.LoginThrottlePolicy{
+		Threshold:  3,
+		Lockout:    time.Minute,
+		MaxLockout: time.Minute * 5,
+		Window:     time.Minute * 15,
+	}
+
+	tests := []struct {
+		name              string
+		inputLoginFailure *entity.LoginFailure
+		expectCount       int
+		expectLockout     time.Duration
+	}{
+		{
+			name:              "first failure",
+			inputLoginFailure: entity.NewLoginFailure(entity.LoginFailureTargetAccount, "name"),
+			expectCount:       1,
+			expectLockout:     0,
+		},
+		{
+			name:              "reached threshold",
+			inputLoginFailure: entity.RestoreLoginFailure(entity.LoginFailureTargetAccount, "name", 2, time.Time{}, time.Now()),
+			expectCount:       3,
+			expectLockout:     time.Minute,
+		},
+		{
+			name:              "exceeded threshold",
+			inputLoginFailure: entity.RestoreLoginFailure(entity.LoginFailureTargetAccount, "name", 4, time.Now(), time.Now().Add(-time.Minute)),
+			expectCount:       5,
+			expectLockout:     time.Minute * 4,
+		},
+		{
+			name:              "max lockout",
+			inputLoginFailure: entity.RestoreLoginFailure(entity.LoginFailureTargetAccount, "name", 10, time.Now(), time.Now().Add(-time.Minute)),
+			expectCount:       11,
+			expectLockout:     time.Minute * 5,
+		},
+		{
+			name:              "window elapsed",
+			inputLoginFailure: entity.RestoreLoginFailure(entity.LoginFailureTargetAccount, "name", 10, time.Now().Add(-time.Minute*16), time.Now().Add(-time.Minute*20)),
+			expectCount:       1,
+			expectLockout:     0,
+		},
+		{
+			name:              "window not elapsed since lock",
+			inputLoginFailure: entity.RestoreLoginFailure(entity.LoginFailureTargetAccount, "name", 3, time.Now().Add(-time.Minute*10), time.Now().Add(-time.Minute*20)),
+			expectCount:       4,
+			expectLockout:     time.Minute * 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failure := tt.inputLoginFailure
+			failure.Record(policy)
+
+			if failure.Count != tt.expectCount {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCount, failure.Count)
+			}
+			if failure.LastFailedAt.IsZero() {
+				t.Error("last_failed_at is not set")
+			}
+			if lockout := failure.RetryAfter().Round(time.Second); lockout != tt.expectLockout {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectLockout, lockout)
+			}
+		})
+	}
+}
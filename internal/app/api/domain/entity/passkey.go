@@ -0,0 +1,109 @@
+package entity
+
+import (
+	stderr "errors"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
+)
+
+var (
+	ErrPasskeyNilAccount        = stderr.New("account must not be nil")
+	ErrPasskeyNilCredential     = stderr.New("credential must not be nil")
+	ErrPasskeyNameInvalidLength = stderr.New("passkey name must be between 1 and 64 characters")
+	ErrPasskeyNameInvalidChars  = stderr.New("passkey name contains invalid characters")
+	ErrPasskeySignCountInvalid  = stderr.New("passkey sign count did not increase")
+)
+
+const passkeyNameMaxLength = 64
+
+// NOTE: アカウントに登録されたWebAuthnの認証情報を表す. 公開鍵はCOSE形式で保持する.
+type Passkey struct {
+	ID           uuid.UUID
+	AccountID    uuid.UUID
+	CredentialID []byte
+	PublicKey    []byte
+	SignCount    uint32
+	Name         string
+	LastUsedAt   time.Time
+	CreatedAt    time.Time
+}
+
+func NewPasskey(account *Account, credential *webauthn.Credential, name string) (*Passkey, error) {
+	const errMessage = "failed to initialize passkey"
+
+	if account == nil {
+		return nil, errors.Wrap(ErrPasskeyNilAccount, errors.CodeInternalServerError, errMessage)
+	}
+	if credential == nil {
+		return nil, errors.Wrap(ErrPasskeyNilCredential, errors.CodeInternalServerError, errMessage)
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	passkey := &Passkey{
+		ID:           id,
+		AccountID:    account.ID,
+		CredentialID: credential.ID,
+		PublicKey:    credential.PublicKey,
+		SignCount:    credential.SignCount,
+		CreatedAt:    time.Now(),
+	}
+	if err := passkey.SetName(name); err != nil {
+		return nil, err
+	}
+
+	return passkey, nil
+}
+
+func RestorePasskey(id, accountID uuid.UUID, credentialID, publicKey []byte, signCount uint32, name string, lastUsedAt, createdAt time.Time) *Passkey {
+	return &Passkey{
+		ID:           id,
+		AccountID:    accountID,
+		CredentialID: credentialID,
+		PublicKey:    publicKey,
+		SignCount:    signCount,
+		Name:         name,
+		LastUsedAt:   lastUsedAt,
+		CreatedAt:    createdAt,
+	}
+}
+
+// NOTE: 一覧で識別するための名前のため, 前後の空白を除いた上で制御文字以外を許容する.
+func (p *Passkey) SetName(name string) error {
+	const errMessage = "failed to set passkey name"
+
+	name = strings.TrimSpace(name)
+	if length := utf8.RuneCountInString(name); length < 1 || passkeyNameMaxLength < length {
+		return errors.Wrap(ErrPasskeyNameInvalidLength, errors.CodeInvalidInput, errMessage)
+	}
+	if !utf8.ValidString(name) || strings.ContainsFunc(name, unicode.IsControl) {
+		return errors.Wrap(ErrPasskeyNameInvalidChars, errors.CodeInvalidInput, errMessage)
+	}
+
+	p.Name = name
+
+	return nil
+}
+
+// NOTE: 認証器の複製を検知するため, 署名カウンタを用いる認証器では値が増加していない場合に拒否する.
+// 署名カウンタを用いない認証器は常に0を返すため, 保存済みの値と共に0の場合は検証しない.
+func (p *Passkey) Use(signCount uint32) error {
+	if (signCount != 0 || p.SignCount != 0) && signCount <= p.SignCount {
+		return errors.Wrap(ErrPasskeySignCountInvalid, errors.CodeUnauthenticated, "failed to use passkey")
+	}
+
+	p.SignCount = signCount
+	p.LastUsedAt = time.Now()
+
+	return nil
+}
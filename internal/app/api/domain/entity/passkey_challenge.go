@@ -0,0 +1,80 @@
+package entity
+
+import (
+	stderr "errors"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+)
+
+var ErrPasskeyChallengeNilAccount = stderr.New("account must not be nil")
+
+const (
+	PasskeyChallengePurposeRegistration   = "registration"
+	PasskeyChallengePurposeAuthentication = "authentication"
+
+	passkeyChallengeLifetime = time.Minute * 5
+)
+
+// NOTE: パスキーの登録または認証の途中で, 認証器の応答を待っている状態を表す.
+// 認証時は認証器が返すユーザーハンドルからアカウントを特定するため, アカウントを持たない.
+type PasskeyChallenge struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	Purpose   string
+	Challenge string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func NewPasskeyRegistrationChallenge(account *Account) (*PasskeyChallenge, error) {
+	if account == nil {
+		return nil, errors.Wrap(ErrPasskeyChallengeNilAccount, errors.CodeInternalServerError, "failed to initialize passkey challenge")
+	}
+	return newPasskeyChallenge(account.ID, PasskeyChallengePurposeRegistration)
+}
+
+func NewPasskeyAuthenticationChallenge() (*PasskeyChallenge, error) {
+	return newPasskeyChallenge(uuid.Nil, PasskeyChallengePurposeAuthentication)
+}
+
+func RestorePasskeyChallenge(id, accountID uuid.UUID, purpose, challenge string, expiresAt, createdAt time.Time) *PasskeyChallenge {
+	return &PasskeyChallenge{
+		ID:        id,
+		AccountID: accountID,
+		Purpose:   purpose,
+		Challenge: challenge,
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+	}
+}
+
+func (c *PasskeyChallenge) Timeout() time.Duration {
+	return c.ExpiresAt.Sub(c.CreatedAt)
+}
+
+func newPasskeyChallenge(accountID uuid.UUID, purpose string) (*PasskeyChallenge, error) {
+	const errMessage = "failed to initialize passkey challenge"
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	challenge, err := generateToken()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	now := time.Now()
+
+	return &PasskeyChallenge{
+		ID:        id,
+		AccountID: accountID,
+		Purpose:   purpose,
+		Challenge: challenge,
+		ExpiresAt: now.Add(passkeyChallengeLifetime),
+		CreatedAt: now,
+	}, nil
+}
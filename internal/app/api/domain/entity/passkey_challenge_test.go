@@ -0,0 +1,69 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+)
+
+func TestNewPasskeyRegistrationChallenge(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+
+	tests := []struct {
+		name         string
+		inputAccount *entity.Account
+		expectError  error
+	}{
+		{name: "successfully initialized", inputAccount: account, expectError: nil},
+		{name: "nil account", inputAccount: nil, expectError: entity.ErrPasskeyChallengeNilAccount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			challenge, err := entity.NewPasskeyRegistrationChallenge(tt.inputAccount)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectError == nil {
+				if challenge.AccountID != account.ID {
+					t.Error("account id is not set")
+				}
+				if challenge.Purpose != entity.PasskeyChallengePurposeRegistration {
+					t.Errorf("\nexpect: %v\ngot: %v", entity.PasskeyChallengePurposeRegistration, challenge.Purpose)
+				}
+				if len(challenge.Challenge) != 32 {
+					t.Error("challenge length is not 32")
+				}
+				if timeout := challenge.Timeout(); timeout != time.Minute*5 {
+					t.Errorf("\nexpect: %v\ngot: %v", time.Minute*5, timeout)
+				}
+			}
+		})
+	}
+}
+
+func TestNewPasskeyAuthenticationChallenge(t *testing.T) {
+	challenge, err := entity.NewPasskeyAuthenticationChallenge()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if challenge.ID == uuid.Nil {
+		t.Error("id is not set")
+	}
+	if challenge.AccountID != uuid.Nil {
+		t.Error("account id is set")
+	}
+	if challenge.Purpose != entity.PasskeyChallengePurposeAuthentication {
+		t.Errorf("\nexpect: %v\ngot: %v", entity.PasskeyChallengePurposeAuthentication, challenge.Purpose)
+	}
+	if len(challenge.Challenge) != 32 {
+		t.Error("challenge length is not 32")
+	}
+}
@@ -0,0 +1,103 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+)
+
+func TestNewPasskey(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+	credential := &webauthn.Credential{
+		ID:        []byte("credential"),
+		PublicKey: []byte("public key"),
+		SignCount: 1,
+	}
+
+	tests := []struct {
+		name            string
+		inputAccount    *entity.Account
+		inputCredential *webauthn.Credential
+		inputName       string
+		expectName      string
+		expectError     error
+	}{
+		{name: "successfully initialized", inputAccount: account, inputCredential: credential, inputName: "laptop", expectName: "laptop", expectError: nil},
+		{name: "trimmed name", inputAccount: account, inputCredential: credential, inputName: "  laptop ", expectName: "laptop", expectError: nil},
+		{name: "nil account", inputAccount: nil, inputCredential: credential, inputName: "laptop", expectError: entity.ErrPasskeyNilAccount},
+		{name: "nil credential", inputAccount: account, inputCredential: nil, inputName: "laptop", expectError: entity.ErrPasskeyNilCredential},
+		{name: "empty name", inputAccount: account, inputCredential: credential, inputName: " ", expectError: entity.ErrPasskeyNameInvalidLength},
+		{name: "too long name", inputAccount: account, inputCredential: credential, inputName: strings.Repeat("a", 65), expectError: entity.ErrPasskeyNameInvalidLength},
+		{name: "control character", inputAccount: account, inputCredential: credential, inputName: "lap\ttop", expectError: entity.ErrPasskeyNameInvalidChars},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passkey, err := entity.NewPasskey(tt.inputAccount, tt.inputCredential, tt.inputName)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectError == nil {
+				if passkey.ID == uuid.Nil {
+					t.Error("id is not set")
+				}
+				if passkey.AccountID != account.ID {
+					t.Error("account id is not set")
+				}
+				if string(passkey.CredentialID) != string(credential.ID) {
+					t.Error("credential id is not set")
+				}
+				if passkey.SignCount != credential.SignCount {
+					t.Error("sign count is not set")
+				}
+				if passkey.Name != tt.expectName {
+					t.Errorf("\nexpect: %v\ngot: %v", tt.expectName, passkey.Name)
+				}
+				if !passkey.LastUsedAt.IsZero() {
+					t.Error("last used at is set")
+				}
+			}
+		})
+	}
+}
+
+func TestPasskey_Use(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputStored    uint32
+		inputSignCount uint32
+		expectError    error
+	}{
+		{name: "increased", inputStored: 1, inputSignCount: 2, expectError: nil},
+		{name: "counter not supported", inputStored: 0, inputSignCount: 0, expectError: nil},
+		{name: "not increased", inputStored: 2, inputSignCount: 2, expectError: entity.ErrPasskeySignCountInvalid},
+		{name: "decreased", inputStored: 2, inputSignCount: 1, expectError: entity.ErrPasskeySignCountInvalid},
+		{name: "reset", inputStored: 2, inputSignCount: 0, expectError: entity.ErrPasskeySignCountInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passkey := entity.RestorePasskey(uuid.New(), uuid.New(), []byte("credential"), []byte("public key"), tt.inputStored, "laptop", time.Time{}, time.Now())
+			err := passkey.Use(tt.inputSignCount)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectError == nil {
+				if passkey.SignCount != tt.inputSignCount {
+					t.Errorf("\nexpect: %v\ngot: %v", tt.inputSignCount, passkey.SignCount)
+				}
+				if passkey.LastUsedAt.IsZero() {
+					t.Error("last used at is not set")
+				}
+			} else if passkey.SignCount != tt.inputStored {
+				t.Error("sign count is updated")
+			}
+		})
+	}
+}
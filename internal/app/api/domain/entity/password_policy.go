@@ -0,0 +1,200 @@
+package entity
+
+import (
+	stderr "errors"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	ErrPasswordTooShort      = stderr.New("password is too short")
+	ErrPasswordTooLong       = stderr.New("password is too long")
+	ErrPasswordMissingUpper  = stderr.New("password must contain an upper case letter")
+	ErrPasswordMissingLower  = stderr.New("password must contain a lower case letter")
+	ErrPasswordMissingDigit  = stderr.New("password must contain a number")
+	ErrPasswordMissingSymbol = stderr.New("password must contain a symbol")
+	ErrPasswordTooWeak       = stderr.New("password is too weak")
+	ErrPasswordDenied        = stderr.New("password is too common")
+)
+
+const (
+	passwordSymbols = " !@#$%^&*()_-+=[]{};:'\",.<>?/\\|~`"
+	// NOTE: ローマ字, 数字, 記号以外の文字は文字種が膨大なため, 控えめな候補数として扱う.
+	passwordOtherCharsSize = 100
+)
+
+type PasswordDenylist interface {
+	Contains(string) bool
+}
+
+type PasswordPolicy struct {
+	MinLength     int
+	MaxLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	MinEntropy    float64
+	Denylist      PasswordDenylist
+}
+
+type PasswordPolicyError struct {
+	Violations []error
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return "password does not satisfy the policy"
+}
+
+func (e *PasswordPolicyError) Unwrap() []error {
+	return e.Violations
+}
+
+func (e *PasswordPolicyError) Details() []string {
+	details := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		details[i] = violation.Error()
+	}
+	return details
+}
+
+// NOTE: Unicode正規化形式NFKCで正規化し, 見た目が同じ文字列の表現の違いを吸収する.
+// ASCII文字列は正規化しても変化しないため, 既存のパスワードのハッシュはそのまま検証できる.
+func NormalizePassword(password string) string {
+	return norm.NFKC.String(password)
+}
+
+// NOTE: 最初の違反で打ち切らず, 全ての規則を検証した上で違反した規則を全て返す.
+func (p PasswordPolicy) Validate(password string) error {
+	var violations []error
+	violations = append(violations, p.validateLength(password)...)
+	violations = append(violations, p.validateClasses(password)...)
+	violations = append(violations, p.validateStrength(password)...)
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return &PasswordPolicyError{Violations: violations}
+}
+
+func (p PasswordPolicy) validateLength(password string) []error {
+	var violations []error
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength {
+		violations = append(violations, fmt.Errorf("%w: must be at least %d characters", ErrPasswordTooShort, p.MinLength))
+	}
+	if 0 < p.MaxLength && p.MaxLength < length {
+		violations = append(violations, fmt.Errorf("%w: must be at most %d characters", ErrPasswordTooLong, p.MaxLength))
+	}
+	return violations
+}
+
+func (p PasswordPolicy) validateClasses(password string) []error {
+	var violations []error
+	if !isPasswordPrintable(password) {
+		violations = append(violations, ErrAccountPasswordInvalidChars)
+	}
+
+	classes := passwordClassesOf(password)
+	for _, rule := range []struct {
+		required, satisfied bool
+		err                 error
+	}{
+		{p.RequireUpper, classes.upper, ErrPasswordMissingUpper},
+		{p.RequireLower, classes.lower, ErrPasswordMissingLower},
+		{p.RequireDigit, classes.digit, ErrPasswordMissingDigit},
+		{p.RequireSymbol, classes.symbol, ErrPasswordMissingSymbol},
+	} {
+		if rule.required && !rule.satisfied {
+			violations = append(violations, rule.err)
+		}
+	}
+	return violations
+}
+
+func (p PasswordPolicy) validateStrength(password string) []error {
+	var violations []error
+	if PasswordEntropy(password) < p.MinEntropy {
+		violations = append(violations, ErrPasswordTooWeak)
+	}
+	if p.Denylist != nil && p.Denylist.Contains(password) {
+		violations = append(violations, ErrPasswordDenied)
+	}
+	return violations
+}
+
+// NOTE: 使用されている文字種の総数を文字毎の候補数とし, 直前と同じ文字や連続する文字は推測が容易なため数えずにビット数を算出する.
+func PasswordEntropy(password string) float64 {
+	classes := passwordClassesOf(password)
+
+	var pool int
+	for _, class := range []struct {
+		used bool
+		size int
+	}{
+		{classes.upper, 26},
+		{classes.lower, 26},
+		{classes.digit, 10},
+		{classes.symbol, len(passwordSymbols)},
+		{classes.other, passwordOtherCharsSize},
+	} {
+		if class.used {
+			pool += class.size
+		}
+	}
+	if pool == 0 {
+		return 0
+	}
+
+	var length int
+	var prev rune
+	for i, r := range password {
+		if i == 0 || (r != prev && r != prev+1 && r != prev-1) {
+			length++
+		}
+		prev = r
+	}
+
+	return float64(length) * math.Log2(float64(pool))
+}
+
+type passwordClasses struct {
+	upper, lower, digit, symbol, other bool
+}
+
+func passwordClassesOf(password string) passwordClasses {
+	var classes passwordClasses
+	for _, r := range password {
+		switch {
+		case 'A' <= r && r <= 'Z':
+			classes.upper = true
+		case 'a' <= r && r <= 'z':
+			classes.lower = true
+		case '0' <= r && r <= '9':
+			classes.digit = true
+		case strings.ContainsRune(passwordSymbols, r):
+			classes.symbol = true
+		default:
+			classes.other = true
+		}
+	}
+	return classes
+}
+
+// NOTE: 空白を含む全ての印字可能な文字を許容し, 制御文字や不正なUTF-8のみ拒否する.
+func isPasswordPrintable(password string) bool {
+	if !utf8.ValidString(password) {
+		return false
+	}
+	for _, r := range password {
+		if unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,113 @@
+package entity_test
+
+import (
+	stderr "errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+type denylist map[string]struct{}
+
+func (d denylist) Contains(password string) bool {
+	_, ok := d[password]
+	return ok
+}
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	policy := entity.PasswordPolicy{
+		MinLength:     8,
+		MaxLength:     72,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		MinEntropy:    40,
+		Denylist:      denylist{"Passw0rd!": {}},
+	}
+
+	tests := []struct {
+		name          string
+		inputPassword string
+		expectErrors  []error
+	}{
+		{name: "satisfied", inputPassword: "Tr0ub4dor&3", expectErrors: nil},
+		{name: "too short", inputPassword: "Tr0ub&3", expectErrors: []error{entity.ErrPasswordTooShort}},
+		{name: "too long", inputPassword: "Tr0ub4dor&3" + strings.Repeat("x1", 31), expectErrors: []error{entity.ErrPasswordTooLong}},
+		{name: "multi-byte characters", inputPassword: "Tr0ub4dor&3" + strings.Repeat("あ", 61), expectErrors: nil},
+		{name: "control characters", inputPassword: "Tr0ub4dor&3\x00", expectErrors: []error{entity.ErrAccountPasswordInvalidChars}},
+		{name: "lower case only", inputPassword: "zzzzzzzzzz", expectErrors: []error{entity.ErrPasswordMissingUpper, entity.ErrPasswordMissingDigit, entity.ErrPasswordMissingSymbol, entity.ErrPasswordTooWeak}},
+		{name: "sequential characters", inputPassword: "Aa1!bcdefghijk", expectErrors: []error{entity.ErrPasswordTooWeak}},
+		{name: "denied", inputPassword: "Passw0rd!", expectErrors: []error{entity.ErrPasswordDenied}},
+		{name: "empty", inputPassword: "", expectErrors: []error{entity.ErrPasswordTooShort, entity.ErrPasswordMissingUpper, entity.ErrPasswordMissingLower, entity.ErrPasswordMissingDigit, entity.ErrPasswordMissingSymbol, entity.ErrPasswordTooWeak}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.inputPassword)
+
+			if tt.expectErrors == nil {
+				if err != nil {
+					t.Errorf("\nexpect: %v\ngot: %v", nil, err)
+				}
+				return
+			}
+
+			var policyErr *entity.PasswordPolicyError
+			if !stderr.As(err, &policyErr) {
+				t.Fatalf("\nexpect: %T\ngot: %v", policyErr, err)
+			}
+			if len(policyErr.Violations) != len(tt.expectErrors) {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectErrors, policyErr.Violations)
+			}
+			for _, expect := range tt.expectErrors {
+				if !stderr.Is(err, expect) {
+					t.Errorf("\nexpect: %v\ngot: %v", expect, err)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizePassword(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputPassword string
+		expectResult  string
+	}{
+		{name: "ascii", inputPassword: "Tr0ub4dor&3", expectResult: "Tr0ub4dor&3"},
+		{name: "full-width latin", inputPassword: "Ｔｒ０ｕｂ４ｄｏｒ＆３", expectResult: "Tr0ub4dor&3"},
+		{name: "half-width katakana", inputPassword: "ﾊﾟｽﾜｰﾄﾞ", expectResult: "パスワード"},
+		{name: "combining characters", inputPassword: "\u30cf\u309a", expectResult: "パ"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expectResult, entity.NormalizePassword(tt.inputPassword)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestPasswordEntropy(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputPassword string
+		expectLength  float64
+	}{
+		{name: "empty", inputPassword: "", expectLength: 0},
+		{name: "repeated characters", inputPassword: "aaaaaaaa", expectLength: 1},
+		{name: "sequential characters", inputPassword: "abcdefgh", expectLength: 1},
+		{name: "random characters", inputPassword: "qzmxanve", expectLength: 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lowerEntropy := entity.PasswordEntropy("z")
+			if diff := cmp.Diff(tt.expectLength*lowerEntropy, entity.PasswordEntropy(tt.inputPassword)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
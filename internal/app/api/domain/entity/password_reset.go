@@ -0,0 +1,53 @@
+package entity
+
+import (
+	stderr "errors"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+)
+
+var ErrPasswordResetNilAccount = stderr.New("account must not be nil")
+
+const passwordResetLifetime = time.Minute * 30
+
+// NOTE: パスワードを忘れたアカウントの再設定要求を表し, 確認済みのメールアドレス宛に送信したトークンで再設定を行う.
+// アカウント毎に1件のみ保持し, 再度要求した場合は上書きする.
+type PasswordReset struct {
+	AccountID uuid.UUID
+	Token     string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func NewPasswordReset(account *Account) (*PasswordReset, error) {
+	const errMessage = "failed to initialize password reset"
+
+	if account == nil {
+		return nil, errors.Wrap(ErrPasswordResetNilAccount, errors.CodeInternalServerError, errMessage)
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	now := time.Now()
+
+	return &PasswordReset{
+		AccountID: account.ID,
+		Token:     token,
+		ExpiresAt: now.Add(passwordResetLifetime),
+		CreatedAt: now,
+	}, nil
+}
+
+func RestorePasswordReset(accountID uuid.UUID, token string, expiresAt, createdAt time.Time) *PasswordReset {
+	return &PasswordReset{
+		AccountID: accountID,
+		Token:     token,
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+	}
+}
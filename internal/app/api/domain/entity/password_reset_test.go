@@ -0,0 +1,47 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+)
+
+func TestNewPasswordReset(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		Email:    "name@example.com",
+	}
+
+	tests := []struct {
+		name         string
+		inputAccount *entity.Account
+		expectError  error
+	}{
+		{name: "successfully initialized", inputAccount: account, expectError: nil},
+		{name: "nil account", inputAccount: nil, expectError: entity.ErrPasswordResetNilAccount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reset, err := entity.NewPasswordReset(tt.inputAccount)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectError == nil {
+				if reset.AccountID != account.ID {
+					t.Error("account id is not set")
+				}
+				if len(reset.Token) != 32 {
+					t.Error("token length is not 32")
+				}
+				if lifetime := reset.ExpiresAt.Sub(reset.CreatedAt); lifetime != time.Minute*30 {
+					t.Errorf("\nexpect: %v\ngot: %v", time.Minute*30, lifetime)
+				}
+			}
+		})
+	}
+}
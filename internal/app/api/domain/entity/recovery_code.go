@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"crypto/rand"
+	stderr "errors"
+	"strings"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRecoveryCodeNilAccount = stderr.New("account must not be nil")
+	ErrRecoveryCodeInvalid    = stderr.New("recovery code is invalid")
+)
+
+const (
+	LoginChallengeMethodRecoveryCode = "recovery_code"
+
+	recoveryCodeCount     = 10
+	recoveryCodeLength    = 10
+	recoveryCodeAlphabet  = "abcdefghijklmnopqrstuvwxyz234567"
+	recoveryCodeSeparator = "-"
+)
+
+// NOTE: 2要素目の認証手段を失った場合に代わりに使用する, 使い捨てのコードを表す.
+type RecoveryCode struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	Code      string
+	CreatedAt time.Time
+}
+
+func NewRecoveryCodes(account *Account) ([]*RecoveryCode, error) {
+	const errMessage = "failed to initialize recovery codes"
+
+	if account == nil {
+		return nil, errors.Wrap(ErrRecoveryCodeNilAccount, errors.CodeInternalServerError, errMessage)
+	}
+
+	now := time.Now()
+
+	codes := make([]*RecoveryCode, recoveryCodeCount)
+	for i := range codes {
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+		}
+
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+		}
+
+		codes[i] = &RecoveryCode{
+			ID:        id,
+			AccountID: account.ID,
+			Code:      code,
+			CreatedAt: now,
+		}
+	}
+
+	return codes, nil
+}
+
+func RestoreRecoveryCode(id, accountID uuid.UUID, code string, createdAt time.Time) *RecoveryCode {
+	return &RecoveryCode{
+		ID:        id,
+		AccountID: accountID,
+		Code:      code,
+		CreatedAt: createdAt,
+	}
+}
+
+// NOTE: 読み取りやすいよう, 表示時は5文字毎に区切る.
+func (c *RecoveryCode) Formatted() string {
+	half := len(c.Code) / 2
+	return c.Code[:half] + recoveryCodeSeparator + c.Code[half:]
+}
+
+// NOTE: 入力時の区切り文字や空白, 大文字小文字の違いは許容する.
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || strings.ContainsRune(recoveryCodeSeparator, r) {
+			return -1
+		}
+		return r
+	}, code)
+}
+
+func generateRecoveryCode() (string, error) {
+	buf := make([]byte, recoveryCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	for i, b := range buf {
+		buf[i] = recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)]
+	}
+
+	return string(buf), nil
+}
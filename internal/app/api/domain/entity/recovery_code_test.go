@@ -0,0 +1,86 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+)
+
+func TestNewRecoveryCodes(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+
+	tests := []struct {
+		name         string
+		inputAccount *entity.Account
+		expectError  error
+	}{
+		{name: "successfully initialized", inputAccount: account, expectError: nil},
+		{name: "nil account", inputAccount: nil, expectError: entity.ErrRecoveryCodeNilAccount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codes, err := entity.NewRecoveryCodes(tt.inputAccount)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectError == nil {
+				if len(codes) != 10 {
+					t.Errorf("\nexpect: %v\ngot: %v", 10, len(codes))
+				}
+
+				seen := make(map[string]struct{}, len(codes))
+				for _, code := range codes {
+					if code.ID == uuid.Nil {
+						t.Error("id is not set")
+					}
+					if code.AccountID != account.ID {
+						t.Error("account id is not set")
+					}
+					if len(code.Code) != 10 {
+						t.Errorf("\nexpect: %v\ngot: %v", 10, len(code.Code))
+					}
+					if entity.NormalizeRecoveryCode(code.Formatted()) != code.Code {
+						t.Errorf("formatted code is not normalized to original: %s", code.Formatted())
+					}
+					seen[code.Code] = struct{}{}
+				}
+				if len(seen) != len(codes) {
+					t.Error("codes are duplicated")
+				}
+			}
+		})
+	}
+}
+
+func TestRecoveryCode_Formatted(t *testing.T) {
+	code := &entity.RecoveryCode{Code: "abcde23456"}
+	if formatted := code.Formatted(); formatted != "abcde-23456" {
+		t.Errorf("\nexpect: %v\ngot: %v", "abcde-23456", formatted)
+	}
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCode    string
+		expectResult string
+	}{
+		{name: "formatted", inputCode: "abcde-23456", expectResult: "abcde23456"},
+		{name: "upper case", inputCode: "ABCDE-23456", expectResult: "abcde23456"},
+		{name: "with spaces", inputCode: " abcde 23456 ", expectResult: "abcde23456"},
+		{name: "not formatted", inputCode: "abcde23456", expectResult: "abcde23456"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := entity.NormalizeRecoveryCode(tt.inputCode); result != tt.expectResult {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectResult, result)
+			}
+		})
+	}
+}
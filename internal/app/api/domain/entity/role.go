@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+type Permission string
+
+const (
+	PermissionAccountsRead  Permission = "accounts:read"
+	PermissionAccountsWrite Permission = "accounts:write"
+)
+
+const RoleNameAdmin = "admin"
+
+// NOTE: ロールと権限はマイグレーションで登録し, アプリケーションからは作成しない.
+type Role struct {
+	ID          uuid.UUID
+	Name        string
+	Permissions []Permission
+}
+
+func RestoreRole(id uuid.UUID, name string, permissions []Permission) *Role {
+	return &Role{
+		ID:          id,
+		Name:        name,
+		Permissions: permissions,
+	}
+}
+
+func (r *Role) HasPermission(permission Permission) bool {
+	return slices.Contains(r.Permissions, permission)
+}
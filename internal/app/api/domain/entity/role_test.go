@@ -0,0 +1,33 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+func TestRole_HasPermission(t *testing.T) {
+	role := &entity.Role{
+		ID:          uuid.New(),
+		Name:        entity.RoleNameAdmin,
+		Permissions: []entity.Permission{entity.PermissionAccountsRead},
+	}
+
+	tests := []struct {
+		name            string
+		inputPermission entity.Permission
+		expectResult    bool
+	}{
+		{name: "has permission", inputPermission: entity.PermissionAccountsRead, expectResult: true},
+		{name: "does not have permission", inputPermission: entity.PermissionAccountsWrite, expectResult: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := role.HasPermission(tt.inputPermission); result != tt.expectResult {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectResult, result)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	stderr "errors"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 
@@ -16,49 +17,111 @@ var (
 	ErrSessionNilAccount         = stderr.New("account must not be nil")
 )
 
+const (
+	sessionTokenLifetime      = time.Minute * 15
+	sessionUserAgentMaxLength = 255
+	sessionIPAddressMaxLength = 45
+)
+
+type SessionPolicy struct {
+	Lifetime    time.Duration
+	IdleTimeout time.Duration
+}
+
 type Session struct {
-	AccountID uuid.UUID
-	Token     string
-	ExpiresAt time.Time
+	ID               uuid.UUID
+	AccountID        uuid.UUID
+	Token            string
+	RefreshToken     string
+	UserAgent        string
+	IPAddress        string
+	IdleTimeout      time.Duration
+	ExpiresAt        time.Time
+	RefreshExpiresAt time.Time
+	IssuedAt         time.Time
+	LastUsedAt       time.Time
+	CreatedAt        time.Time
 }
 
-func NewSession(account *Account) (*Session, error) {
+func NewSession(account *Account, policy SessionPolicy, userAgent, ipAddress string) (*Session, error) {
 	var session Session
 
+	if err := session.generateID(); err != nil {
+		return nil, err
+	}
 	if err := session.setAccount(account); err != nil {
 		return nil, err
 	}
+	session.setPolicy(policy)
 	if err := session.GenerateToken(); err != nil {
 		return nil, err
 	}
+	session.setClient(userAgent, ipAddress)
+	session.CreatedAt = time.Now()
 
 	return &session, nil
 }
 
-func RestoreSession(accountID uuid.UUID, token string, expiresAt time.Time) *Session {
+func RestoreSession(
+	id, accountID uuid.UUID,
+	token, refreshToken, userAgent, ipAddress string,
+	idleTimeout time.Duration,
+	expiresAt, refreshExpiresAt, issuedAt, lastUsedAt, createdAt time.Time,
+) *Session {
 	return &Session{
-		AccountID: accountID,
-		Token:     token,
-		ExpiresAt: expiresAt,
+		ID:               id,
+		AccountID:        accountID,
+		Token:            token,
+		RefreshToken:     refreshToken,
+		UserAgent:        userAgent,
+		IPAddress:        ipAddress,
+		IdleTimeout:      idleTimeout,
+		ExpiresAt:        expiresAt,
+		RefreshExpiresAt: refreshExpiresAt,
+		IssuedAt:         issuedAt,
+		LastUsedAt:       lastUsedAt,
+		CreatedAt:        createdAt,
 	}
 }
 
 func (s *Session) GenerateToken() error {
 	const errMessage = "failed to generate token"
 
-	buf := make([]byte, 24)
-	if _, err := rand.Read(buf); err != nil {
+	token, err := generateToken()
+	if err != nil {
 		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
 	}
 
-	token := base64.URLEncoding.WithPadding(base64.NoPadding).EncodeToString(buf)
-	if len(token) != 32 {
-		return errors.Wrap(ErrSessionTokenInvalidLength, errors.CodeInternalServerError, errMessage)
+	refreshToken, err := generateToken()
+	if err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
 	}
 
+	now := time.Now()
 	s.Token = token
-	s.ExpiresAt = time.Now().Add(time.Hour * 24 * 7)
+	s.RefreshToken = refreshToken
+	s.IssuedAt = now
+	s.LastUsedAt = now
+
+	// NOTE: リフレッシュトークンの有効期限はセッションの絶対的な有効期限のため, トークンの再発行では延長しない.
+	s.ExpiresAt = now.Add(sessionTokenLifetime)
+	if s.ExpiresAt.After(s.RefreshExpiresAt) {
+		s.ExpiresAt = s.RefreshExpiresAt
+	}
+
+	return nil
+}
+
+func (s *Session) Touch() {
+	s.LastUsedAt = time.Now()
+}
 
+func (s *Session) generateID() error {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, "failed to generate session id")
+	}
+	s.ID = id
 	return nil
 }
 
@@ -69,3 +132,35 @@ func (s *Session) setAccount(account *Account) error {
 	s.AccountID = account.ID
 	return nil
 }
+
+func (s *Session) setPolicy(policy SessionPolicy) {
+	s.IdleTimeout = policy.IdleTimeout
+	s.RefreshExpiresAt = time.Now().Add(policy.Lifetime)
+}
+
+// NOTE: クライアントから送信される値のため, 検証は行わずカラム長に収まるよう切り詰める.
+func (s *Session) setClient(userAgent, ipAddress string) {
+	s.UserAgent = truncate(userAgent, sessionUserAgentMaxLength)
+	s.IPAddress = truncate(ipAddress, sessionIPAddressMaxLength)
+}
+
+func generateToken() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	token := base64.URLEncoding.WithPadding(base64.NoPadding).EncodeToString(buf)
+	if len(token) != 32 {
+		return "", ErrSessionTokenInvalidLength
+	}
+
+	return token, nil
+}
+
+func truncate(s string, length int) string {
+	if utf8.RuneCountInString(s) <= length {
+		return s
+	}
+	return string([]rune(s)[:length])
+}
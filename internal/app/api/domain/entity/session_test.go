@@ -1,6 +1,7 @@
 package entity_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -17,32 +18,68 @@ func TestNewSession(t *testing.T) {
 		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
 	}
 
+	policy := entity.SessionPolicy{
+		Lifetime:    time.Hour * 24,
+		IdleTimeout: time.Hour,
+	}
+
 	tests := []struct {
-		name         string
-		inputAccount *entity.Account
-		expectError  error
+		name            string
+		inputAccount    *entity.Account
+		inputUserAgent  string
+		inputIPAddress  string
+		expectUserAgent string
+		expectError     error
 	}{
-		{name: "successfully initialized", inputAccount: account, expectError: nil},
-		{name: "account is nil", inputAccount: nil, expectError: entity.ErrSessionNilAccount},
+		{name: "successfully initialized", inputAccount: account, inputUserAgent: "Mozilla/5.0", inputIPAddress: "192.0.2.1", expectUserAgent: "Mozilla/5.0", expectError: nil},
+		{name: "long user agent", inputAccount: account, inputUserAgent: strings.Repeat("a", 256), inputIPAddress: "192.0.2.1", expectUserAgent: strings.Repeat("a", 255), expectError: nil},
+		{name: "account is nil", inputAccount: nil, inputUserAgent: "Mozilla/5.0", inputIPAddress: "192.0.2.1", expectError: entity.ErrSessionNilAccount},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			session, err := entity.NewSession(tt.inputAccount)
+			session, err := entity.NewSession(tt.inputAccount, policy, tt.inputUserAgent, tt.inputIPAddress)
 			assert.Error(t, err, tt.expectError)
 
 			if tt.expectError == nil {
 				if session == nil {
 					t.Error("session is nil")
 				} else {
+					if session.ID == uuid.Nil {
+						t.Error("id is not set")
+					}
 					if session.AccountID == uuid.Nil {
 						t.Error("account_id is not set")
 					}
 					if session.Token == "" {
 						t.Error("token is not set")
 					}
+					if session.UserAgent != tt.expectUserAgent {
+						t.Errorf("\nexpect: %v\ngot: %v", tt.expectUserAgent, session.UserAgent)
+					}
+					if session.IPAddress != tt.inputIPAddress {
+						t.Errorf("\nexpect: %v\ngot: %v", tt.inputIPAddress, session.IPAddress)
+					}
+					if session.RefreshToken == "" {
+						t.Error("refresh_token is not set")
+					}
 					if session.ExpiresAt.Before(time.Now()) {
 						t.Error("invalid expires_at")
 					}
+					if session.RefreshExpiresAt.Before(session.ExpiresAt) {
+						t.Error("invalid refresh_expires_at")
+					}
+					if session.IdleTimeout != policy.IdleTimeout {
+						t.Errorf("\nexpect: %v\ngot: %v", policy.IdleTimeout, session.IdleTimeout)
+					}
+					if session.IssuedAt.IsZero() {
+						t.Error("issued_at is not set")
+					}
+					if session.LastUsedAt.IsZero() {
+						t.Error("last_used_at is not set")
+					}
+					if session.CreatedAt.IsZero() {
+						t.Error("created_at is not set")
+					}
 				}
 			}
 		})
@@ -50,21 +87,29 @@ func TestNewSession(t *testing.T) {
 }
 
 func TestSession_GenerateToken(t *testing.T) {
-	session := &entity.Session{
-		AccountID: uuid.New(),
-		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
-		ExpiresAt: time.Now().Add(time.Hour * 24 * 7),
-	}
-
 	tests := []struct {
-		name        string
-		expectError error
+		name                  string
+		inputRefreshExpiresAt time.Time
+		expectError           error
 	}{
-		{name: "successfully generated", expectError: nil},
+		{name: "successfully generated", inputRefreshExpiresAt: time.Now().Add(time.Hour * 24 * 7), expectError: nil},
+		{name: "refresh token expires soon", inputRefreshExpiresAt: time.Now().Add(time.Minute), expectError: nil},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			session := &entity.Session{
+				ID:               uuid.New(),
+				AccountID:        uuid.New(),
+				Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+				RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+				ExpiresAt:        time.Now().Add(time.Minute * 15),
+				RefreshExpiresAt: tt.inputRefreshExpiresAt,
+				IssuedAt:         time.Now().Add(-time.Hour),
+			}
+
 			old := session.Token
+			oldIssuedAt := session.IssuedAt
+			oldRefresh := session.RefreshToken
 
 			err := session.GenerateToken()
 			assert.Error(t, err, tt.expectError)
@@ -75,6 +120,46 @@ func TestSession_GenerateToken(t *testing.T) {
 			if len(session.Token) != 32 {
 				t.Error("invalid token")
 			}
+			if session.RefreshToken == oldRefresh {
+				t.Error("refresh_token has not been updated")
+			}
+			if len(session.RefreshToken) != 32 {
+				t.Error("invalid refresh_token")
+			}
+			if session.ExpiresAt.After(tt.inputRefreshExpiresAt) {
+				t.Error("invalid expires_at")
+			}
+			if !session.RefreshExpiresAt.Equal(tt.inputRefreshExpiresAt) {
+				t.Error("refresh_expires_at has been updated")
+			}
+			if !session.IssuedAt.After(oldIssuedAt) {
+				t.Error("issued_at has not been updated")
+			}
+		})
+	}
+}
+
+func TestSession_Touch(t *testing.T) {
+	session := &entity.Session{
+		ID:         uuid.New(),
+		AccountID:  uuid.New(),
+		LastUsedAt: time.Now().Add(-time.Hour),
+	}
+
+	tests := []struct {
+		name string
+	}{
+		{name: "successfully touched"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := session.LastUsedAt
+
+			session.Touch()
+
+			if !session.LastUsedAt.After(old) {
+				t.Error("last_used_at has not been updated")
+			}
 		})
 	}
 }
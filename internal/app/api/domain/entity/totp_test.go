@@ -0,0 +1,144 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+)
+
+func TestNewTOTP(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+
+	tests := []struct {
+		name         string
+		inputAccount *entity.Account
+		expectError  error
+	}{
+		{name: "successfully initialized", inputAccount: account, expectError: nil},
+		{name: "nil account", inputAccount: nil, expectError: entity.ErrTOTPNilAccount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			totp, err := entity.NewTOTP(tt.inputAccount)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectError == nil {
+				if totp.AccountID != account.ID {
+					t.Error("account id is not set")
+				}
+				if len(totp.Secret) != 20 {
+					t.Error("secret length is not 20")
+				}
+				if totp.Enabled {
+					t.Error("totp is enabled before confirmation")
+				}
+			}
+		})
+	}
+}
+
+func TestTOTP_URI(t *testing.T) {
+	totp := entity.RestoreTOTP(uuid.New(), []byte("12345678901234567890"), false, 0)
+
+	expect := "otpauth://totp/holos:name?algorithm=SHA1&digits=6&issuer=holos&period=30&secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	if uri := totp.URI("holos", "name"); uri != expect {
+		t.Errorf("\nexpect: %v\ngot: %v", expect, uri)
+	}
+}
+
+func TestTOTP_Code(t *testing.T) {
+	totp := entity.RestoreTOTP(uuid.New(), []byte("12345678901234567890"), true, 0)
+
+	tests := []struct {
+		name       string
+		inputTime  time.Time
+		expectCode string
+	}{
+		{name: "59", inputTime: time.Unix(59, 0), expectCode: "287082"},
+		{name: "1111111109", inputTime: time.Unix(1111111109, 0), expectCode: "081804"},
+		{name: "1234567890", inputTime: time.Unix(1234567890, 0), expectCode: "005924"},
+		{name: "2000000000", inputTime: time.Unix(2000000000, 0), expectCode: "279037"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := totp.Code(tt.inputTime); code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, code)
+			}
+		})
+	}
+}
+
+func TestTOTP_Enable(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	code := entity.RestoreTOTP(uuid.Nil, secret, false, 0).Code(time.Now())
+
+	tests := []struct {
+		name        string
+		inputTOTP   *entity.TOTP
+		inputCode   string
+		expectError error
+	}{
+		{name: "successfully enabled", inputTOTP: entity.RestoreTOTP(uuid.New(), secret, false, 0), inputCode: code, expectError: nil},
+		{name: "already enabled", inputTOTP: entity.RestoreTOTP(uuid.New(), secret, true, 0), inputCode: code, expectError: entity.ErrTOTPAlreadyEnabled},
+		{name: "invalid code", inputTOTP: entity.RestoreTOTP(uuid.New(), secret, false, 0), inputCode: "abcdef", expectError: entity.ErrTOTPCodeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.inputTOTP.Enable(tt.inputCode)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectError == nil && !tt.inputTOTP.Enabled {
+				t.Error("totp is not enabled")
+			}
+		})
+	}
+}
+
+func TestTOTP_Verify(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	now := time.Now()
+	step := now.Unix() / 30
+	generator := entity.RestoreTOTP(uuid.Nil, secret, true, 0)
+
+	tests := []struct {
+		name        string
+		inputTOTP   *entity.TOTP
+		inputCode   string
+		expectError error
+	}{
+		{name: "current code", inputTOTP: entity.RestoreTOTP(uuid.New(), secret, true, 0), inputCode: generator.Code(now), expectError: nil},
+		{name: "previous code", inputTOTP: entity.RestoreTOTP(uuid.New(), secret, true, 0), inputCode: generator.Code(now.Add(-time.Second * 30)), expectError: nil},
+		{name: "expired code", inputTOTP: entity.RestoreTOTP(uuid.New(), secret, true, 0), inputCode: generator.Code(now.Add(-time.Second * 90)), expectError: entity.ErrTOTPCodeInvalid},
+		{name: "used code", inputTOTP: entity.RestoreTOTP(uuid.New(), secret, true, step), inputCode: generator.Code(now), expectError: entity.ErrTOTPCodeInvalid},
+		{name: "invalid code", inputTOTP: entity.RestoreTOTP(uuid.New(), secret, true, 0), inputCode: strings.Repeat("0", 7), expectError: entity.ErrTOTPCodeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.inputTOTP.Verify(tt.inputCode)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectError == nil && tt.inputTOTP.LastUsedStep == 0 {
+				t.Error("last used step is not set")
+			}
+		})
+	}
+}
+
+func TestTOTP_Verify_Replay(t *testing.T) {
+	totp := entity.RestoreTOTP(uuid.New(), []byte("12345678901234567890"), true, 0)
+	code := totp.Code(time.Now())
+
+	if err := totp.Verify(code); err != nil {
+		t.Fatal(err)
+	}
+	assert.Error(t, totp.Verify(code), entity.ErrTOTPCodeInvalid)
+}
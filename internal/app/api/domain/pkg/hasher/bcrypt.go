@@ -0,0 +1,90 @@
+package hasher
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	stderr "errors"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	bcryptSHA256Prefix = "$bcrypt-sha256$"
+	bcryptMaxLength    = 72
+)
+
+type bcryptHasher struct {
+	cost int
+}
+
+// NOTE: bcryptは72バイトを超える入力を切り捨てるため, SHA-256で事前にハッシュ化した値をbcryptでハッシュ化する.
+// 事前ハッシュ化を行わずに生成された旧形式のハッシュも検証できる.
+func NewBcryptHasher(cost int) PasswordHasher {
+	return &bcryptHasher{
+		cost: cost,
+	}
+}
+
+func (h *bcryptHasher) Hash(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword(prehashBcryptPassword(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return bcryptSHA256Prefix + string(hashed), nil
+}
+
+func (h *bcryptHasher) Verify(hash, password string) error {
+	switch {
+	case strings.HasPrefix(hash, bcryptSHA256Prefix):
+		return compareBcryptHash(strings.TrimPrefix(hash, bcryptSHA256Prefix), prehashBcryptPassword(password))
+	case isBcryptHash(hash):
+		// NOTE: 旧形式のハッシュは72バイト以下のパスワードからのみ生成されているため, 切り捨てにより一致することを防ぐ.
+		if bcryptMaxLength < len(password) {
+			return ErrMismatchedHashAndPassword
+		}
+		return compareBcryptHash(hash, []byte(password))
+	default:
+		return ErrUnsupportedHash
+	}
+}
+
+func (h *bcryptHasher) NeedsRehash(hash string) bool {
+	if !strings.HasPrefix(hash, bcryptSHA256Prefix) {
+		return true
+	}
+
+	cost, err := bcrypt.Cost([]byte(strings.TrimPrefix(hash, bcryptSHA256Prefix)))
+	if err != nil {
+		return true
+	}
+	return cost != h.cost
+}
+
+func prehashBcryptPassword(password string) []byte {
+	sum := sha256.Sum256([]byte(password))
+	return []byte(base64.StdEncoding.EncodeToString(sum[:]))
+}
+
+func compareBcryptHash(hash string, password []byte) error {
+	if !isBcryptHash(hash) {
+		return ErrInvalidHash
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), password); err != nil {
+		if stderr.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return ErrMismatchedHashAndPassword
+		}
+		return ErrInvalidHash
+	}
+	return nil
+}
+
+func isBcryptHash(hash string) bool {
+	for _, prefix := range []string{"$2a$", "$2b$", "$2y$"} {
+		if strings.HasPrefix(hash, prefix) {
+			return true
+		}
+	}
+	return false
+}
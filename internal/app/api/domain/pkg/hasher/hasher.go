@@ -0,0 +1,49 @@
+package hasher
+
+import stderr "errors"
+
+var (
+	ErrMismatchedHashAndPassword = stderr.New("hashed password is not the hash of the given password")
+	ErrUnsupportedHash           = stderr.New("unsupported password hash")
+	ErrInvalidHash               = stderr.New("invalid password hash")
+)
+
+// NOTE: Verifyは自身が扱えない形式のハッシュに対してErrUnsupportedHashを返す.
+// NeedsRehashはハッシュが現在の方式やパラメータで生成されていない場合にtrueを返す.
+type PasswordHasher interface {
+	Hash(string) (string, error)
+	Verify(string, string) error
+	NeedsRehash(string) bool
+}
+
+type passwordHasher struct {
+	primary PasswordHasher
+	legacy  []PasswordHasher
+}
+
+// NOTE: primaryでハッシュを生成し, primaryが扱えない形式のハッシュはlegacyで順に検証する.
+func NewPasswordHasher(primary PasswordHasher, legacy ...PasswordHasher) PasswordHasher {
+	return &passwordHasher{
+		primary: primary,
+		legacy:  legacy,
+	}
+}
+
+func (h *passwordHasher) Hash(password string) (string, error) {
+	return h.primary.Hash(password)
+}
+
+func (h *passwordHasher) Verify(hash, password string) error {
+	err := h.primary.Verify(hash, password)
+	for _, legacy := range h.legacy {
+		if !stderr.Is(err, ErrUnsupportedHash) {
+			break
+		}
+		err = legacy.Verify(hash, password)
+	}
+	return err
+}
+
+func (h *passwordHasher) NeedsRehash(hash string) bool {
+	return h.primary.NeedsRehash(hash)
+}
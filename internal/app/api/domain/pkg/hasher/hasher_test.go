@@ -0,0 +1,175 @@
+package hasher_test
+
+import (
+	stderr "errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/hasher"
+)
+
+const bcryptHash = "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK"
+
+var argon2idParams = hasher.Argon2idParams{
+	Memory:      64,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestArgon2idHasher_Verify(t *testing.T) {
+	argon2idHasher := hasher.NewArgon2idHasher(argon2idParams)
+
+	hashed, err := argon2idHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		inputHash     string
+		inputPassword string
+		expectError   error
+	}{
+		{name: "successfully verified", inputHash: hashed, inputPassword: "password", expectError: nil},
+		{name: "mismatched", inputHash: hashed, inputPassword: "PASSWORD", expectError: hasher.ErrMismatchedHashAndPassword},
+		{name: "bcrypt hash", inputHash: bcryptHash, inputPassword: "password", expectError: hasher.ErrUnsupportedHash},
+		{name: "invalid params", inputHash: "$argon2id$v=19$m=x,t=1,p=1$c2FsdA$a2V5", inputPassword: "password", expectError: hasher.ErrInvalidHash},
+		{name: "invalid version", inputHash: "$argon2id$v=16$m=64,t=1,p=1$c2FsdA$a2V5", inputPassword: "password", expectError: hasher.ErrInvalidHash},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := argon2idHasher.Verify(tt.inputHash, tt.inputPassword); !stderr.Is(err, tt.expectError) {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestArgon2idHasher_NeedsRehash(t *testing.T) {
+	argon2idHasher := hasher.NewArgon2idHasher(argon2idParams)
+
+	hashed, err := argon2idHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	weakParams := argon2idParams
+	weakParams.Memory = 32
+	weakHashed, err := hasher.NewArgon2idHasher(weakParams).Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		inputHash    string
+		expectResult bool
+	}{
+		{name: "current params", inputHash: hashed, expectResult: false},
+		{name: "outdated params", inputHash: weakHashed, expectResult: true},
+		{name: "bcrypt hash", inputHash: bcryptHash, expectResult: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := argon2idHasher.NeedsRehash(tt.inputHash); result != tt.expectResult {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectResult, result)
+			}
+		})
+	}
+}
+
+func TestBcryptHasher_Verify(t *testing.T) {
+	bcryptHasher := hasher.NewBcryptHasher(bcrypt.MinCost)
+
+	hashed, err := bcryptHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	longPassword := strings.Repeat("password", 16)
+	longHashed, err := bcryptHasher.Hash(longPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		inputHash     string
+		inputPassword string
+		expectError   error
+	}{
+		{name: "successfully verified", inputHash: hashed, inputPassword: "password", expectError: nil},
+		{name: "mismatched", inputHash: hashed, inputPassword: "PASSWORD", expectError: hasher.ErrMismatchedHashAndPassword},
+		{name: "longer than 72 bytes", inputHash: longHashed, inputPassword: longPassword, expectError: nil},
+		{name: "mismatched after 72 bytes", inputHash: longHashed, inputPassword: longPassword[:72] + "x", expectError: hasher.ErrMismatchedHashAndPassword},
+		{name: "legacy hash", inputHash: bcryptHash, inputPassword: "password", expectError: nil},
+		{name: "mismatched legacy hash", inputHash: bcryptHash, inputPassword: "PASSWORD", expectError: hasher.ErrMismatchedHashAndPassword},
+		{name: "legacy hash with truncated password", inputHash: bcryptHash, inputPassword: "password" + strings.Repeat("x", 72), expectError: hasher.ErrMismatchedHashAndPassword},
+		{name: "argon2id hash", inputHash: "$argon2id$v=19$m=64,t=1,p=1$c2FsdA$a2V5", inputPassword: "password", expectError: hasher.ErrUnsupportedHash},
+		{name: "invalid hash", inputHash: "$bcrypt-sha256$$2a$10$invalid", inputPassword: "password", expectError: hasher.ErrInvalidHash},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := bcryptHasher.Verify(tt.inputHash, tt.inputPassword); !stderr.Is(err, tt.expectError) {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestBcryptHasher_NeedsRehash(t *testing.T) {
+	hashed, err := hasher.NewBcryptHasher(bcrypt.MinCost).Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		inputCost    int
+		inputHash    string
+		expectResult bool
+	}{
+		{name: "current cost", inputCost: bcrypt.MinCost, inputHash: hashed, expectResult: false},
+		{name: "outdated cost", inputCost: bcrypt.MinCost + 1, inputHash: hashed, expectResult: true},
+		{name: "legacy hash", inputCost: 10, inputHash: bcryptHash, expectResult: true},
+		{name: "argon2id hash", inputCost: 10, inputHash: "$argon2id$v=19$m=64,t=1,p=1$c2FsdA$a2V5", expectResult: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := hasher.NewBcryptHasher(tt.inputCost).NeedsRehash(tt.inputHash); result != tt.expectResult {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectResult, result)
+			}
+		})
+	}
+}
+
+func TestPasswordHasher_Verify(t *testing.T) {
+	passwordHasher := hasher.NewPasswordHasher(hasher.NewArgon2idHasher(argon2idParams), hasher.NewBcryptHasher(10))
+
+	hashed, err := passwordHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		inputHash     string
+		inputPassword string
+		expectError   error
+	}{
+		{name: "primary hash", inputHash: hashed, inputPassword: "password", expectError: nil},
+		{name: "legacy hash", inputHash: bcryptHash, inputPassword: "password", expectError: nil},
+		{name: "mismatched legacy hash", inputHash: bcryptHash, inputPassword: "PASSWORD", expectError: hasher.ErrMismatchedHashAndPassword},
+		{name: "unsupported hash", inputHash: "password", inputPassword: "password", expectError: hasher.ErrUnsupportedHash},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := passwordHasher.Verify(tt.inputHash, tt.inputPassword); !stderr.Is(err, tt.expectError) {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectError, err)
+			}
+		})
+	}
+}
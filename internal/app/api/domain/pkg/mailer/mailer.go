@@ -0,0 +1,14 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../../../test/mock/domain/pkg/$GOPACKAGE/$GOFILE
+package mailer
+
+import "context"
+
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(context.Context, *Mail) error
+}
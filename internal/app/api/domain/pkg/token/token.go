@@ -0,0 +1,18 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../../../test/mock/domain/pkg/$GOPACKAGE/$GOFILE
+package token
+
+import (
+	"crypto/ed25519"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+type PublicKey struct {
+	ID  string
+	Key ed25519.PublicKey
+}
+
+type AccessTokenIssuer interface {
+	Issue(*entity.Account, *entity.Session) (string, error)
+	PublicKeys() []*PublicKey
+}
@@ -0,0 +1,87 @@
+package webauthn
+
+import (
+	"encoding/binary"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn/cbor"
+)
+
+const (
+	flagUserPresent            = 0x01
+	flagUserVerified           = 0x04
+	flagAttestedCredentialData = 0x40
+
+	rpIDHashLength = 32
+	aaguidLength   = 16
+)
+
+type authenticatorData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	credentialID []byte
+	publicKey    []byte
+}
+
+func parseAttestationObject(attestationObject []byte) (string, []byte, error) {
+	decoded, rest, err := cbor.Unmarshal(attestationObject)
+	if err != nil || len(rest) != 0 {
+		return "", nil, ErrAttestationInvalid
+	}
+
+	object, ok := decoded.(map[any]any)
+	if !ok {
+		return "", nil, ErrAttestationInvalid
+	}
+
+	format, ok := object["fmt"].(string)
+	if !ok {
+		return "", nil, ErrAttestationInvalid
+	}
+	authData, ok := object["authData"].([]byte)
+	if !ok {
+		return "", nil, ErrAttestationInvalid
+	}
+
+	return format, authData, nil
+}
+
+// NOTE: 構成証明された認証情報が含まれる場合は, 認証情報IDとCOSE形式の公開鍵を取り出す.
+// 拡張データは使用しないため読み飛ばす.
+func parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < rpIDHashLength+1+4 {
+		return nil, ErrAuthenticatorDataInvalid
+	}
+
+	authData := &authenticatorData{
+		rpIDHash:  data[:rpIDHashLength],
+		flags:     data[rpIDHashLength],
+		signCount: binary.BigEndian.Uint32(data[rpIDHashLength+1:]),
+	}
+
+	if authData.flags&flagAttestedCredentialData == 0 {
+		return authData, nil
+	}
+
+	rest := data[rpIDHashLength+1+4:]
+	if len(rest) < aaguidLength+2 {
+		return nil, ErrAuthenticatorDataInvalid
+	}
+	rest = rest[aaguidLength:]
+
+	length := int(binary.BigEndian.Uint16(rest))
+	rest = rest[2:]
+	if length == 0 || len(rest) < length {
+		return nil, ErrAuthenticatorDataInvalid
+	}
+	authData.credentialID = rest[:length]
+	rest = rest[length:]
+
+	_, extensions, err := cbor.Unmarshal(rest)
+	if err != nil {
+		return nil, ErrAuthenticatorDataInvalid
+	}
+	authData.publicKey = rest[:len(rest)-len(extensions)]
+
+	return authData, nil
+}
@@ -0,0 +1,305 @@
+package cbor
+
+import (
+	"bytes"
+	"encoding/binary"
+	stderr "errors"
+	"math"
+	"slices"
+)
+
+var (
+	ErrUnexpectedEnd   = stderr.New("unexpected end of cbor data")
+	ErrUnsupportedType = stderr.New("unsupported cbor type")
+	ErrInvalidMapKey   = stderr.New("invalid cbor map key")
+	ErrTooDeep         = stderr.New("cbor data is nested too deeply")
+)
+
+const (
+	majorUnsigned = 0
+	majorNegative = 1
+	majorBytes    = 2
+	majorText     = 3
+	majorArray    = 4
+	majorMap      = 5
+	majorSimple   = 7
+
+	simpleFalse = 20
+	simpleTrue  = 21
+	simpleNull  = 22
+
+	maxDepth = 16
+)
+
+// NOTE: WebAuthnで使用される範囲のみを扱うため, 不定長, タグ, 浮動小数点数には対応しない.
+// 整数はint64, バイト列は[]byte, 文字列はstring, 配列は[]any, マップはmap[any]anyとして返す.
+func Unmarshal(data []byte) (any, []byte, error) {
+	return decode(data, 0)
+}
+
+// NOTE: 同じ値から同じバイト列が得られるよう, マップのキーはCTAP2の正規形に従って並べる.
+func Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := encode(&buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decode(data []byte, depth int) (any, []byte, error) {
+	if maxDepth < depth {
+		return nil, nil, ErrTooDeep
+	}
+
+	major, arg, rest, err := decodeHead(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch major {
+	case majorUnsigned, majorNegative:
+		return decodeInt(major, arg, rest)
+	case majorBytes, majorText:
+		return decodeString(major, arg, rest)
+	case majorArray:
+		return decodeArray(rest, arg, depth)
+	case majorMap:
+		return decodeMap(rest, arg, depth)
+	default:
+		return decodeSimple(arg, rest)
+	}
+}
+
+func decodeHead(data []byte) (byte, uint64, []byte, error) {
+	if len(data) < 1 {
+		return 0, 0, nil, ErrUnexpectedEnd
+	}
+
+	major := data[0] >> 5
+	info := data[0] & 0x1f
+	data = data[1:]
+
+	if info < 24 {
+		return major, uint64(info), data, nil
+	}
+
+	var size int
+	switch info {
+	case 24:
+		size = 1
+	case 25:
+		size = 2
+	case 26:
+		size = 4
+	case 27:
+		size = 8
+	default:
+		return 0, 0, nil, ErrUnsupportedType
+	}
+	if len(data) < size {
+		return 0, 0, nil, ErrUnexpectedEnd
+	}
+
+	var arg uint64
+	for _, b := range data[:size] {
+		arg = arg<<8 | uint64(b)
+	}
+
+	return major, arg, data[size:], nil
+}
+
+func decodeInt(major byte, arg uint64, data []byte) (any, []byte, error) {
+	if math.MaxInt64 < arg {
+		return nil, nil, ErrUnsupportedType
+	}
+
+	v := int64(arg) // nolint:gosec // 直前でint64の範囲内であることを確認している.
+	if major == majorNegative {
+		return -1 - v, data, nil
+	}
+	return v, data, nil
+}
+
+func decodeString(major byte, length uint64, data []byte) (any, []byte, error) {
+	if uint64(len(data)) < length {
+		return nil, nil, ErrUnexpectedEnd
+	}
+
+	if major == majorText {
+		return string(data[:length]), data[length:], nil
+	}
+	return bytes.Clone(data[:length]), data[length:], nil
+}
+
+// NOTE: 不正な長さで大きな領域を確保しないよう, 要素数は残りのバイト数を上限とする.
+func decodeArray(data []byte, length uint64, depth int) (any, []byte, error) {
+	if uint64(len(data)) < length {
+		return nil, nil, ErrUnexpectedEnd
+	}
+
+	array := make([]any, length)
+	for i := range array {
+		var err error
+		array[i], data, err = decode(data, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return array, data, nil
+}
+
+func decodeMap(data []byte, length uint64, depth int) (any, []byte, error) {
+	if uint64(len(data)) < length*2 {
+		return nil, nil, ErrUnexpectedEnd
+	}
+
+	m := make(map[any]any, length)
+	for range length {
+		key, rest, err := decode(data, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch key.(type) {
+		case int64, string:
+		default:
+			return nil, nil, ErrInvalidMapKey
+		}
+
+		m[key], data, err = decode(rest, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return m, data, nil
+}
+
+func decodeSimple(arg uint64, data []byte) (any, []byte, error) {
+	switch arg {
+	case simpleFalse:
+		return false, data, nil
+	case simpleTrue:
+		return true, data, nil
+	case simpleNull:
+		return nil, data, nil
+	default:
+		return nil, nil, ErrUnsupportedType
+	}
+}
+
+func encode(buf *bytes.Buffer, v any) error {
+	switch v := v.(type) {
+	case []any:
+		return encodeArray(buf, v)
+	case map[any]any:
+		return encodeMap(buf, v)
+	default:
+		return encodeScalar(buf, v)
+	}
+}
+
+func encodeScalar(buf *bytes.Buffer, v any) error {
+	switch v := v.(type) {
+	case int:
+		encodeInt(buf, int64(v))
+	case int64:
+		encodeInt(buf, v)
+	case []byte:
+		encodeHead(buf, majorBytes, uint64(len(v)))
+		buf.Write(v)
+	case string:
+		encodeHead(buf, majorText, uint64(len(v)))
+		buf.WriteString(v)
+	case bool:
+		encodeBool(buf, v)
+	case nil:
+		encodeHead(buf, majorSimple, simpleNull)
+	default:
+		return ErrUnsupportedType
+	}
+	return nil
+}
+
+func encodeInt(buf *bytes.Buffer, v int64) {
+	if v < 0 {
+		encodeHead(buf, majorNegative, uint64(-1-v)) // nolint:gosec // 負の値のみを変換するため-1-vは0以上になる.
+		return
+	}
+	encodeHead(buf, majorUnsigned, uint64(v))
+}
+
+func encodeBool(buf *bytes.Buffer, v bool) {
+	if v {
+		encodeHead(buf, majorSimple, simpleTrue)
+		return
+	}
+	encodeHead(buf, majorSimple, simpleFalse)
+}
+
+func encodeArray(buf *bytes.Buffer, array []any) error {
+	encodeHead(buf, majorArray, uint64(len(array)))
+	for _, item := range array {
+		if err := encode(buf, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func encodeHead(buf *bytes.Buffer, major byte, arg uint64) {
+	major <<= 5
+	switch {
+	case arg < 24:
+		buf.WriteByte(major | byte(arg))
+	case arg <= math.MaxUint8:
+		buf.Write([]byte{major | 24, byte(arg)})
+	case arg <= math.MaxUint16:
+		buf.WriteByte(major | 25)
+		buf.Write(binary.BigEndian.AppendUint16(nil, uint16(arg)))
+	case arg <= math.MaxUint32:
+		buf.WriteByte(major | 26)
+		buf.Write(binary.BigEndian.AppendUint32(nil, uint32(arg)))
+	default:
+		buf.WriteByte(major | 27)
+		buf.Write(binary.BigEndian.AppendUint64(nil, arg))
+	}
+}
+
+func encodeMap(buf *bytes.Buffer, m map[any]any) error {
+	type entry struct {
+		key   []byte
+		value any
+	}
+
+	entries := make([]entry, 0, len(m))
+	for k, v := range m {
+		switch k.(type) {
+		case int, int64, string:
+		default:
+			return ErrInvalidMapKey
+		}
+
+		key, err := Marshal(k)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry{key, v})
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		if len(a.key) != len(b.key) {
+			return len(a.key) - len(b.key)
+		}
+		return bytes.Compare(a.key, b.key)
+	})
+
+	encodeHead(buf, majorMap, uint64(len(entries)))
+	for _, e := range entries {
+		buf.Write(e.key)
+		if err := encode(buf, e.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package cbor_test
+
+import (
+	"encoding/hex"
+	stderr "errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn/cbor"
+)
+
+// NOTE: RFC 8949 Appendix Aの例を用いる.
+func TestMarshal(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       any
+		expect      string
+		expectError error
+	}{
+		{name: "small unsigned", input: 10, expect: "0a", expectError: nil},
+		{name: "one byte unsigned", input: 24, expect: "1818", expectError: nil},
+		{name: "large unsigned", input: int64(1000000), expect: "1a000f4240", expectError: nil},
+		{name: "negative", input: -1000, expect: "3903e7", expectError: nil},
+		{name: "bytes", input: []byte{1, 2, 3, 4}, expect: "4401020304", expectError: nil},
+		{name: "text", input: "IETF", expect: "6449455446", expectError: nil},
+		{name: "array", input: []any{1, 2, 3}, expect: "83010203", expectError: nil},
+		{name: "map sorted by canonical order", input: map[any]any{"a": 1, 3: 4, -1: 2}, expect: "a303042002616101", expectError: nil},
+		{name: "bool", input: true, expect: "f5", expectError: nil},
+		{name: "null", input: nil, expect: "f6", expectError: nil},
+		{name: "unsupported type", input: 1.5, expect: "", expectError: cbor.ErrUnsupportedType},
+		{name: "invalid map key", input: map[any]any{true: 1}, expect: "", expectError: cbor.ErrInvalidMapKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := cbor.Marshal(tt.input)
+			if !stderr.Is(err, tt.expectError) {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectError, err)
+			}
+
+			if got := hex.EncodeToString(result); got != tt.expect {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		expectResult any
+		expectRest   string
+		expectError  error
+	}{
+		{name: "unsigned", input: "1a000f4240", expectResult: int64(1000000), expectRest: "", expectError: nil},
+		{name: "negative", input: "3903e7", expectResult: int64(-1000), expectRest: "", expectError: nil},
+		{name: "bytes", input: "4401020304", expectResult: []byte{1, 2, 3, 4}, expectRest: "", expectError: nil},
+		{name: "text", input: "6449455446", expectResult: "IETF", expectRest: "", expectError: nil},
+		{name: "nested", input: "a26161016162820203", expectResult: map[any]any{"a": int64(1), "b": []any{int64(2), int64(3)}}, expectRest: "", expectError: nil},
+		{name: "simple values", input: "83f4f5f6", expectResult: []any{false, true, nil}, expectRest: "", expectError: nil},
+		{name: "trailing data", input: "0102", expectResult: int64(1), expectRest: "02", expectError: nil},
+		{name: "truncated", input: "4401", expectResult: nil, expectRest: "", expectError: cbor.ErrUnexpectedEnd},
+		{name: "too long array", input: "9b00000000ffffffff", expectResult: nil, expectRest: "", expectError: cbor.ErrUnexpectedEnd},
+		{name: "indefinite length", input: "5f42010243030405ff", expectResult: nil, expectRest: "", expectError: cbor.ErrUnsupportedType},
+		{name: "tag", input: "c11a514b67b0", expectResult: nil, expectRest: "", expectError: cbor.ErrUnsupportedType},
+		{name: "float", input: "f93c00", expectResult: nil, expectRest: "", expectError: cbor.ErrUnsupportedType},
+		{name: "overflow", input: "1bffffffffffffffff", expectResult: nil, expectRest: "", expectError: cbor.ErrUnsupportedType},
+		{name: "invalid map key", input: "a1f501", expectResult: nil, expectRest: "", expectError: cbor.ErrInvalidMapKey},
+		{name: "too deep", input: "818181818181818181818181818181818100", expectResult: nil, expectRest: "", expectError: cbor.ErrTooDeep},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := hex.DecodeString(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result, rest, err := cbor.Unmarshal(input)
+			if !stderr.Is(err, tt.expectError) {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectError, err)
+			}
+
+			if diff := cmp.Diff(tt.expectResult, result); diff != "" {
+				t.Error(diff)
+			}
+			if got := hex.EncodeToString(rest); got != tt.expectRest {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectRest, got)
+			}
+		})
+	}
+}
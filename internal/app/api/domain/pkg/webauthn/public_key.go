@@ -0,0 +1,125 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"math/big"
+	"slices"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn/cbor"
+)
+
+// NOTE: COSE Key Common ParametersとCOSE Algorithmsの値を表す.
+const (
+	AlgorithmES256 = -7
+	AlgorithmRS256 = -257
+
+	coseKeyType       = 1
+	coseKeyAlgorithm  = 3
+	coseKeyCurve      = -1
+	coseKeyX          = -2
+	coseKeyY          = -3
+	coseKeyModulus    = -1
+	coseKeyExponent   = -2
+	coseKeyTypeEC2    = 2
+	coseKeyTypeRSA    = 3
+	coseCurveP256     = 1
+	p256CoordinateLen = 32
+	rsaMinBits        = 2048
+)
+
+func SupportedAlgorithms() []int {
+	return []int{AlgorithmES256, AlgorithmRS256}
+}
+
+type publicKey struct {
+	key crypto.PublicKey
+}
+
+func parsePublicKey(coseKey []byte) (*publicKey, error) {
+	decoded, rest, err := cbor.Unmarshal(coseKey)
+	if err != nil || len(rest) != 0 {
+		return nil, ErrPublicKeyInvalid
+	}
+
+	params, ok := decoded.(map[any]any)
+	if !ok {
+		return nil, ErrPublicKeyInvalid
+	}
+
+	keyType, _ := params[int64(coseKeyType)].(int64)
+	algorithm, _ := params[int64(coseKeyAlgorithm)].(int64)
+
+	switch {
+	case keyType == coseKeyTypeEC2 && algorithm == AlgorithmES256:
+		return parseES256PublicKey(params)
+	case keyType == coseKeyTypeRSA && algorithm == AlgorithmRS256:
+		return parseRS256PublicKey(params)
+	default:
+		return nil, ErrPublicKeyUnsupported
+	}
+}
+
+func parseES256PublicKey(params map[any]any) (*publicKey, error) {
+	curve, _ := params[int64(coseKeyCurve)].(int64)
+	x, _ := params[int64(coseKeyX)].([]byte)
+	y, _ := params[int64(coseKeyY)].([]byte)
+	if curve != coseCurveP256 || len(x) != p256CoordinateLen || len(y) != p256CoordinateLen {
+		return nil, ErrPublicKeyInvalid
+	}
+
+	// NOTE: 曲線上の点であることを確認するため, ecdhで非圧縮形式の公開鍵として読み込む.
+	if _, err := ecdh.P256().NewPublicKey(slices.Concat([]byte{0x04}, x, y)); err != nil {
+		return nil, ErrPublicKeyInvalid
+	}
+
+	return &publicKey{
+		key: &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		},
+	}, nil
+}
+
+func parseRS256PublicKey(params map[any]any) (*publicKey, error) {
+	n, _ := params[int64(coseKeyModulus)].([]byte)
+	e, _ := params[int64(coseKeyExponent)].([]byte)
+	if len(e) == 0 || 4 < len(e) {
+		return nil, ErrPublicKeyInvalid
+	}
+
+	key := &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}
+	if key.N.BitLen() < rsaMinBits || key.E < 3 || key.E%2 == 0 {
+		return nil, ErrPublicKeyInvalid
+	}
+
+	return &publicKey{
+		key: key,
+	}, nil
+}
+
+func (k *publicKey) verify(data, signature []byte) error {
+	digest := sha256.Sum256(data)
+
+	switch key := k.key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return ErrSignatureInvalid
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return ErrSignatureInvalid
+		}
+	default:
+		return ErrPublicKeyUnsupported
+	}
+	return nil
+}
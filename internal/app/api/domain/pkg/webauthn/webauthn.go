@@ -0,0 +1,206 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	stderr "errors"
+	"slices"
+)
+
+var (
+	ErrClientDataInvalid        = stderr.New("client data is invalid")
+	ErrClientDataTypeMismatch   = stderr.New("client data type does not match")
+	ErrChallengeMismatch        = stderr.New("challenge does not match")
+	ErrOriginNotAllowed         = stderr.New("origin is not allowed")
+	ErrAttestationInvalid       = stderr.New("attestation object is invalid")
+	ErrAttestationUnsupported   = stderr.New("attestation format is not supported")
+	ErrAuthenticatorDataInvalid = stderr.New("authenticator data is invalid")
+	ErrRPIDHashMismatch         = stderr.New("rp id hash does not match")
+	ErrUserNotPresent           = stderr.New("user is not present")
+	ErrUserNotVerified          = stderr.New("user is not verified")
+	ErrPublicKeyInvalid         = stderr.New("public key is invalid")
+	ErrPublicKeyUnsupported     = stderr.New("public key algorithm is not supported")
+	ErrSignatureInvalid         = stderr.New("signature is invalid")
+)
+
+const (
+	ClientDataTypeCreate = "webauthn.create"
+	ClientDataTypeGet    = "webauthn.get"
+
+	AttestationFormatNone = "none"
+)
+
+type ClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+// NOTE: navigator.credentials.create()の結果のうち, 検証に必要な値を表す.
+type Attestation struct {
+	ClientDataJSON    []byte
+	AttestationObject []byte
+}
+
+// NOTE: navigator.credentials.get()の結果のうち, 検証に必要な値を表す.
+type Assertion struct {
+	CredentialID      []byte
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+	UserHandle        []byte
+}
+
+// NOTE: 登録時に検証済みの認証器の情報を表す. 公開鍵はCOSE形式のまま保持する.
+type Credential struct {
+	ID        []byte
+	PublicKey []byte
+	SignCount uint32
+}
+
+type RelyingParty interface {
+	ID() string
+	Name() string
+	ParseClientData([]byte) (*ClientData, error)
+	VerifyRegistration(string, *Attestation) (*Credential, error)
+	VerifyAssertion(string, []byte, *Assertion) (uint32, error)
+}
+
+type relyingParty struct {
+	id      string
+	name    string
+	origins []string
+}
+
+// NOTE: 認証器の真正性は問わないため, 構成証明はnone形式のみを受け付ける.
+// パスワードを用いないログインとして扱うため, ユーザー検証(UV)を必須とする.
+func NewRelyingParty(id, name string, origins []string) RelyingParty {
+	return &relyingParty{
+		id:      id,
+		name:    name,
+		origins: origins,
+	}
+}
+
+func (rp *relyingParty) ID() string {
+	return rp.id
+}
+
+func (rp *relyingParty) Name() string {
+	return rp.name
+}
+
+func (rp *relyingParty) ParseClientData(clientDataJSON []byte) (*ClientData, error) {
+	var clientData ClientData
+	if err := json.Unmarshal(clientDataJSON, &clientData); err != nil {
+		return nil, ErrClientDataInvalid
+	}
+	return &clientData, nil
+}
+
+func (rp *relyingParty) VerifyRegistration(challenge string, attestation *Attestation) (*Credential, error) {
+	if attestation == nil {
+		return nil, ErrAttestationInvalid
+	}
+
+	if err := rp.verifyClientData(attestation.ClientDataJSON, ClientDataTypeCreate, challenge); err != nil {
+		return nil, err
+	}
+
+	format, rawAuthData, err := parseAttestationObject(attestation.AttestationObject)
+	if err != nil {
+		return nil, err
+	}
+	if format != AttestationFormatNone {
+		return nil, ErrAttestationUnsupported
+	}
+
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.verifyAuthenticatorData(authData); err != nil {
+		return nil, err
+	}
+	if authData.credentialID == nil {
+		return nil, ErrAuthenticatorDataInvalid
+	}
+
+	if _, err := parsePublicKey(authData.publicKey); err != nil {
+		return nil, err
+	}
+
+	return &Credential{
+		ID:        authData.credentialID,
+		PublicKey: authData.publicKey,
+		SignCount: authData.signCount,
+	}, nil
+}
+
+// NOTE: 署名カウンタの検証は保存済みの値が必要なため呼び出し側で行い, ここでは認証器が返した値を返す.
+func (rp *relyingParty) VerifyAssertion(challenge string, publicKey []byte, assertion *Assertion) (uint32, error) {
+	if assertion == nil {
+		return 0, ErrAuthenticatorDataInvalid
+	}
+
+	if err := rp.verifyClientData(assertion.ClientDataJSON, ClientDataTypeGet, challenge); err != nil {
+		return 0, err
+	}
+
+	authData, err := parseAuthenticatorData(assertion.AuthenticatorData)
+	if err != nil {
+		return 0, err
+	}
+	if err := rp.verifyAuthenticatorData(authData); err != nil {
+		return 0, err
+	}
+
+	key, err := parsePublicKey(publicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	signed := slices.Concat(assertion.AuthenticatorData, clientDataHash[:])
+	if err := key.verify(signed, assertion.Signature); err != nil {
+		return 0, err
+	}
+
+	return authData.signCount, nil
+}
+
+func (rp *relyingParty) verifyClientData(clientDataJSON []byte, clientDataType, challenge string) error {
+	clientData, err := rp.ParseClientData(clientDataJSON)
+	if err != nil {
+		return err
+	}
+
+	if clientData.Type != clientDataType {
+		return ErrClientDataTypeMismatch
+	}
+	if subtle.ConstantTimeCompare([]byte(clientData.Challenge), []byte(challenge)) != 1 {
+		return ErrChallengeMismatch
+	}
+	if clientData.CrossOrigin || !slices.Contains(rp.origins, clientData.Origin) {
+		return ErrOriginNotAllowed
+	}
+
+	return nil
+}
+
+func (rp *relyingParty) verifyAuthenticatorData(authData *authenticatorData) error {
+	rpIDHash := sha256.Sum256([]byte(rp.id))
+	if !bytes.Equal(authData.rpIDHash, rpIDHash[:]) {
+		return ErrRPIDHashMismatch
+	}
+	if authData.flags&flagUserPresent == 0 {
+		return ErrUserNotPresent
+	}
+	if authData.flags&flagUserVerified == 0 {
+		return ErrUserNotVerified
+	}
+	return nil
+}
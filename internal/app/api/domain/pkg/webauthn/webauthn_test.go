@@ -0,0 +1,256 @@
+package webauthn_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/binary"
+	stderr "errors"
+	"slices"
+	"testing"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn/cbor"
+	"github.com/atsumarukun/holos-account-api/test/authenticator"
+)
+
+const (
+	rpID      = "example.com"
+	origin    = "https://example.com"
+	challenge = "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS"
+)
+
+func newRelyingParty() webauthn.RelyingParty {
+	return webauthn.NewRelyingParty(rpID, "holos", []string{origin})
+}
+
+func newAuthenticator(t *testing.T) *authenticator.Authenticator {
+	t.Helper()
+
+	a, err := authenticator.New(rpID, origin, []byte("user"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestRelyingParty_VerifyRegistration(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputChallenge string
+		modify         func(*authenticator.Authenticator)
+		expectError    error
+	}{
+		{name: "successfully verified", inputChallenge: challenge, modify: func(*authenticator.Authenticator) {}, expectError: nil},
+		{name: "challenge mismatch", inputChallenge: "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K", modify: func(*authenticator.Authenticator) {}, expectError: webauthn.ErrChallengeMismatch},
+		{name: "origin not allowed", inputChallenge: challenge, modify: func(a *authenticator.Authenticator) { a.Origin = "https://evil.example.com" }, expectError: webauthn.ErrOriginNotAllowed},
+		{name: "rp id mismatch", inputChallenge: challenge, modify: func(a *authenticator.Authenticator) { a.RPID = "evil.example.com" }, expectError: webauthn.ErrRPIDHashMismatch},
+		{name: "user not present", inputChallenge: challenge, modify: func(a *authenticator.Authenticator) { a.Flags = authenticator.FlagUserVerified }, expectError: webauthn.ErrUserNotPresent},
+		{name: "user not verified", inputChallenge: challenge, modify: func(a *authenticator.Authenticator) { a.Flags = authenticator.FlagUserPresent }, expectError: webauthn.ErrUserNotVerified},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAuthenticator(t)
+			tt.modify(a)
+
+			attestation, err := a.Register(challenge)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			credential, err := newRelyingParty().VerifyRegistration(tt.inputChallenge, attestation)
+			if !stderr.Is(err, tt.expectError) {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectError, err)
+			}
+
+			if tt.expectError == nil {
+				if !slices.Equal(credential.ID, a.CredentialID) {
+					t.Error("credential id does not match")
+				}
+				if !slices.Equal(credential.PublicKey, a.PublicKey()) {
+					t.Error("public key does not match")
+				}
+			}
+		})
+	}
+}
+
+func TestRelyingParty_VerifyRegistration_Invalid(t *testing.T) {
+	a := newAuthenticator(t)
+	attestation, err := a.Register(challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	packed, err := cbor.Marshal(map[any]any{"fmt": "packed", "attStmt": map[any]any{}, "authData": []byte{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name             string
+		inputAttestation *webauthn.Attestation
+		expectError      error
+	}{
+		{name: "nil attestation", inputAttestation: nil, expectError: webauthn.ErrAttestationInvalid},
+		{name: "invalid client data", inputAttestation: &webauthn.Attestation{ClientDataJSON: []byte("{"), AttestationObject: attestation.AttestationObject}, expectError: webauthn.ErrClientDataInvalid},
+		{name: "client data type mismatch", inputAttestation: &webauthn.Attestation{ClientDataJSON: a.ClientDataJSON(webauthn.ClientDataTypeGet, challenge), AttestationObject: attestation.AttestationObject}, expectError: webauthn.ErrClientDataTypeMismatch},
+		{name: "invalid attestation object", inputAttestation: &webauthn.Attestation{ClientDataJSON: attestation.ClientDataJSON, AttestationObject: []byte{0xa1}}, expectError: webauthn.ErrAttestationInvalid},
+		{name: "unsupported format", inputAttestation: &webauthn.Attestation{ClientDataJSON: attestation.ClientDataJSON, AttestationObject: packed}, expectError: webauthn.ErrAttestationUnsupported},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newRelyingParty().VerifyRegistration(challenge, tt.inputAttestation)
+			if !stderr.Is(err, tt.expectError) {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestRelyingParty_VerifyAssertion(t *testing.T) {
+	tests := []struct {
+		name            string
+		inputChallenge  string
+		modify          func(*authenticator.Authenticator, *webauthn.Assertion)
+		expectSignCount uint32
+		expectError     error
+	}{
+		{
+			name:            "successfully verified",
+			inputChallenge:  challenge,
+			modify:          func(*authenticator.Authenticator, *webauthn.Assertion) {},
+			expectSignCount: 1,
+			expectError:     nil,
+		},
+		{
+			name:            "challenge mismatch",
+			inputChallenge:  "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+			modify:          func(*authenticator.Authenticator, *webauthn.Assertion) {},
+			expectSignCount: 0,
+			expectError:     webauthn.ErrChallengeMismatch,
+		},
+		{
+			name:           "client data type mismatch",
+			inputChallenge: challenge,
+			modify: func(a *authenticator.Authenticator, assertion *webauthn.Assertion) {
+				assertion.ClientDataJSON = a.ClientDataJSON(webauthn.ClientDataTypeCreate, challenge)
+			},
+			expectSignCount: 0,
+			expectError:     webauthn.ErrClientDataTypeMismatch,
+		},
+		{
+			name:           "tampered authenticator data",
+			inputChallenge: challenge,
+			modify: func(_ *authenticator.Authenticator, assertion *webauthn.Assertion) {
+				assertion.AuthenticatorData = slices.Clone(assertion.AuthenticatorData)
+				assertion.AuthenticatorData[len(assertion.AuthenticatorData)-1]++
+			},
+			expectSignCount: 0,
+			expectError:     webauthn.ErrSignatureInvalid,
+		},
+		{
+			name:           "short authenticator data",
+			inputChallenge: challenge,
+			modify: func(_ *authenticator.Authenticator, assertion *webauthn.Assertion) {
+				assertion.AuthenticatorData = assertion.AuthenticatorData[:10]
+			},
+			expectSignCount: 0,
+			expectError:     webauthn.ErrAuthenticatorDataInvalid,
+		},
+		{
+			name:           "user not verified",
+			inputChallenge: challenge,
+			modify: func(a *authenticator.Authenticator, assertion *webauthn.Assertion) {
+				a.Flags = authenticator.FlagUserPresent
+				signed, err := a.Assert(challenge)
+				if err != nil {
+					t.Fatal(err)
+				}
+				*assertion = *signed
+			},
+			expectSignCount: 0,
+			expectError:     webauthn.ErrUserNotVerified,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAuthenticator(t)
+
+			assertion, err := a.Assert(challenge)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.modify(a, assertion)
+
+			signCount, err := newRelyingParty().VerifyAssertion(tt.inputChallenge, a.PublicKey(), assertion)
+			if !stderr.Is(err, tt.expectError) {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectError, err)
+			}
+
+			if signCount != tt.expectSignCount {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectSignCount, signCount)
+			}
+		})
+	}
+}
+
+func TestRelyingParty_VerifyAssertion_RS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	coseKey, err := cbor.Marshal(map[any]any{
+		1:  3,
+		3:  webauthn.AlgorithmRS256,
+		-1: key.N.Bytes(),
+		-2: binary.BigEndian.AppendUint32(nil, uint32(key.E))[1:],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := newAuthenticator(t)
+	clientDataJSON := a.ClientDataJSON(webauthn.ClientDataTypeGet, challenge)
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authData := slices.Concat(rpIDHash[:], []byte{authenticator.FlagUserPresent | authenticator.FlagUserVerified}, []byte{0, 0, 0, 0})
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(slices.Concat(authData, clientDataHash[:]))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertion := &webauthn.Assertion{
+		ClientDataJSON:    clientDataJSON,
+		AuthenticatorData: authData,
+		Signature:         signature,
+	}
+	if _, err := newRelyingParty().VerifyAssertion(challenge, coseKey, assertion); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := newRelyingParty().VerifyAssertion(challenge, a.PublicKey(), assertion); !stderr.Is(err, webauthn.ErrSignatureInvalid) {
+		t.Errorf("\nexpect: %v\ngot: %v", webauthn.ErrSignatureInvalid, err)
+	}
+}
+
+func TestRelyingParty_VerifyAssertion_UnsupportedKey(t *testing.T) {
+	coseKey, err := cbor.Marshal(map[any]any{1: 1, 3: -8, -1: 6, -2: make([]byte, 32)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := newAuthenticator(t)
+	assertion, err := a.Assert(challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := newRelyingParty().VerifyAssertion(challenge, coseKey, assertion); !stderr.Is(err, webauthn.ErrPublicKeyUnsupported) {
+		t.Errorf("\nexpect: %v\ngot: %v", webauthn.ErrPublicKeyUnsupported, err)
+	}
+}
@@ -4,6 +4,7 @@ package repository
 import (
 	"context"
 	stderr "errors"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -12,11 +13,37 @@ import (
 
 var ErrNilAccount = stderr.New("account must not be nil")
 
+// NOTE: 作成日時とIDの降順で並べ, カーソルを指定した場合はカーソルのアカウントより後のアカウントのみを対象とする.
+// 削除済みかどうかを指定しない場合は削除済みのアカウントも対象とする.
+type AccountSearchCondition struct {
+	NamePrefix    string
+	Deleted       *bool
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Cursor        *AccountCursor
+	Limit         int
+}
+
+type AccountCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
 type AccountRepository interface {
 	Create(context.Context, *entity.Account) error
 	Update(context.Context, *entity.Account) error
 	Delete(context.Context, *entity.Account) error
+	Restore(context.Context, *entity.Account) error
+	Purge(context.Context, *entity.Account) error
 	FindOneByID(context.Context, uuid.UUID) (*entity.Account, error)
+	FindOneByIDIncludingDeleted(context.Context, uuid.UUID) (*entity.Account, error)
+	FindOneByIDAndDeletedAtAfter(context.Context, uuid.UUID, time.Time) (*entity.Account, error)
 	FindOneByName(context.Context, string) (*entity.Account, error)
 	FindOneByNameIncludingDeleted(context.Context, string) (*entity.Account, error)
+	FindOneByNameExcludingDeletedBefore(context.Context, string, time.Time) (*entity.Account, error)
+	FindOneByNameAndDeletedAtAfter(context.Context, string, time.Time) (*entity.Account, error)
+	FindOneByEmail(context.Context, string) (*entity.Account, error)
+	FindOneByEmailIncludingDeleted(context.Context, string) (*entity.Account, error)
+	FindByDeletedAtBefore(context.Context, time.Time) ([]*entity.Account, error)
+	Search(context.Context, AccountSearchCondition) ([]*entity.Account, error)
 }
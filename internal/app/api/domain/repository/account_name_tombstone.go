@@ -0,0 +1,18 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../../test/mock/domain/$GOPACKAGE/$GOFILE
+package repository
+
+import (
+	"context"
+	stderr "errors"
+	"time"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+var ErrNilAccountNameTombstone = stderr.New("account name tombstone must not be nil")
+
+type AccountNameTombstoneRepository interface {
+	Save(context.Context, *entity.AccountNameTombstone) error
+	DeleteByReleasedAtBefore(context.Context, time.Time) error
+	FindOneByNameAndReleasedAtAfter(context.Context, string, time.Time) (*entity.AccountNameTombstone, error)
+}
@@ -0,0 +1,18 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../../test/mock/domain/$GOPACKAGE/$GOFILE
+package repository
+
+import (
+	"context"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+var ErrNilEmailVerification = stderr.New("email verification must not be nil")
+
+type EmailVerificationRepository interface {
+	Save(context.Context, *entity.EmailVerification) error
+	Delete(context.Context, *entity.EmailVerification) error
+	DeleteExpired(context.Context) error
+	FindOneByTokenAndNotExpired(context.Context, string) (*entity.EmailVerification, error)
+}
@@ -0,0 +1,23 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../../test/mock/domain/$GOPACKAGE/$GOFILE
+package repository
+
+import (
+	"context"
+	stderr "errors"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+var ErrNilLoginChallenge = stderr.New("login challenge must not be nil")
+
+// NOTE: FindOneByIDForUpdateは同時に失敗した場合の試行回数の取りこぼしや, 同時に成功した場合のセッションの重複作成を防ぐため, トランザクション内で行をロックして取得する.
+type LoginChallengeRepository interface {
+	Create(context.Context, *entity.LoginChallenge) error
+	Update(context.Context, *entity.LoginChallenge) error
+	Delete(context.Context, *entity.LoginChallenge) error
+	DeleteExpired(context.Context) error
+	FindOneByIDForUpdate(context.Context, uuid.UUID) (*entity.LoginChallenge, error)
+	FindOneByTokenAndNotExpired(context.Context, string) (*entity.LoginChallenge, error)
+}
@@ -0,0 +1,22 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../../test/mock/domain/$GOPACKAGE/$GOFILE
+package repository
+
+import (
+	"context"
+	stderr "errors"
+	"time"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+var ErrNilLoginFailure = stderr.New("login failure must not be nil")
+
+// NOTE: Ensureは失敗回数を変更せずに行が存在する状態にし, FindOneByTargetForUpdateは同時に失敗した場合の回数の取りこぼしを防ぐため, トランザクション内で行をロックして取得する.
+type LoginFailureRepository interface {
+	Save(context.Context, *entity.LoginFailure) error
+	Ensure(context.Context, *entity.LoginFailure) error
+	Delete(context.Context, *entity.LoginFailure) error
+	DeleteByLastFailedAtBefore(context.Context, time.Time) error
+	FindOneByTarget(context.Context, string, string) (*entity.LoginFailure, error)
+	FindOneByTargetForUpdate(context.Context, string, string) (*entity.LoginFailure, error)
+}
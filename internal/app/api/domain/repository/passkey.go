@@ -0,0 +1,22 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../../test/mock/domain/$GOPACKAGE/$GOFILE
+package repository
+
+import (
+	"context"
+	stderr "errors"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+var ErrNilPasskey = stderr.New("passkey must not be nil")
+
+type PasskeyRepository interface {
+	Create(context.Context, *entity.Passkey) error
+	Update(context.Context, *entity.Passkey) error
+	Delete(context.Context, *entity.Passkey) error
+	FindByAccountID(context.Context, uuid.UUID) ([]*entity.Passkey, error)
+	FindOneByIDAndAccountID(context.Context, uuid.UUID, uuid.UUID) (*entity.Passkey, error)
+	FindOneByCredentialIDForUpdate(context.Context, []byte) (*entity.Passkey, error)
+}
@@ -0,0 +1,19 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../../test/mock/domain/$GOPACKAGE/$GOFILE
+package repository
+
+import (
+	"context"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+var ErrNilPasskeyChallenge = stderr.New("passkey challenge must not be nil")
+
+// NOTE: FindOneByPurposeAndChallengeAndNotExpiredForUpdateは同一チャレンジの同時使用を防ぐため, トランザクション内で行をロックして取得する.
+type PasskeyChallengeRepository interface {
+	Create(context.Context, *entity.PasskeyChallenge) error
+	Delete(context.Context, *entity.PasskeyChallenge) error
+	DeleteExpired(context.Context) error
+	FindOneByPurposeAndChallengeAndNotExpiredForUpdate(context.Context, string, string) (*entity.PasskeyChallenge, error)
+}
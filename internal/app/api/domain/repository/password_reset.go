@@ -0,0 +1,18 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../../test/mock/domain/$GOPACKAGE/$GOFILE
+package repository
+
+import (
+	"context"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+var ErrNilPasswordReset = stderr.New("password reset must not be nil")
+
+type PasswordResetRepository interface {
+	Save(context.Context, *entity.PasswordReset) error
+	Delete(context.Context, *entity.PasswordReset) error
+	DeleteExpired(context.Context) error
+	FindOneByTokenAndNotExpired(context.Context, string) (*entity.PasswordReset, error)
+}
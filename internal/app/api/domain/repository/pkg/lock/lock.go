@@ -0,0 +1,8 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../../../../test/mock/domain/repository/pkg/$GOPACKAGE/$GOFILE
+package lock
+
+import "context"
+
+type LockObject interface {
+	Lock(context.Context, string, func(context.Context) error) error
+}
@@ -0,0 +1,22 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../../test/mock/domain/$GOPACKAGE/$GOFILE
+package repository
+
+import (
+	"context"
+	stderr "errors"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+var ErrNilRecoveryCode = stderr.New("recovery code must not be nil")
+
+// NOTE: FindOneByAccountIDAndCodeForUpdateは同一コードの同時使用を防ぐため, トランザクション内で行をロックして取得する.
+type RecoveryCodeRepository interface {
+	Create(context.Context, *entity.RecoveryCode) error
+	Delete(context.Context, *entity.RecoveryCode) error
+	DeleteByAccountID(context.Context, uuid.UUID) error
+	CountByAccountID(context.Context, uuid.UUID) (int, error)
+	FindOneByAccountIDAndCodeForUpdate(context.Context, uuid.UUID, string) (*entity.RecoveryCode, error)
+}
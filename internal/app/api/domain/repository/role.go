@@ -0,0 +1,20 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../../test/mock/domain/$GOPACKAGE/$GOFILE
+package repository
+
+import (
+	"context"
+	stderr "errors"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+var ErrNilRole = stderr.New("role must not be nil")
+
+type RoleRepository interface {
+	Assign(context.Context, *entity.Role, *entity.Account) error
+	CountAccountsByID(context.Context, uuid.UUID) (int, error)
+	FindOneByName(context.Context, string) (*entity.Role, error)
+	FindByAccountID(context.Context, uuid.UUID) ([]*entity.Role, error)
+}
@@ -12,9 +12,19 @@ import (
 
 var ErrNilSession = stderr.New("session must not be nil")
 
+// NOTE: FindOneByRefreshTokenAndNotExpiredForUpdateは同一のリフレッシュトークンによる同時のローテーションを防ぐため, トランザクション内で行をロックして取得する.
+// 後続のリクエストはロックの解放後に旧トークンで見つからず, 使用済みトークンとして再利用を検知する.
 type SessionRepository interface {
-	Save(context.Context, *entity.Session) error
+	Create(context.Context, *entity.Session) error
+	Update(context.Context, *entity.Session) error
+	UpdateLastUsedAt(context.Context, *entity.Session) error
 	Delete(context.Context, *entity.Session) error
-	FindOneByAccountID(context.Context, uuid.UUID) (*entity.Session, error)
+	DeleteByAccountID(context.Context, uuid.UUID) error
+	DeleteByAccountIDExcludingID(context.Context, uuid.UUID, uuid.UUID) error
+	DeleteExpired(context.Context) error
+	FindOneByIDAndAccountID(context.Context, uuid.UUID, uuid.UUID) (*entity.Session, error)
 	FindOneByTokenAndNotExpired(context.Context, string) (*entity.Session, error)
+	FindOneByRefreshTokenAndNotExpiredForUpdate(context.Context, string) (*entity.Session, error)
+	FindOneByUsedRefreshToken(context.Context, string) (*entity.Session, error)
+	FindByAccountIDAndNotExpired(context.Context, uuid.UUID) ([]*entity.Session, error)
 }
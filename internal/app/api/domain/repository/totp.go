@@ -0,0 +1,21 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../../test/mock/domain/$GOPACKAGE/$GOFILE
+package repository
+
+import (
+	"context"
+	stderr "errors"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+var ErrNilTOTP = stderr.New("totp must not be nil")
+
+// NOTE: FindOneByAccountIDForUpdateは同一コードの同時使用を防ぐため, トランザクション内で行をロックして取得する.
+type TOTPRepository interface {
+	Save(context.Context, *entity.TOTP) error
+	Delete(context.Context, *entity.TOTP) error
+	FindOneByAccountID(context.Context, uuid.UUID) (*entity.TOTP, error)
+	FindOneByAccountIDForUpdate(context.Context, uuid.UUID) (*entity.TOTP, error)
+}
@@ -4,6 +4,7 @@ package service
 import (
 	"context"
 	stderr "errors"
+	"time"
 
 	"github.com/atsumarukun/holos-api-pkg/errors"
 
@@ -11,29 +12,84 @@ import (
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
 )
 
-var ErrAccountNameAlreadyInUse = stderr.New("account name already in use")
+var (
+	ErrAccountNameAlreadyInUse  = stderr.New("account name already in use")
+	ErrAccountEmailAlreadyInUse = stderr.New("email already in use")
+)
 
 type AccountService interface {
 	Exists(context.Context, *entity.Account) error
+	EmailExists(context.Context, string) error
+	Release(context.Context, *entity.Account) error
 }
 
 type accountService struct {
-	accountRepo repository.AccountRepository
+	accountRepo              repository.AccountRepository
+	accountNameTombstoneRepo repository.AccountNameTombstoneRepository
+	retentionPolicy          entity.AccountRetentionPolicy
 }
 
-func NewAccountService(accountRepo repository.AccountRepository) AccountService {
+func NewAccountService(
+	accountRepo repository.AccountRepository,
+	accountNameTombstoneRepo repository.AccountNameTombstoneRepository,
+	retentionPolicy entity.AccountRetentionPolicy,
+) AccountService {
 	return &accountService{
-		accountRepo: accountRepo,
+		accountRepo:              accountRepo,
+		accountNameTombstoneRepo: accountNameTombstoneRepo,
+		retentionPolicy:          retentionPolicy,
 	}
 }
 
+// NOTE: 保持期間を経過した削除済みのアカウントは物理削除前でも名前を使用中とみなさない.
+// 物理削除を待っているアカウントは一意制約に抵触するため, 呼び出し元で登録前に解放する.
 func (s *accountService) Exists(ctx context.Context, account *entity.Account) error {
-	acc, err := s.accountRepo.FindOneByNameIncludingDeleted(ctx, account.Name)
+	const errMessage = "account already exists"
+
+	now := time.Now()
+
+	acc, err := s.accountRepo.FindOneByNameExcludingDeletedBefore(ctx, account.Name, now.Add(-s.retentionPolicy.Retention))
 	if err != nil {
 		return err
 	}
 	if acc != nil {
-		return errors.Wrap(ErrAccountNameAlreadyInUse, errors.CodeDuplicate, "account already exists")
+		return errors.Wrap(ErrAccountNameAlreadyInUse, errors.CodeDuplicate, errMessage)
+	}
+
+	tombstone, err := s.accountNameTombstoneRepo.FindOneByNameAndReleasedAtAfter(ctx, account.Name, now.Add(-s.retentionPolicy.NameCooldown))
+	if err != nil {
+		return err
+	}
+	if tombstone != nil {
+		return errors.Wrap(ErrAccountNameAlreadyInUse, errors.CodeDuplicate, errMessage)
+	}
+
+	return nil
+}
+
+// NOTE: 一意制約に合わせて削除済みのアカウントも含めて判定し, 削除済みのアカウントのメールアドレスは物理削除後に解放される.
+func (s *accountService) EmailExists(ctx context.Context, email string) error {
+	account, err := s.accountRepo.FindOneByEmailIncludingDeleted(ctx, email)
+	if err != nil {
+		return err
 	}
+	if account != nil {
+		return errors.Wrap(ErrAccountEmailAlreadyInUse, errors.CodeDuplicate, "email already exists")
+	}
+
 	return nil
 }
+
+// NOTE: 物理削除したアカウントの名前はクールダウン期間中に再登録されないよう記録を残す.
+func (s *accountService) Release(ctx context.Context, account *entity.Account) error {
+	tombstone, err := entity.NewAccountNameTombstone(account)
+	if err != nil {
+		return err
+	}
+
+	if err := s.accountNameTombstoneRepo.Save(ctx, tombstone); err != nil {
+		return err
+	}
+
+	return s.accountRepo.Purge(ctx, account)
+}
@@ -1,8 +1,10 @@
 package service_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/atsumarukun/holos-api-pkg/errors"
 	"github.com/google/uuid"
@@ -14,18 +16,34 @@ import (
 	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository"
 )
 
+var retentionPolicy = entity.AccountRetentionPolicy{
+	Retention:    time.Hour * 24 * 30,
+	NameCooldown: time.Hour * 24 * 90,
+}
+
 func TestAccount_Exists(t *testing.T) {
 	account := &entity.Account{
 		ID:       uuid.New(),
 		Name:     "name",
 		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
 	}
+	expiredAccount := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		DeletedAt: time.Now().Add(-time.Hour * 24 * 31),
+	}
+	tombstone := &entity.AccountNameTombstone{
+		Name:       "name",
+		ReleasedAt: time.Now(),
+	}
 
 	tests := []struct {
-		name               string
-		inputAccount       *entity.Account
-		expectError        error
-		setMockAccountRepo func(*repository.MockAccountRepository)
+		name                            string
+		inputAccount                    *entity.Account
+		expectError                     error
+		setMockAccountRepo              func(*repository.MockAccountRepository)
+		setMockAccountNameTombstoneRepo func(*repository.MockAccountNameTombstoneRepository)
 	}{
 		{
 			name:         "not exists",
@@ -34,7 +52,14 @@ func TestAccount_Exists(t *testing.T) {
 			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
 				accountRepo.
 					EXPECT().
-					FindOneByNameIncludingDeleted(gomock.Any(), gomock.Any()).
+					FindOneByNameExcludingDeletedBefore(gomock.Any(), "name", gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockAccountNameTombstoneRepo: func(accountNameTombstoneRepo *repository.MockAccountNameTombstoneRepository) {
+				accountNameTombstoneRepo.
+					EXPECT().
+					FindOneByNameAndReleasedAtAfter(gomock.Any(), "name", gomock.Any()).
 					Return(nil, nil).
 					Times(1)
 			},
@@ -46,10 +71,54 @@ func TestAccount_Exists(t *testing.T) {
 			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
 				accountRepo.
 					EXPECT().
-					FindOneByNameIncludingDeleted(gomock.Any(), gomock.Any()).
+					FindOneByNameExcludingDeletedBefore(gomock.Any(), "name", gomock.Any()).
 					Return(account, nil).
 					Times(1)
 			},
+			setMockAccountNameTombstoneRepo: func(*repository.MockAccountNameTombstoneRepository) {},
+		},
+		{
+			name:         "deleted past retention and not purged",
+			inputAccount: account,
+			expectError:  nil,
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByNameExcludingDeletedBefore(gomock.Any(), "name", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, deletedAt time.Time) (*entity.Account, error) {
+						if expiredAccount.DeletedAt.After(deletedAt) {
+							return expiredAccount, nil
+						}
+						return nil, nil
+					}).
+					Times(1)
+			},
+			setMockAccountNameTombstoneRepo: func(accountNameTombstoneRepo *repository.MockAccountNameTombstoneRepository) {
+				accountNameTombstoneRepo.
+					EXPECT().
+					FindOneByNameAndReleasedAtAfter(gomock.Any(), "name", gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:         "in cooldown",
+			inputAccount: account,
+			expectError:  service.ErrAccountNameAlreadyInUse,
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByNameExcludingDeletedBefore(gomock.Any(), "name", gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockAccountNameTombstoneRepo: func(accountNameTombstoneRepo *repository.MockAccountNameTombstoneRepository) {
+				accountNameTombstoneRepo.
+					EXPECT().
+					FindOneByNameAndReleasedAtAfter(gomock.Any(), "name", gomock.Any()).
+					Return(tombstone, nil).
+					Times(1)
+			},
 		},
 		{
 			name:         "find error",
@@ -58,8 +127,28 @@ func TestAccount_Exists(t *testing.T) {
 			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
 				accountRepo.
 					EXPECT().
-					FindOneByNameIncludingDeleted(gomock.Any(), gomock.Any()).
-					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find account by name including deleted")).
+					FindOneByNameExcludingDeletedBefore(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find account by name excluding deleted before")).
+					Times(1)
+			},
+			setMockAccountNameTombstoneRepo: func(*repository.MockAccountNameTombstoneRepository) {},
+		},
+		{
+			name:         "find tombstone error",
+			inputAccount: account,
+			expectError:  sql.ErrConnDone,
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByNameExcludingDeletedBefore(gomock.Any(), "name", gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockAccountNameTombstoneRepo: func(accountNameTombstoneRepo *repository.MockAccountNameTombstoneRepository) {
+				accountNameTombstoneRepo.
+					EXPECT().
+					FindOneByNameAndReleasedAtAfter(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find account name tombstone by name and released_at")).
 					Times(1)
 			},
 		},
@@ -74,9 +163,173 @@ func TestAccount_Exists(t *testing.T) {
 			accountRepo := repository.NewMockAccountRepository(ctrl)
 			tt.setMockAccountRepo(accountRepo)
 
-			serv := service.NewAccountService(accountRepo)
+			accountNameTombstoneRepo := repository.NewMockAccountNameTombstoneRepository(ctrl)
+			tt.setMockAccountNameTombstoneRepo(accountNameTombstoneRepo)
+
+			serv := service.NewAccountService(accountRepo, accountNameTombstoneRepo, retentionPolicy)
 			err := serv.Exists(ctx, tt.inputAccount)
 			assert.Error(t, err, tt.expectError)
 		})
 	}
 }
+
+func TestAccount_EmailExists(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		Email:    "name@example.com",
+	}
+
+	tests := []struct {
+		name               string
+		inputEmail         string
+		expectError        error
+		setMockAccountRepo func(*repository.MockAccountRepository)
+	}{
+		{
+			name:        "not exists",
+			inputEmail:  "name@example.com",
+			expectError: nil,
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByEmailIncludingDeleted(gomock.Any(), "name@example.com").
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "exists",
+			inputEmail:  "name@example.com",
+			expectError: service.ErrAccountEmailAlreadyInUse,
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByEmailIncludingDeleted(gomock.Any(), "name@example.com").
+					Return(account, nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "find error",
+			inputEmail:  "name@example.com",
+			expectError: sql.ErrConnDone,
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByEmailIncludingDeleted(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find account by email including deleted")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			accountRepo := repository.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			serv := service.NewAccountService(accountRepo, nil, retentionPolicy)
+			err := serv.EmailExists(ctx, tt.inputEmail)
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
+
+func TestAccount_Release(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+
+	tests := []struct {
+		name                            string
+		inputAccount                    *entity.Account
+		expectError                     error
+		setMockAccountRepo              func(*repository.MockAccountRepository)
+		setMockAccountNameTombstoneRepo func(*repository.MockAccountNameTombstoneRepository)
+	}{
+		{
+			name:         "successfully released",
+			inputAccount: account,
+			expectError:  nil,
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					Purge(gomock.Any(), account).
+					Return(nil).
+					Times(1)
+			},
+			setMockAccountNameTombstoneRepo: func(accountNameTombstoneRepo *repository.MockAccountNameTombstoneRepository) {
+				accountNameTombstoneRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                            "nil account",
+			inputAccount:                    nil,
+			expectError:                     entity.ErrAccountNameTombstoneNilAccount,
+			setMockAccountRepo:              func(*repository.MockAccountRepository) {},
+			setMockAccountNameTombstoneRepo: func(*repository.MockAccountNameTombstoneRepository) {},
+		},
+		{
+			name:               "save error",
+			inputAccount:       account,
+			expectError:        sql.ErrConnDone,
+			setMockAccountRepo: func(*repository.MockAccountRepository) {},
+			setMockAccountNameTombstoneRepo: func(accountNameTombstoneRepo *repository.MockAccountNameTombstoneRepository) {
+				accountNameTombstoneRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to save account name tombstone")).
+					Times(1)
+			},
+		},
+		{
+			name:         "purge error",
+			inputAccount: account,
+			expectError:  sql.ErrConnDone,
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					Purge(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to purge account")).
+					Times(1)
+			},
+			setMockAccountNameTombstoneRepo: func(accountNameTombstoneRepo *repository.MockAccountNameTombstoneRepository) {
+				accountNameTombstoneRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			accountRepo := repository.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			accountNameTombstoneRepo := repository.NewMockAccountNameTombstoneRepository(ctrl)
+			tt.setMockAccountNameTombstoneRepo(accountNameTombstoneRepo)
+
+			serv := service.NewAccountService(accountRepo, accountNameTombstoneRepo, retentionPolicy)
+			err := serv.Release(ctx, tt.inputAccount)
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	stderr "errors"
+	"strings"
+	"time"
 
 	"github.com/atsumarukun/holos-api-pkg/errors"
 	"github.com/google/uuid"
@@ -36,7 +38,7 @@ func (r *accountRepository) Create(ctx context.Context, account *entity.Account)
 	driver := transaction.GetDriver(ctx, r.db)
 	model := transformer.ToAccountModel(account)
 
-	if _, err := driver.ExecContext(ctx, `INSERT INTO accounts (id, name, password) VALUES (?, ?, ?);`, model.ID, model.Name, model.Password); err != nil {
+	if _, err := driver.ExecContext(ctx, `INSERT INTO accounts (id, name, password, email, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?);`, model.ID, model.Name, model.Password, model.Email, model.CreatedAt, model.UpdatedAt); err != nil {
 		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
 	}
 
@@ -53,7 +55,7 @@ func (r *accountRepository) Update(ctx context.Context, account *entity.Account)
 	driver := transaction.GetDriver(ctx, r.db)
 	model := transformer.ToAccountModel(account)
 
-	if _, err := driver.ExecContext(ctx, `UPDATE accounts SET name = ?, password = ? WHERE id = ? AND deleted_at IS NULL LIMIT 1;`, model.Name, model.Password, model.ID); err != nil {
+	if _, err := driver.ExecContext(ctx, `UPDATE accounts SET name = ?, password = ?, email = ?, updated_at = ? WHERE id = ? AND deleted_at IS NULL LIMIT 1;`, model.Name, model.Password, model.Email, model.UpdatedAt, model.ID); err != nil {
 		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
 	}
 
@@ -77,23 +79,79 @@ func (r *accountRepository) Delete(ctx context.Context, account *entity.Account)
 	return nil
 }
 
+func (r *accountRepository) Restore(ctx context.Context, account *entity.Account) error {
+	const errMessage = "failed to restore account"
+
+	if account == nil {
+		return errors.Wrap(repository.ErrNilAccount, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToAccountModel(account)
+
+	if _, err := driver.ExecContext(ctx, `UPDATE accounts SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL LIMIT 1;`, model.ID); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *accountRepository) Purge(ctx context.Context, account *entity.Account) error {
+	const errMessage = "failed to purge account"
+
+	if account == nil {
+		return errors.Wrap(repository.ErrNilAccount, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToAccountModel(account)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM accounts WHERE id = ? AND deleted_at IS NOT NULL LIMIT 1;`, model.ID); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
 func (r *accountRepository) FindOneByID(ctx context.Context, id uuid.UUID) (*entity.Account, error) {
 	const errMessage = "faild to find account by id"
 
 	return r.findOne(
 		ctx,
-		`SELECT id, name, password FROM accounts WHERE id = ? AND deleted_at IS NULL LIMIT 1;`,
+		`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE id = ? AND deleted_at IS NULL LIMIT 1;`,
+		[]any{id},
+		errMessage,
+	)
+}
+
+func (r *accountRepository) FindOneByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*entity.Account, error) {
+	const errMessage = "faild to find account by id including deleted"
+
+	return r.findOne(
+		ctx,
+		`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE id = ? LIMIT 1;`,
 		[]any{id},
 		errMessage,
 	)
 }
 
+func (r *accountRepository) FindOneByIDAndDeletedAtAfter(ctx context.Context, id uuid.UUID, deletedAt time.Time) (*entity.Account, error) {
+	const errMessage = "faild to find account by id and deleted_at"
+
+	return r.findOne(
+		ctx,
+		`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE id = ? AND deleted_at IS NOT NULL AND deleted_at > ? LIMIT 1 FOR UPDATE;`,
+		[]any{id, deletedAt},
+		errMessage,
+	)
+}
+
 func (r *accountRepository) FindOneByName(ctx context.Context, name string) (*entity.Account, error) {
 	const errMessage = "faild to find account by name"
 
 	return r.findOne(
 		ctx,
-		`SELECT id, name, password FROM accounts WHERE name = ? AND deleted_at IS NULL LIMIT 1;`,
+		`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? AND deleted_at IS NULL LIMIT 1;`,
 		[]any{name},
 		errMessage,
 	)
@@ -104,12 +162,122 @@ func (r *accountRepository) FindOneByNameIncludingDeleted(ctx context.Context, n
 
 	return r.findOne(
 		ctx,
-		`SELECT id, name, password FROM accounts WHERE name = ? LIMIT 1;`,
+		`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? LIMIT 1;`,
 		[]any{name},
 		errMessage,
 	)
 }
 
+func (r *accountRepository) FindOneByNameExcludingDeletedBefore(ctx context.Context, name string, deletedAt time.Time) (*entity.Account, error) {
+	const errMessage = "faild to find account by name excluding deleted before"
+
+	return r.findOne(
+		ctx,
+		`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? AND (deleted_at IS NULL OR deleted_at > ?) LIMIT 1;`,
+		[]any{name, deletedAt},
+		errMessage,
+	)
+}
+
+func (r *accountRepository) FindOneByNameAndDeletedAtAfter(ctx context.Context, name string, deletedAt time.Time) (*entity.Account, error) {
+	const errMessage = "faild to find account by name and deleted_at"
+
+	return r.findOne(
+		ctx,
+		`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? AND deleted_at IS NOT NULL AND deleted_at > ? LIMIT 1 FOR UPDATE;`,
+		[]any{name, deletedAt},
+		errMessage,
+	)
+}
+
+func (r *accountRepository) FindOneByEmail(ctx context.Context, email string) (*entity.Account, error) {
+	const errMessage = "faild to find account by email"
+
+	return r.findOne(
+		ctx,
+		`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE email = ? AND deleted_at IS NULL LIMIT 1;`,
+		[]any{email},
+		errMessage,
+	)
+}
+
+// NOTE: メールアドレスの一意制約は削除済みのアカウントも対象となるため, 削除済みのアカウントも含めて検索する.
+func (r *accountRepository) FindOneByEmailIncludingDeleted(ctx context.Context, email string) (*entity.Account, error) {
+	const errMessage = "faild to find account by email including deleted"
+
+	return r.findOne(
+		ctx,
+		`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE email = ? LIMIT 1;`,
+		[]any{email},
+		errMessage,
+	)
+}
+
+func (r *accountRepository) FindByDeletedAtBefore(ctx context.Context, deletedAt time.Time) ([]*entity.Account, error) {
+	const errMessage = "faild to find accounts by deleted_at"
+
+	driver := transaction.GetDriver(ctx, r.db)
+	var models []*model.AccountModel
+
+	if err := sqlx.SelectContext(
+		ctx,
+		driver,
+		&models,
+		`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE deleted_at IS NOT NULL AND deleted_at < ? FOR UPDATE;`,
+		deletedAt,
+	); err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return transformer.ToAccountEntities(models), nil
+}
+
+// NOTE: 指定された条件のみを絞り込みに用いるため, 条件に応じてクエリを組み立てる.
+func (r *accountRepository) Search(ctx context.Context, condition repository.AccountSearchCondition) ([]*entity.Account, error) {
+	var conditions []string
+	var args []any
+
+	if condition.NamePrefix != "" {
+		conditions = append(conditions, `name LIKE ?`)
+		args = append(args, escapeLike(condition.NamePrefix)+"%")
+	}
+	if condition.Deleted != nil {
+		if *condition.Deleted {
+			conditions = append(conditions, `deleted_at IS NOT NULL`)
+		} else {
+			conditions = append(conditions, `deleted_at IS NULL`)
+		}
+	}
+	if !condition.CreatedAfter.IsZero() {
+		conditions = append(conditions, `created_at >= ?`)
+		args = append(args, condition.CreatedAfter)
+	}
+	if !condition.CreatedBefore.IsZero() {
+		conditions = append(conditions, `created_at < ?`)
+		args = append(args, condition.CreatedBefore)
+	}
+	if condition.Cursor != nil {
+		conditions = append(conditions, `(created_at < ? OR (created_at = ? AND id < ?))`)
+		args = append(args, condition.Cursor.CreatedAt, condition.Cursor.CreatedAt, condition.Cursor.ID)
+	}
+
+	query := `SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts`
+	if 0 < len(conditions) {
+		query += ` WHERE ` + strings.Join(conditions, ` AND `)
+	}
+	query += ` ORDER BY created_at DESC, id DESC LIMIT ?;`
+	args = append(args, condition.Limit)
+
+	driver := transaction.GetDriver(ctx, r.db)
+	var models []*model.AccountModel
+
+	if err := sqlx.SelectContext(ctx, driver, &models, query, args...); err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, "faild to search accounts")
+	}
+
+	return transformer.ToAccountEntities(models), nil
+}
+
 // nolint:dupl // 集約単位のrepository実装. 集約境界を保つためrepository間での共通化は行わず重複を許容.
 func (r *accountRepository) findOne(ctx context.Context, query string, args []any, errMessage string) (*entity.Account, error) {
 	driver := transaction.GetDriver(ctx, r.db)
@@ -124,3 +292,7 @@ func (r *accountRepository) findOne(ctx context.Context, query string, args []an
 
 	return transformer.ToAccountEntity(&model), nil
 }
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stderr "errors"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/transformer"
+)
+
+type accountNameTombstoneRepository struct {
+	db *sqlx.DB
+}
+
+func NewDBAccountNameTombstoneRepository(db *sqlx.DB) repository.AccountNameTombstoneRepository {
+	return &accountNameTombstoneRepository{
+		db: db,
+	}
+}
+
+func (r *accountNameTombstoneRepository) Save(ctx context.Context, tombstone *entity.AccountNameTombstone) error {
+	const errMessage = "failed to save account name tombstone"
+
+	if tombstone == nil {
+		return errors.Wrap(repository.ErrNilAccountNameTombstone, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToAccountNameTombstoneModel(tombstone)
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`INSERT INTO account_name_tombstones (name, released_at) VALUES (?, ?) ON DUPLICATE KEY UPDATE released_at = VALUES(released_at);`,
+		model.Name,
+		model.ReleasedAt,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *accountNameTombstoneRepository) DeleteByReleasedAtBefore(ctx context.Context, releasedAt time.Time) error {
+	driver := transaction.GetDriver(ctx, r.db)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM account_name_tombstones WHERE released_at < ?;`, releasedAt); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, "failed to delete account name tombstones by released_at")
+	}
+
+	return nil
+}
+
+func (r *accountNameTombstoneRepository) FindOneByNameAndReleasedAtAfter(ctx context.Context, name string, releasedAt time.Time) (*entity.AccountNameTombstone, error) {
+	const errMessage = "faild to find account name tombstone by name and released_at"
+
+	driver := transaction.GetDriver(ctx, r.db)
+	var model model.AccountNameTombstoneModel
+
+	if err := driver.QueryRowxContext(
+		ctx,
+		`SELECT name, released_at FROM account_name_tombstones WHERE name = ? AND released_at > ? LIMIT 1;`,
+		name,
+		releasedAt,
+	).StructScan(&model); err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return transformer.ToAccountNameTombstoneEntity(&model), nil
+}
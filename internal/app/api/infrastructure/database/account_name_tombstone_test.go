@@ -0,0 +1,203 @@
+package database_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	mockDatabase "github.com/atsumarukun/holos-account-api/test/mock/database"
+)
+
+func TestAccountNameTombstone_Save(t *testing.T) {
+	tombstone := &entity.AccountNameTombstone{
+		Name:       "name",
+		ReleasedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name                      string
+		inputAccountNameTombstone *entity.AccountNameTombstone
+		expectError               error
+		setMockDB                 func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:                      "successfully saved",
+			inputAccountNameTombstone: tombstone,
+			expectError:               nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO account_name_tombstones (name, released_at) VALUES (?, ?) ON DUPLICATE KEY UPDATE released_at = VALUES(released_at);`)).
+					WithArgs(tombstone.Name, tombstone.ReleasedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:                      "account name tombstone is nil",
+			inputAccountNameTombstone: nil,
+			expectError:               repository.ErrNilAccountNameTombstone,
+			setMockDB:                 func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:                      "save error",
+			inputAccountNameTombstone: tombstone,
+			expectError:               sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO account_name_tombstones (name, released_at) VALUES (?, ?) ON DUPLICATE KEY UPDATE released_at = VALUES(released_at);`)).
+					WithArgs(tombstone.Name, tombstone.ReleasedAt).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBAccountNameTombstoneRepository(db)
+			err := repo.Save(t.Context(), tt.inputAccountNameTombstone)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAccountNameTombstone_DeleteByReleasedAtBefore(t *testing.T) {
+	releasedAt := time.Now().Add(-time.Hour * 24 * 90)
+
+	tests := []struct {
+		name            string
+		inputReleasedAt time.Time
+		expectError     error
+		setMockDB       func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:            "successfully deleted",
+			inputReleasedAt: releasedAt,
+			expectError:     nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM account_name_tombstones WHERE released_at < ?;`)).
+					WithArgs(releasedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:            "delete error",
+			inputReleasedAt: releasedAt,
+			expectError:     sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM account_name_tombstones WHERE released_at < ?;`)).
+					WithArgs(releasedAt).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBAccountNameTombstoneRepository(db)
+			err := repo.DeleteByReleasedAtBefore(t.Context(), tt.inputReleasedAt)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAccountNameTombstone_FindOneByNameAndReleasedAtAfter(t *testing.T) {
+	tombstone := &entity.AccountNameTombstone{
+		Name:       "name",
+		ReleasedAt: time.Now(),
+	}
+	releasedAt := time.Now().Add(-time.Hour * 24 * 90)
+
+	tests := []struct {
+		name            string
+		inputName       string
+		inputReleasedAt time.Time
+		expectResult    *entity.AccountNameTombstone
+		expectError     error
+		setMockDB       func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:            "successfully found",
+			inputName:       "name",
+			inputReleasedAt: releasedAt,
+			expectResult:    tombstone,
+			expectError:     nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT name, released_at FROM account_name_tombstones WHERE name = ? AND released_at > ? LIMIT 1;`)).
+					WithArgs("name", releasedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"name", "released_at"}).AddRow(tombstone.Name, tombstone.ReleasedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:            "not found",
+			inputName:       "name",
+			inputReleasedAt: releasedAt,
+			expectResult:    nil,
+			expectError:     nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT name, released_at FROM account_name_tombstones WHERE name = ? AND released_at > ? LIMIT 1;`)).
+					WithArgs("name", releasedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"name", "released_at"})).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:            "find error",
+			inputName:       "name",
+			inputReleasedAt: releasedAt,
+			expectResult:    nil,
+			expectError:     sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT name, released_at FROM account_name_tombstones WHERE name = ? AND released_at > ? LIMIT 1;`)).
+					WithArgs("name", releasedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"name", "released_at"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBAccountNameTombstoneRepository(db)
+			result, err := repo.FindOneByNameAndReleasedAtAfter(t.Context(), tt.inputName, tt.inputReleasedAt)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
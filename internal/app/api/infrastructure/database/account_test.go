@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/go-cmp/cmp"
@@ -18,9 +19,12 @@ import (
 
 func TestAccount_Create(t *testing.T) {
 	account := &entity.Account{
-		ID:       uuid.New(),
-		Name:     "name",
-		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		Email:     "name@example.com",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
 	}
 
 	tests := []struct {
@@ -34,8 +38,8 @@ func TestAccount_Create(t *testing.T) {
 			inputAccount: account,
 			expectError:  nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO accounts (id, name, password) VALUES (?, ?, ?);`)).
-					WithArgs(account.ID, account.Name, account.Password).
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO accounts (id, name, password, email, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?);`)).
+					WithArgs(account.ID, account.Name, account.Password, account.Email, account.CreatedAt, account.UpdatedAt).
 					WillReturnResult(sqlmock.NewResult(1, 1)).
 					WillReturnError(nil)
 			},
@@ -51,8 +55,8 @@ func TestAccount_Create(t *testing.T) {
 			inputAccount: account,
 			expectError:  sql.ErrConnDone,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO accounts (id, name, password) VALUES (?, ?, ?);`)).
-					WithArgs(account.ID, account.Name, account.Password).
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO accounts (id, name, password, email, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?);`)).
+					WithArgs(account.ID, account.Name, account.Password, account.Email, account.CreatedAt, account.UpdatedAt).
 					WillReturnResult(nil).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -78,9 +82,12 @@ func TestAccount_Create(t *testing.T) {
 
 func TestAccount_Update(t *testing.T) {
 	account := &entity.Account{
-		ID:       uuid.New(),
-		Name:     "name",
-		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		Email:     "name@example.com",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
 	}
 
 	tests := []struct {
@@ -94,8 +101,8 @@ func TestAccount_Update(t *testing.T) {
 			inputAccount: account,
 			expectError:  nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(regexp.QuoteMeta(`UPDATE accounts SET name = ?, password = ? WHERE id = ? AND deleted_at IS NULL LIMIT 1;`)).
-					WithArgs(account.Name, account.Password, account.ID).
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE accounts SET name = ?, password = ?, email = ?, updated_at = ? WHERE id = ? AND deleted_at IS NULL LIMIT 1;`)).
+					WithArgs(account.Name, account.Password, account.Email, account.UpdatedAt, account.ID).
 					WillReturnResult(sqlmock.NewResult(1, 1)).
 					WillReturnError(nil)
 			},
@@ -111,8 +118,8 @@ func TestAccount_Update(t *testing.T) {
 			inputAccount: account,
 			expectError:  sql.ErrConnDone,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(regexp.QuoteMeta(`UPDATE accounts SET name = ?, password = ? WHERE id = ? AND deleted_at IS NULL LIMIT 1;`)).
-					WithArgs(account.Name, account.Password, account.ID).
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE accounts SET name = ?, password = ?, email = ?, updated_at = ? WHERE id = ? AND deleted_at IS NULL LIMIT 1;`)).
+					WithArgs(account.Name, account.Password, account.Email, account.UpdatedAt, account.ID).
 					WillReturnResult(nil).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -138,9 +145,11 @@ func TestAccount_Update(t *testing.T) {
 
 func TestAccount_Delete(t *testing.T) {
 	account := &entity.Account{
-		ID:       uuid.New(),
-		Name:     "name",
-		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
 	}
 
 	tests := []struct {
@@ -196,11 +205,137 @@ func TestAccount_Delete(t *testing.T) {
 	}
 }
 
+func TestAccount_Restore(t *testing.T) {
+	account := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name         string
+		inputAccount *entity.Account
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully restored",
+			inputAccount: account,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE accounts SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL LIMIT 1;`)).
+					WithArgs(account.ID).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "account is nil",
+			inputAccount: nil,
+			expectError:  repository.ErrNilAccount,
+			setMockDB:    func(sqlmock.Sqlmock) {},
+		},
+		{
+			name:         "restore error",
+			inputAccount: account,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE accounts SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL LIMIT 1;`)).
+					WithArgs(account.ID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBAccountRepository(db)
+			err := repo.Restore(t.Context(), tt.inputAccount)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAccount_Purge(t *testing.T) {
+	account := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name         string
+		inputAccount *entity.Account
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully purged",
+			inputAccount: account,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM accounts WHERE id = ? AND deleted_at IS NOT NULL LIMIT 1;`)).
+					WithArgs(account.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "account is nil",
+			inputAccount: nil,
+			expectError:  repository.ErrNilAccount,
+			setMockDB:    func(sqlmock.Sqlmock) {},
+		},
+		{
+			name:         "purge error",
+			inputAccount: account,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM accounts WHERE id = ? AND deleted_at IS NOT NULL LIMIT 1;`)).
+					WithArgs(account.ID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBAccountRepository(db)
+			err := repo.Purge(t.Context(), tt.inputAccount)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func TestAccount_FindOneByID(t *testing.T) {
 	account := &entity.Account{
-		ID:       uuid.New(),
-		Name:     "name",
-		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
 	}
 
 	tests := []struct {
@@ -216,9 +351,9 @@ func TestAccount_FindOneByID(t *testing.T) {
 			expectResult: account,
 			expectError:  nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password FROM accounts WHERE id = ? AND deleted_at IS NULL LIMIT 1;`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE id = ? AND deleted_at IS NULL LIMIT 1;`)).
 					WithArgs(account.ID).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password"}).AddRow(account.ID, account.Name, account.Password)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"}).AddRow(account.ID, account.Name, account.Password, nil, account.CreatedAt, account.UpdatedAt, nil)).
 					WillReturnError(nil)
 			},
 		},
@@ -228,9 +363,9 @@ func TestAccount_FindOneByID(t *testing.T) {
 			expectResult: nil,
 			expectError:  nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password FROM accounts WHERE id = ? AND deleted_at IS NULL LIMIT 1;`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE id = ? AND deleted_at IS NULL LIMIT 1;`)).
 					WithArgs(account.ID).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password"})).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
 					WillReturnError(nil)
 			},
 		},
@@ -240,9 +375,9 @@ func TestAccount_FindOneByID(t *testing.T) {
 			expectResult: nil,
 			expectError:  sql.ErrConnDone,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password FROM accounts WHERE id = ? AND deleted_at IS NULL LIMIT 1;`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE id = ? AND deleted_at IS NULL LIMIT 1;`)).
 					WithArgs(account.ID).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password"})).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
 					WillReturnError(sql.ErrConnDone)
 			},
 		},
@@ -269,11 +404,89 @@ func TestAccount_FindOneByID(t *testing.T) {
 	}
 }
 
+func TestAccount_FindOneByIDIncludingDeleted(t *testing.T) {
+	account := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		DeletedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name         string
+		inputID      uuid.UUID
+		expectResult *entity.Account
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully found",
+			inputID:      account.ID,
+			expectResult: account,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE id = ? LIMIT 1;`)).
+					WithArgs(account.ID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"}).AddRow(account.ID, account.Name, account.Password, nil, account.CreatedAt, account.UpdatedAt, account.DeletedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "not found",
+			inputID:      account.ID,
+			expectResult: nil,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE id = ? LIMIT 1;`)).
+					WithArgs(account.ID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "find error",
+			inputID:      account.ID,
+			expectResult: nil,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE id = ? LIMIT 1;`)).
+					WithArgs(account.ID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBAccountRepository(db)
+			result, err := repo.FindOneByIDIncludingDeleted(t.Context(), tt.inputID)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func TestAccount_FindOneByName(t *testing.T) {
 	account := &entity.Account{
-		ID:       uuid.New(),
-		Name:     "name",
-		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
 	}
 
 	tests := []struct {
@@ -289,9 +502,9 @@ func TestAccount_FindOneByName(t *testing.T) {
 			expectResult: account,
 			expectError:  nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password FROM accounts WHERE name = ? AND deleted_at IS NULL LIMIT 1;`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? AND deleted_at IS NULL LIMIT 1;`)).
 					WithArgs("name").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password"}).AddRow(account.ID, account.Name, account.Password)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"}).AddRow(account.ID, account.Name, account.Password, nil, account.CreatedAt, account.UpdatedAt, nil)).
 					WillReturnError(nil)
 			},
 		},
@@ -301,9 +514,9 @@ func TestAccount_FindOneByName(t *testing.T) {
 			expectResult: nil,
 			expectError:  nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password FROM accounts WHERE name = ? AND deleted_at IS NULL LIMIT 1;`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? AND deleted_at IS NULL LIMIT 1;`)).
 					WithArgs("name").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password"})).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
 					WillReturnError(nil)
 			},
 		},
@@ -313,9 +526,9 @@ func TestAccount_FindOneByName(t *testing.T) {
 			expectResult: nil,
 			expectError:  sql.ErrConnDone,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password FROM accounts WHERE name = ? AND deleted_at IS NULL LIMIT 1;`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? AND deleted_at IS NULL LIMIT 1;`)).
 					WithArgs("name").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password"})).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
 					WillReturnError(sql.ErrConnDone)
 			},
 		},
@@ -344,9 +557,11 @@ func TestAccount_FindOneByName(t *testing.T) {
 
 func TestAccount_FindOneByNameIncludingDeleted(t *testing.T) {
 	account := &entity.Account{
-		ID:       uuid.New(),
-		Name:     "name",
-		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
 	}
 
 	tests := []struct {
@@ -362,9 +577,9 @@ func TestAccount_FindOneByNameIncludingDeleted(t *testing.T) {
 			expectResult: account,
 			expectError:  nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password FROM accounts WHERE name = ? LIMIT 1;`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? LIMIT 1;`)).
 					WithArgs("name").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password"}).AddRow(account.ID, account.Name, account.Password)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"}).AddRow(account.ID, account.Name, account.Password, nil, account.CreatedAt, account.UpdatedAt, nil)).
 					WillReturnError(nil)
 			},
 		},
@@ -374,9 +589,9 @@ func TestAccount_FindOneByNameIncludingDeleted(t *testing.T) {
 			expectResult: nil,
 			expectError:  nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password FROM accounts WHERE name = ? LIMIT 1;`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? LIMIT 1;`)).
 					WithArgs("name").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password"})).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
 					WillReturnError(nil)
 			},
 		},
@@ -386,9 +601,9 @@ func TestAccount_FindOneByNameIncludingDeleted(t *testing.T) {
 			expectResult: nil,
 			expectError:  sql.ErrConnDone,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password FROM accounts WHERE name = ? LIMIT 1;`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? LIMIT 1;`)).
 					WithArgs("name").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password"})).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
 					WillReturnError(sql.ErrConnDone)
 			},
 		},
@@ -414,3 +629,573 @@ func TestAccount_FindOneByNameIncludingDeleted(t *testing.T) {
 		})
 	}
 }
+
+func TestAccount_FindOneByNameAndDeletedAtAfter(t *testing.T) {
+	account := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	deletedAt := time.Now().Add(-time.Hour * 24 * 30)
+
+	tests := []struct {
+		name           string
+		inputName      string
+		inputDeletedAt time.Time
+		expectResult   *entity.Account
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully found",
+			inputName:      "name",
+			inputDeletedAt: deletedAt,
+			expectResult:   account,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? AND deleted_at IS NOT NULL AND deleted_at > ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs("name", deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"}).AddRow(account.ID, account.Name, account.Password, nil, account.CreatedAt, account.UpdatedAt, nil)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "not found",
+			inputName:      "name",
+			inputDeletedAt: deletedAt,
+			expectResult:   nil,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? AND deleted_at IS NOT NULL AND deleted_at > ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs("name", deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "find error",
+			inputName:      "name",
+			inputDeletedAt: deletedAt,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? AND deleted_at IS NOT NULL AND deleted_at > ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs("name", deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBAccountRepository(db)
+			result, err := repo.FindOneByNameAndDeletedAtAfter(t.Context(), tt.inputName, tt.inputDeletedAt)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAccount_FindOneByIDAndDeletedAtAfter(t *testing.T) {
+	account := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		DeletedAt: time.Now(),
+	}
+	deletedAt := time.Now().Add(-time.Hour * 24 * 30)
+
+	tests := []struct {
+		name           string
+		inputID        uuid.UUID
+		inputDeletedAt time.Time
+		expectResult   *entity.Account
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully found",
+			inputID:        account.ID,
+			inputDeletedAt: deletedAt,
+			expectResult:   account,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE id = ? AND deleted_at IS NOT NULL AND deleted_at > ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(account.ID, deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"}).AddRow(account.ID, account.Name, account.Password, nil, account.CreatedAt, account.UpdatedAt, account.DeletedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "not found",
+			inputID:        account.ID,
+			inputDeletedAt: deletedAt,
+			expectResult:   nil,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE id = ? AND deleted_at IS NOT NULL AND deleted_at > ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(account.ID, deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "find error",
+			inputID:        account.ID,
+			inputDeletedAt: deletedAt,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE id = ? AND deleted_at IS NOT NULL AND deleted_at > ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(account.ID, deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBAccountRepository(db)
+			result, err := repo.FindOneByIDAndDeletedAtAfter(t.Context(), tt.inputID, tt.inputDeletedAt)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAccount_FindOneByNameExcludingDeletedBefore(t *testing.T) {
+	account := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	deletedAt := time.Now().Add(-time.Hour * 24 * 30)
+
+	tests := []struct {
+		name           string
+		inputName      string
+		inputDeletedAt time.Time
+		expectResult   *entity.Account
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully found",
+			inputName:      "name",
+			inputDeletedAt: deletedAt,
+			expectResult:   account,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? AND (deleted_at IS NULL OR deleted_at > ?) LIMIT 1;`)).
+					WithArgs("name", deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"}).AddRow(account.ID, account.Name, account.Password, nil, account.CreatedAt, account.UpdatedAt, nil)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "not found",
+			inputName:      "name",
+			inputDeletedAt: deletedAt,
+			expectResult:   nil,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? AND (deleted_at IS NULL OR deleted_at > ?) LIMIT 1;`)).
+					WithArgs("name", deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "find error",
+			inputName:      "name",
+			inputDeletedAt: deletedAt,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name = ? AND (deleted_at IS NULL OR deleted_at > ?) LIMIT 1;`)).
+					WithArgs("name", deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBAccountRepository(db)
+			result, err := repo.FindOneByNameExcludingDeletedBefore(t.Context(), tt.inputName, tt.inputDeletedAt)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAccount_FindOneByEmail(t *testing.T) {
+	account := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		Email:     "name@example.com",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name         string
+		inputEmail   string
+		expectResult *entity.Account
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully found",
+			inputEmail:   "name@example.com",
+			expectResult: account,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE email = ? AND deleted_at IS NULL LIMIT 1;`)).
+					WithArgs("name@example.com").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"}).AddRow(account.ID, account.Name, account.Password, account.Email, account.CreatedAt, account.UpdatedAt, nil)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "not found",
+			inputEmail:   "name@example.com",
+			expectResult: nil,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE email = ? AND deleted_at IS NULL LIMIT 1;`)).
+					WithArgs("name@example.com").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "find error",
+			inputEmail:   "name@example.com",
+			expectResult: nil,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE email = ? AND deleted_at IS NULL LIMIT 1;`)).
+					WithArgs("name@example.com").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBAccountRepository(db)
+			result, err := repo.FindOneByEmail(t.Context(), tt.inputEmail)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAccount_FindOneByEmailIncludingDeleted(t *testing.T) {
+	account := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		Email:     "name@example.com",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name         string
+		inputEmail   string
+		expectResult *entity.Account
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully found",
+			inputEmail:   "name@example.com",
+			expectResult: account,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE email = ? LIMIT 1;`)).
+					WithArgs("name@example.com").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"}).AddRow(account.ID, account.Name, account.Password, account.Email, account.CreatedAt, account.UpdatedAt, nil)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "not found",
+			inputEmail:   "name@example.com",
+			expectResult: nil,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE email = ? LIMIT 1;`)).
+					WithArgs("name@example.com").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "find error",
+			inputEmail:   "name@example.com",
+			expectResult: nil,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE email = ? LIMIT 1;`)).
+					WithArgs("name@example.com").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBAccountRepository(db)
+			result, err := repo.FindOneByEmailIncludingDeleted(t.Context(), tt.inputEmail)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAccount_FindByDeletedAtBefore(t *testing.T) {
+	account := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	deletedAt := time.Now().Add(-time.Hour * 24 * 30)
+
+	tests := []struct {
+		name           string
+		inputDeletedAt time.Time
+		expectResult   []*entity.Account
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully found",
+			inputDeletedAt: deletedAt,
+			expectResult:   []*entity.Account{account},
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE deleted_at IS NOT NULL AND deleted_at < ? FOR UPDATE;`)).
+					WithArgs(deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"}).AddRow(account.ID, account.Name, account.Password, nil, account.CreatedAt, account.UpdatedAt, nil)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "not found",
+			inputDeletedAt: deletedAt,
+			expectResult:   nil,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE deleted_at IS NOT NULL AND deleted_at < ? FOR UPDATE;`)).
+					WithArgs(deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "find error",
+			inputDeletedAt: deletedAt,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE deleted_at IS NOT NULL AND deleted_at < ? FOR UPDATE;`)).
+					WithArgs(deletedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBAccountRepository(db)
+			result, err := repo.FindByDeletedAtBefore(t.Context(), tt.inputDeletedAt)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAccount_Search(t *testing.T) {
+	account := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	deleted := false
+	createdAfter := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdBefore := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	cursor := &repository.AccountCursor{
+		CreatedAt: time.Now(),
+		ID:        uuid.New(),
+	}
+
+	tests := []struct {
+		name           string
+		inputCondition repository.AccountSearchCondition
+		expectResult   []*entity.Account
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully found",
+			inputCondition: repository.AccountSearchCondition{Limit: 20},
+			expectResult:   []*entity.Account{account},
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts ORDER BY created_at DESC, id DESC LIMIT ?;`)).
+					WithArgs(20).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"}).AddRow(account.ID, account.Name, account.Password, nil, account.CreatedAt, account.UpdatedAt, nil)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name: "successfully found with conditions",
+			inputCondition: repository.AccountSearchCondition{
+				NamePrefix:    "na_%",
+				Deleted:       &deleted,
+				CreatedAfter:  createdAfter,
+				CreatedBefore: createdBefore,
+				Cursor:        cursor,
+				Limit:         20,
+			},
+			expectResult: []*entity.Account{account},
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts WHERE name LIKE ? AND deleted_at IS NULL AND created_at >= ? AND created_at < ? AND (created_at < ? OR (created_at = ? AND id < ?)) ORDER BY created_at DESC, id DESC LIMIT ?;`)).
+					WithArgs(`na\_\%%`, createdAfter, createdBefore, cursor.CreatedAt, cursor.CreatedAt, cursor.ID, 20).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"}).AddRow(account.ID, account.Name, account.Password, nil, account.CreatedAt, account.UpdatedAt, nil)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "not found",
+			inputCondition: repository.AccountSearchCondition{Limit: 20},
+			expectResult:   nil,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts ORDER BY created_at DESC, id DESC LIMIT ?;`)).
+					WithArgs(20).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "find error",
+			inputCondition: repository.AccountSearchCondition{Limit: 20},
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, password, email, created_at, updated_at, deleted_at FROM accounts ORDER BY created_at DESC, id DESC LIMIT ?;`)).
+					WithArgs(20).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "updated_at", "deleted_at"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBAccountRepository(db)
+			result, err := repo.Search(t.Context(), tt.inputCondition)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/hash"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/transformer"
+)
+
+type emailVerificationRepository struct {
+	db     *sqlx.DB
+	secret []byte
+}
+
+// NOTE: DB漏洩時に確認前のメールアドレスを本人の操作なしに確認済みにされないよう, 確認トークンはハッシュ化した値のみを保存する.
+func NewDBEmailVerificationRepository(db *sqlx.DB, secret []byte) repository.EmailVerificationRepository {
+	return &emailVerificationRepository{
+		db:     db,
+		secret: secret,
+	}
+}
+
+func (r *emailVerificationRepository) Save(ctx context.Context, verification *entity.EmailVerification) error {
+	const errMessage = "failed to save email verification"
+
+	if verification == nil {
+		return errors.Wrap(repository.ErrNilEmailVerification, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToEmailVerificationModel(verification)
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`INSERT INTO email_verifications (account_id, email, token, expires_at, created_at) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE email = VALUES(email), token = VALUES(token), expires_at = VALUES(expires_at), created_at = VALUES(created_at);`,
+		model.AccountID,
+		model.Email,
+		hash.HMAC(r.secret, model.Token),
+		model.ExpiresAt,
+		model.CreatedAt,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *emailVerificationRepository) Delete(ctx context.Context, verification *entity.EmailVerification) error {
+	const errMessage = "failed to delete email verification"
+
+	if verification == nil {
+		return errors.Wrap(repository.ErrNilEmailVerification, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToEmailVerificationModel(verification)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM email_verifications WHERE account_id = ?;`, model.AccountID); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *emailVerificationRepository) DeleteExpired(ctx context.Context) error {
+	driver := transaction.GetDriver(ctx, r.db)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM email_verifications WHERE expires_at <= NOW(6);`); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, "failed to delete expired email verifications")
+	}
+
+	return nil
+}
+
+func (r *emailVerificationRepository) FindOneByTokenAndNotExpired(ctx context.Context, token string) (*entity.EmailVerification, error) {
+	const errMessage = "faild to find email verification by token and not expired"
+
+	driver := transaction.GetDriver(ctx, r.db)
+	var model model.EmailVerificationModel
+
+	if err := driver.QueryRowxContext(
+		ctx,
+		`SELECT account_id, email, token, expires_at, created_at FROM email_verifications WHERE token = ? AND expires_at > NOW(6) LIMIT 1 FOR UPDATE;`,
+		hash.HMAC(r.secret, token),
+	).StructScan(&model); err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return transformer.ToEmailVerificationEntity(&model), nil
+}
@@ -0,0 +1,263 @@
+package database_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/hash"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	mockDatabase "github.com/atsumarukun/holos-account-api/test/mock/database"
+)
+
+var emailVerificationColumns = []string{"account_id", "email", "token", "expires_at", "created_at"}
+
+func TestEmailVerification_Save(t *testing.T) {
+	verification := &entity.EmailVerification{
+		AccountID: uuid.New(),
+		Email:     "name@example.com",
+		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		ExpiresAt: time.Now().Add(time.Hour * 24),
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name                   string
+		inputEmailVerification *entity.EmailVerification
+		expectError            error
+		setMockDB              func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:                   "successfully saved",
+			inputEmailVerification: verification,
+			expectError:            nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO email_verifications (account_id, email, token, expires_at, created_at) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE email = VALUES(email), token = VALUES(token), expires_at = VALUES(expires_at), created_at = VALUES(created_at);`)).
+					WithArgs(verification.AccountID, verification.Email, hash.HMAC(sessionTokenSecret, verification.Token), verification.ExpiresAt, verification.CreatedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:                   "email verification is nil",
+			inputEmailVerification: nil,
+			expectError:            repository.ErrNilEmailVerification,
+			setMockDB:              func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:                   "save error",
+			inputEmailVerification: verification,
+			expectError:            sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO email_verifications (account_id, email, token, expires_at, created_at) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE email = VALUES(email), token = VALUES(token), expires_at = VALUES(expires_at), created_at = VALUES(created_at);`)).
+					WithArgs(verification.AccountID, verification.Email, hash.HMAC(sessionTokenSecret, verification.Token), verification.ExpiresAt, verification.CreatedAt).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBEmailVerificationRepository(db, sessionTokenSecret)
+			err := repo.Save(t.Context(), tt.inputEmailVerification)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestEmailVerification_Delete(t *testing.T) {
+	verification := &entity.EmailVerification{
+		AccountID: uuid.New(),
+		Email:     "name@example.com",
+		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		ExpiresAt: time.Now().Add(time.Hour * 24),
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name                   string
+		inputEmailVerification *entity.EmailVerification
+		expectError            error
+		setMockDB              func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:                   "successfully deleted",
+			inputEmailVerification: verification,
+			expectError:            nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM email_verifications WHERE account_id = ?;`)).
+					WithArgs(verification.AccountID).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:                   "email verification is nil",
+			inputEmailVerification: nil,
+			expectError:            repository.ErrNilEmailVerification,
+			setMockDB:              func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:                   "delete error",
+			inputEmailVerification: verification,
+			expectError:            sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM email_verifications WHERE account_id = ?;`)).
+					WithArgs(verification.AccountID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBEmailVerificationRepository(db, sessionTokenSecret)
+			err := repo.Delete(t.Context(), tt.inputEmailVerification)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestEmailVerification_DeleteExpired(t *testing.T) {
+	tests := []struct {
+		name        string
+		expectError error
+		setMockDB   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:        "successfully deleted",
+			expectError: nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM email_verifications WHERE expires_at <= NOW(6);`)).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:        "delete error",
+			expectError: sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM email_verifications WHERE expires_at <= NOW(6);`)).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBEmailVerificationRepository(db, sessionTokenSecret)
+			err := repo.DeleteExpired(t.Context())
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestEmailVerification_FindOneByTokenAndNotExpired(t *testing.T) {
+	verification := &entity.EmailVerification{
+		AccountID: uuid.New(),
+		Email:     "name@example.com",
+		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		ExpiresAt: time.Now().Add(time.Hour * 24),
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name         string
+		inputToken   string
+		expectResult *entity.EmailVerification
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully found",
+			inputToken:   verification.Token,
+			expectResult: verification,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, email, token, expires_at, created_at FROM email_verifications WHERE token = ? AND expires_at > NOW(6) LIMIT 1 FOR UPDATE;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, verification.Token)).
+					WillReturnRows(sqlmock.NewRows(emailVerificationColumns).AddRow(verification.AccountID, verification.Email, verification.Token, verification.ExpiresAt, verification.CreatedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "not found",
+			inputToken:   verification.Token,
+			expectResult: nil,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, email, token, expires_at, created_at FROM email_verifications WHERE token = ? AND expires_at > NOW(6) LIMIT 1 FOR UPDATE;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, verification.Token)).
+					WillReturnRows(sqlmock.NewRows(emailVerificationColumns)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "find error",
+			inputToken:   verification.Token,
+			expectResult: nil,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, email, token, expires_at, created_at FROM email_verifications WHERE token = ? AND expires_at > NOW(6) LIMIT 1 FOR UPDATE;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, verification.Token)).
+					WillReturnRows(sqlmock.NewRows(emailVerificationColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBEmailVerificationRepository(db, sessionTokenSecret)
+			result, err := repo.FindOneByTokenAndNotExpired(t.Context(), tt.inputToken)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
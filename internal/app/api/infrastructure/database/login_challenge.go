@@ -0,0 +1,142 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/hash"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/transformer"
+)
+
+type loginChallengeRepository struct {
+	db     *sqlx.DB
+	secret []byte
+}
+
+// NOTE: トークンはパスワード認証を通過した状態を表すため, DB漏洩時にパスワードを知らずに2要素認証へ進まれないようハッシュ化した値のみを保存する.
+func NewDBLoginChallengeRepository(db *sqlx.DB, secret []byte) repository.LoginChallengeRepository {
+	return &loginChallengeRepository{
+		db:     db,
+		secret: secret,
+	}
+}
+
+func (r *loginChallengeRepository) Create(ctx context.Context, challenge *entity.LoginChallenge) error {
+	const errMessage = "failed to create login challenge"
+
+	if challenge == nil {
+		return errors.Wrap(repository.ErrNilLoginChallenge, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToLoginChallengeModel(challenge)
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`INSERT INTO login_challenges (id, account_id, token, remember_me, attempts, expires_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?);`,
+		model.ID,
+		model.AccountID,
+		hash.HMAC(r.secret, model.Token),
+		model.RememberMe,
+		model.Attempts,
+		model.ExpiresAt,
+		model.CreatedAt,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *loginChallengeRepository) Update(ctx context.Context, challenge *entity.LoginChallenge) error {
+	const errMessage = "failed to update login challenge"
+
+	if challenge == nil {
+		return errors.Wrap(repository.ErrNilLoginChallenge, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToLoginChallengeModel(challenge)
+
+	if _, err := driver.ExecContext(ctx, `UPDATE login_challenges SET attempts = ? WHERE id = ?;`, model.Attempts, model.ID); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *loginChallengeRepository) Delete(ctx context.Context, challenge *entity.LoginChallenge) error {
+	const errMessage = "failed to delete login challenge"
+
+	if challenge == nil {
+		return errors.Wrap(repository.ErrNilLoginChallenge, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToLoginChallengeModel(challenge)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM login_challenges WHERE id = ?;`, model.ID); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *loginChallengeRepository) DeleteExpired(ctx context.Context) error {
+	driver := transaction.GetDriver(ctx, r.db)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM login_challenges WHERE expires_at <= NOW(6);`); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, "failed to delete expired login challenges")
+	}
+
+	return nil
+}
+
+func (r *loginChallengeRepository) FindOneByIDForUpdate(ctx context.Context, id uuid.UUID) (*entity.LoginChallenge, error) {
+	const errMessage = "faild to find login challenge by id for update"
+
+	driver := transaction.GetDriver(ctx, r.db)
+	var model model.LoginChallengeModel
+
+	if err := driver.QueryRowxContext(
+		ctx,
+		`SELECT id, account_id, token, remember_me, attempts, expires_at, created_at FROM login_challenges WHERE id = ? LIMIT 1 FOR UPDATE;`,
+		id,
+	).StructScan(&model); err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return transformer.ToLoginChallengeEntity(&model), nil
+}
+
+func (r *loginChallengeRepository) FindOneByTokenAndNotExpired(ctx context.Context, token string) (*entity.LoginChallenge, error) {
+	const errMessage = "faild to find login challenge by token and not expired"
+
+	driver := transaction.GetDriver(ctx, r.db)
+	var model model.LoginChallengeModel
+
+	if err := driver.QueryRowxContext(
+		ctx,
+		`SELECT id, account_id, token, remember_me, attempts, expires_at, created_at FROM login_challenges WHERE token = ? AND expires_at > NOW(6) LIMIT 1;`,
+		hash.HMAC(r.secret, token),
+	).StructScan(&model); err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return transformer.ToLoginChallengeEntity(&model), nil
+}
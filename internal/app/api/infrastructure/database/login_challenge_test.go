@@ -0,0 +1,410 @@
+package database_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/hash"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	mockDatabase "github.com/atsumarukun/holos-account-api/test/mock/database"
+)
+
+var loginChallengeColumns = []string{"id", "account_id", "token", "remember_me", "attempts", "expires_at", "created_at"}
+
+func TestLoginChallenge_Create(t *testing.T) {
+	challenge := &entity.LoginChallenge{
+		ID:         uuid.New(),
+		AccountID:  uuid.New(),
+		Token:      "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RememberMe: true,
+		Attempts:   1,
+		ExpiresAt:  time.Now().Add(time.Minute * 5),
+		CreatedAt:  time.Now(),
+	}
+
+	tests := []struct {
+		name                string
+		inputLoginChallenge *entity.LoginChallenge
+		expectError         error
+		setMockDB           func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:                "successfully created",
+			inputLoginChallenge: challenge,
+			expectError:         nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO login_challenges (id, account_id, token, remember_me, attempts, expires_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?);`)).
+					WithArgs(challenge.ID, challenge.AccountID, hash.HMAC(sessionTokenSecret, challenge.Token), challenge.RememberMe, challenge.Attempts, challenge.ExpiresAt, challenge.CreatedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:                "login challenge is nil",
+			inputLoginChallenge: nil,
+			expectError:         repository.ErrNilLoginChallenge,
+			setMockDB:           func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:                "create error",
+			inputLoginChallenge: challenge,
+			expectError:         sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO login_challenges (id, account_id, token, remember_me, attempts, expires_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?);`)).
+					WithArgs(challenge.ID, challenge.AccountID, hash.HMAC(sessionTokenSecret, challenge.Token), challenge.RememberMe, challenge.Attempts, challenge.ExpiresAt, challenge.CreatedAt).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBLoginChallengeRepository(db, sessionTokenSecret)
+			err := repo.Create(t.Context(), tt.inputLoginChallenge)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestLoginChallenge_Update(t *testing.T) {
+	challenge := &entity.LoginChallenge{
+		ID:         uuid.New(),
+		AccountID:  uuid.New(),
+		Token:      "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RememberMe: true,
+		Attempts:   1,
+		ExpiresAt:  time.Now().Add(time.Minute * 5),
+		CreatedAt:  time.Now(),
+	}
+
+	tests := []struct {
+		name                string
+		inputLoginChallenge *entity.LoginChallenge
+		expectError         error
+		setMockDB           func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:                "successfully updated",
+			inputLoginChallenge: challenge,
+			expectError:         nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE login_challenges SET attempts = ? WHERE id = ?;`)).
+					WithArgs(challenge.Attempts, challenge.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:                "login challenge is nil",
+			inputLoginChallenge: nil,
+			expectError:         repository.ErrNilLoginChallenge,
+			setMockDB:           func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:                "update error",
+			inputLoginChallenge: challenge,
+			expectError:         sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE login_challenges SET attempts = ? WHERE id = ?;`)).
+					WithArgs(challenge.Attempts, challenge.ID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBLoginChallengeRepository(db, sessionTokenSecret)
+			err := repo.Update(t.Context(), tt.inputLoginChallenge)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestLoginChallenge_Delete(t *testing.T) {
+	challenge := &entity.LoginChallenge{
+		ID:         uuid.New(),
+		AccountID:  uuid.New(),
+		Token:      "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RememberMe: true,
+		Attempts:   1,
+		ExpiresAt:  time.Now().Add(time.Minute * 5),
+		CreatedAt:  time.Now(),
+	}
+
+	tests := []struct {
+		name                string
+		inputLoginChallenge *entity.LoginChallenge
+		expectError         error
+		setMockDB           func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:                "successfully deleted",
+			inputLoginChallenge: challenge,
+			expectError:         nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM login_challenges WHERE id = ?;`)).
+					WithArgs(challenge.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:                "login challenge is nil",
+			inputLoginChallenge: nil,
+			expectError:         repository.ErrNilLoginChallenge,
+			setMockDB:           func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:                "delete error",
+			inputLoginChallenge: challenge,
+			expectError:         sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM login_challenges WHERE id = ?;`)).
+					WithArgs(challenge.ID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBLoginChallengeRepository(db, sessionTokenSecret)
+			err := repo.Delete(t.Context(), tt.inputLoginChallenge)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestLoginChallenge_DeleteExpired(t *testing.T) {
+	tests := []struct {
+		name        string
+		expectError error
+		setMockDB   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:        "successfully deleted",
+			expectError: nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM login_challenges WHERE expires_at <= NOW(6);`)).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:        "delete error",
+			expectError: sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM login_challenges WHERE expires_at <= NOW(6);`)).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBLoginChallengeRepository(db, sessionTokenSecret)
+			err := repo.DeleteExpired(t.Context())
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestLoginChallenge_FindOneByIDForUpdate(t *testing.T) {
+	challenge := &entity.LoginChallenge{
+		ID:         uuid.New(),
+		AccountID:  uuid.New(),
+		Token:      "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RememberMe: true,
+		Attempts:   1,
+		ExpiresAt:  time.Now().Add(time.Minute * 5),
+		CreatedAt:  time.Now(),
+	}
+
+	tests := []struct {
+		name         string
+		inputID      uuid.UUID
+		expectResult *entity.LoginChallenge
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully found",
+			inputID:      challenge.ID,
+			expectResult: challenge,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, remember_me, attempts, expires_at, created_at FROM login_challenges WHERE id = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(challenge.ID).
+					WillReturnRows(sqlmock.NewRows(loginChallengeColumns).AddRow(challenge.ID, challenge.AccountID, challenge.Token, challenge.RememberMe, challenge.Attempts, challenge.ExpiresAt, challenge.CreatedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "not found",
+			inputID:      challenge.ID,
+			expectResult: nil,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, remember_me, attempts, expires_at, created_at FROM login_challenges WHERE id = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(challenge.ID).
+					WillReturnRows(sqlmock.NewRows(loginChallengeColumns)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "find error",
+			inputID:      challenge.ID,
+			expectResult: nil,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, remember_me, attempts, expires_at, created_at FROM login_challenges WHERE id = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(challenge.ID).
+					WillReturnRows(sqlmock.NewRows(loginChallengeColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBLoginChallengeRepository(db, sessionTokenSecret)
+			result, err := repo.FindOneByIDForUpdate(t.Context(), tt.inputID)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestLoginChallenge_FindOneByTokenAndNotExpired(t *testing.T) {
+	challenge := &entity.LoginChallenge{
+		ID:         uuid.New(),
+		AccountID:  uuid.New(),
+		Token:      "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RememberMe: true,
+		Attempts:   1,
+		ExpiresAt:  time.Now().Add(time.Minute * 5),
+		CreatedAt:  time.Now(),
+	}
+
+	tests := []struct {
+		name         string
+		inputToken   string
+		expectResult *entity.LoginChallenge
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully found",
+			inputToken:   challenge.Token,
+			expectResult: challenge,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, remember_me, attempts, expires_at, created_at FROM login_challenges WHERE token = ? AND expires_at > NOW(6) LIMIT 1;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, challenge.Token)).
+					WillReturnRows(sqlmock.NewRows(loginChallengeColumns).AddRow(challenge.ID, challenge.AccountID, challenge.Token, challenge.RememberMe, challenge.Attempts, challenge.ExpiresAt, challenge.CreatedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "not found",
+			inputToken:   challenge.Token,
+			expectResult: nil,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, remember_me, attempts, expires_at, created_at FROM login_challenges WHERE token = ? AND expires_at > NOW(6) LIMIT 1;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, challenge.Token)).
+					WillReturnRows(sqlmock.NewRows(loginChallengeColumns)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "find error",
+			inputToken:   challenge.Token,
+			expectResult: nil,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, remember_me, attempts, expires_at, created_at FROM login_challenges WHERE token = ? AND expires_at > NOW(6) LIMIT 1;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, challenge.Token)).
+					WillReturnRows(sqlmock.NewRows(loginChallengeColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBLoginChallengeRepository(db, sessionTokenSecret)
+			result, err := repo.FindOneByTokenAndNotExpired(t.Context(), tt.inputToken)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stderr "errors"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/transformer"
+)
+
+type loginFailureRepository struct {
+	db *sqlx.DB
+}
+
+func NewDBLoginFailureRepository(db *sqlx.DB) repository.LoginFailureRepository {
+	return &loginFailureRepository{
+		db: db,
+	}
+}
+
+func (r *loginFailureRepository) Save(ctx context.Context, failure *entity.LoginFailure) error {
+	const errMessage = "failed to save login failure"
+
+	if failure == nil {
+		return errors.Wrap(repository.ErrNilLoginFailure, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToLoginFailureModel(failure)
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`INSERT INTO login_failures (target_type, target, count, locked_until, last_failed_at) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE count = VALUES(count), locked_until = VALUES(locked_until), last_failed_at = VALUES(last_failed_at);`,
+		model.TargetType,
+		model.Target,
+		model.Count,
+		model.LockedUntil,
+		model.LastFailedAt,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+// NOTE: 既存の行は更新せず, 存在しない場合のみ失敗回数0の行を作成する.
+func (r *loginFailureRepository) Ensure(ctx context.Context, failure *entity.LoginFailure) error {
+	const errMessage = "failed to ensure login failure"
+
+	if failure == nil {
+		return errors.Wrap(repository.ErrNilLoginFailure, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToLoginFailureModel(failure)
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`INSERT INTO login_failures (target_type, target, count, locked_until, last_failed_at) VALUES (?, ?, 0, NULL, ?) ON DUPLICATE KEY UPDATE count = count;`,
+		model.TargetType,
+		model.Target,
+		time.Now(),
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *loginFailureRepository) Delete(ctx context.Context, failure *entity.LoginFailure) error {
+	const errMessage = "failed to delete login failure"
+
+	if failure == nil {
+		return errors.Wrap(repository.ErrNilLoginFailure, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToLoginFailureModel(failure)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM login_failures WHERE target_type = ? AND target = ? LIMIT 1;`, model.TargetType, model.Target); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *loginFailureRepository) DeleteByLastFailedAtBefore(ctx context.Context, lastFailedAt time.Time) error {
+	driver := transaction.GetDriver(ctx, r.db)
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`DELETE FROM login_failures WHERE last_failed_at < ? AND (locked_until IS NULL OR locked_until < ?);`,
+		lastFailedAt,
+		lastFailedAt,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, "failed to delete login failures by last_failed_at")
+	}
+
+	return nil
+}
+
+func (r *loginFailureRepository) FindOneByTarget(ctx context.Context, targetType, target string) (*entity.LoginFailure, error) {
+	const errMessage = "faild to find login failure by target"
+
+	driver := transaction.GetDriver(ctx, r.db)
+	var model model.LoginFailureModel
+
+	if err := driver.QueryRowxContext(
+		ctx,
+		`SELECT target_type, target, count, locked_until, last_failed_at FROM login_failures WHERE target_type = ? AND target = ? LIMIT 1;`,
+		targetType,
+		target,
+	).StructScan(&model); err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return transformer.ToLoginFailureEntity(&model), nil
+}
+
+func (r *loginFailureRepository) FindOneByTargetForUpdate(ctx context.Context, targetType, target string) (*entity.LoginFailure, error) {
+	const errMessage = "faild to find login failure by target for update"
+
+	driver := transaction.GetDriver(ctx, r.db)
+	var model model.LoginFailureModel
+
+	if err := driver.QueryRowxContext(
+		ctx,
+		`SELECT target_type, target, count, locked_until, last_failed_at FROM login_failures WHERE target_type = ? AND target = ? LIMIT 1 FOR UPDATE;`,
+		targetType,
+		target,
+	).StructScan(&model); err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return transformer.ToLoginFailureEntity(&model), nil
+}
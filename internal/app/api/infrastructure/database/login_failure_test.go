@@ -0,0 +1,418 @@
+package database_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	mockDatabase "github.com/atsumarukun/holos-account-api/test/mock/database"
+)
+
+var loginFailureColumns = []string{"target_type", "target", "count", "locked_until", "last_failed_at"}
+
+func TestLoginFailure_Save(t *testing.T) {
+	failure := &entity.LoginFailure{
+		TargetType:   entity.LoginFailureTargetAccount,
+		Target:       "name",
+		Count:        5,
+		LockedUntil:  time.Now().Add(time.Minute),
+		LastFailedAt: time.Now(),
+	}
+	unlockedFailure := &entity.LoginFailure{
+		TargetType:   entity.LoginFailureTargetIP,
+		Target:       "192.0.2.1",
+		Count:        1,
+		LastFailedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name              string
+		inputLoginFailure *entity.LoginFailure
+		expectError       error
+		setMockDB         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:              "successfully saved",
+			inputLoginFailure: failure,
+			expectError:       nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO login_failures (target_type, target, count, locked_until, last_failed_at) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE count = VALUES(count), locked_until = VALUES(locked_until), last_failed_at = VALUES(last_failed_at);`)).
+					WithArgs(failure.TargetType, failure.Target, failure.Count, failure.LockedUntil, failure.LastFailedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:              "successfully saved without lock",
+			inputLoginFailure: unlockedFailure,
+			expectError:       nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO login_failures (target_type, target, count, locked_until, last_failed_at) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE count = VALUES(count), locked_until = VALUES(locked_until), last_failed_at = VALUES(last_failed_at);`)).
+					WithArgs(unlockedFailure.TargetType, unlockedFailure.Target, unlockedFailure.Count, nil, unlockedFailure.LastFailedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:              "login failure is nil",
+			inputLoginFailure: nil,
+			expectError:       repository.ErrNilLoginFailure,
+			setMockDB:         func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:              "save error",
+			inputLoginFailure: failure,
+			expectError:       sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO login_failures (target_type, target, count, locked_until, last_failed_at) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE count = VALUES(count), locked_until = VALUES(locked_until), last_failed_at = VALUES(last_failed_at);`)).
+					WithArgs(failure.TargetType, failure.Target, failure.Count, failure.LockedUntil, failure.LastFailedAt).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBLoginFailureRepository(db)
+			err := repo.Save(t.Context(), tt.inputLoginFailure)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestLoginFailure_Ensure(t *testing.T) {
+	failure := entity.NewLoginFailure(entity.LoginFailureTargetAccount, "name")
+
+	tests := []struct {
+		name              string
+		inputLoginFailure *entity.LoginFailure
+		expectError       error
+		setMockDB         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:              "successfully ensured",
+			inputLoginFailure: failure,
+			expectError:       nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO login_failures (target_type, target, count, locked_until, last_failed_at) VALUES (?, ?, 0, NULL, ?) ON DUPLICATE KEY UPDATE count = count;`)).
+					WithArgs(failure.TargetType, failure.Target, sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:              "login failure is nil",
+			inputLoginFailure: nil,
+			expectError:       repository.ErrNilLoginFailure,
+			setMockDB:         func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:              "ensure error",
+			inputLoginFailure: failure,
+			expectError:       sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO login_failures (target_type, target, count, locked_until, last_failed_at) VALUES (?, ?, 0, NULL, ?) ON DUPLICATE KEY UPDATE count = count;`)).
+					WithArgs(failure.TargetType, failure.Target, sqlmock.AnyArg()).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBLoginFailureRepository(db)
+			err := repo.Ensure(t.Context(), tt.inputLoginFailure)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestLoginFailure_Delete(t *testing.T) {
+	failure := entity.NewLoginFailure(entity.LoginFailureTargetAccount, "name")
+
+	tests := []struct {
+		name              string
+		inputLoginFailure *entity.LoginFailure
+		expectError       error
+		setMockDB         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:              "successfully deleted",
+			inputLoginFailure: failure,
+			expectError:       nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM login_failures WHERE target_type = ? AND target = ? LIMIT 1;`)).
+					WithArgs(failure.TargetType, failure.Target).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:              "login failure is nil",
+			inputLoginFailure: nil,
+			expectError:       repository.ErrNilLoginFailure,
+			setMockDB:         func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:              "delete error",
+			inputLoginFailure: failure,
+			expectError:       sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM login_failures WHERE target_type = ? AND target = ? LIMIT 1;`)).
+					WithArgs(failure.TargetType, failure.Target).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBLoginFailureRepository(db)
+			err := repo.Delete(t.Context(), tt.inputLoginFailure)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestLoginFailure_DeleteByLastFailedAtBefore(t *testing.T) {
+	lastFailedAt := time.Now().Add(-time.Minute * 15)
+
+	tests := []struct {
+		name              string
+		inputLastFailedAt time.Time
+		expectError       error
+		setMockDB         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:              "successfully deleted",
+			inputLastFailedAt: lastFailedAt,
+			expectError:       nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM login_failures WHERE last_failed_at < ? AND (locked_until IS NULL OR locked_until < ?);`)).
+					WithArgs(lastFailedAt, lastFailedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:              "delete error",
+			inputLastFailedAt: lastFailedAt,
+			expectError:       sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM login_failures WHERE last_failed_at < ? AND (locked_until IS NULL OR locked_until < ?);`)).
+					WithArgs(lastFailedAt, lastFailedAt).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBLoginFailureRepository(db)
+			err := repo.DeleteByLastFailedAtBefore(t.Context(), tt.inputLastFailedAt)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestLoginFailure_FindOneByTarget(t *testing.T) {
+	failure := &entity.LoginFailure{
+		TargetType:   entity.LoginFailureTargetAccount,
+		Target:       "name",
+		Count:        5,
+		LockedUntil:  time.Now().Add(time.Minute),
+		LastFailedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name            string
+		inputTargetType string
+		inputTarget     string
+		expectResult    *entity.LoginFailure
+		expectError     error
+		setMockDB       func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:            "successfully found",
+			inputTargetType: entity.LoginFailureTargetAccount,
+			inputTarget:     "name",
+			expectResult:    failure,
+			expectError:     nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT target_type, target, count, locked_until, last_failed_at FROM login_failures WHERE target_type = ? AND target = ? LIMIT 1;`)).
+					WithArgs(entity.LoginFailureTargetAccount, "name").
+					WillReturnRows(sqlmock.NewRows(loginFailureColumns).AddRow(failure.TargetType, failure.Target, failure.Count, failure.LockedUntil, failure.LastFailedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:            "not found",
+			inputTargetType: entity.LoginFailureTargetAccount,
+			inputTarget:     "name",
+			expectResult:    nil,
+			expectError:     nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT target_type, target, count, locked_until, last_failed_at FROM login_failures WHERE target_type = ? AND target = ? LIMIT 1;`)).
+					WithArgs(entity.LoginFailureTargetAccount, "name").
+					WillReturnRows(sqlmock.NewRows(loginFailureColumns)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:            "find error",
+			inputTargetType: entity.LoginFailureTargetAccount,
+			inputTarget:     "name",
+			expectResult:    nil,
+			expectError:     sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT target_type, target, count, locked_until, last_failed_at FROM login_failures WHERE target_type = ? AND target = ? LIMIT 1;`)).
+					WithArgs(entity.LoginFailureTargetAccount, "name").
+					WillReturnRows(sqlmock.NewRows(loginFailureColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBLoginFailureRepository(db)
+			result, err := repo.FindOneByTarget(t.Context(), tt.inputTargetType, tt.inputTarget)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestLoginFailure_FindOneByTargetForUpdate(t *testing.T) {
+	failure := &entity.LoginFailure{
+		TargetType:   entity.LoginFailureTargetAccount,
+		Target:       "name",
+		Count:        5,
+		LockedUntil:  time.Now().Add(time.Minute),
+		LastFailedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name            string
+		inputTargetType string
+		inputTarget     string
+		expectResult    *entity.LoginFailure
+		expectError     error
+		setMockDB       func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:            "successfully found",
+			inputTargetType: entity.LoginFailureTargetAccount,
+			inputTarget:     "name",
+			expectResult:    failure,
+			expectError:     nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT target_type, target, count, locked_until, last_failed_at FROM login_failures WHERE target_type = ? AND target = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(entity.LoginFailureTargetAccount, "name").
+					WillReturnRows(sqlmock.NewRows(loginFailureColumns).AddRow(failure.TargetType, failure.Target, failure.Count, failure.LockedUntil, failure.LastFailedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:            "not found",
+			inputTargetType: entity.LoginFailureTargetAccount,
+			inputTarget:     "name",
+			expectResult:    nil,
+			expectError:     nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT target_type, target, count, locked_until, last_failed_at FROM login_failures WHERE target_type = ? AND target = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(entity.LoginFailureTargetAccount, "name").
+					WillReturnRows(sqlmock.NewRows(loginFailureColumns)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:            "find error",
+			inputTargetType: entity.LoginFailureTargetAccount,
+			inputTarget:     "name",
+			expectResult:    nil,
+			expectError:     sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT target_type, target, count, locked_until, last_failed_at FROM login_failures WHERE target_type = ? AND target = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(entity.LoginFailureTargetAccount, "name").
+					WillReturnRows(sqlmock.NewRows(loginFailureColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBLoginFailureRepository(db)
+			result, err := repo.FindOneByTargetForUpdate(t.Context(), tt.inputTargetType, tt.inputTarget)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -1,9 +1,18 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type AccountModel struct {
-	ID       uuid.UUID `db:"id"`
-	Name     string    `db:"name"`
-	Password string    `db:"password"`
+	ID        uuid.UUID      `db:"id"`
+	Name      string         `db:"name"`
+	Password  string         `db:"password"`
+	Email     sql.NullString `db:"email"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+	DeletedAt sql.NullTime   `db:"deleted_at"`
 }
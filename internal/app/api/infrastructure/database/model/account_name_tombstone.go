@@ -0,0 +1,8 @@
+package model
+
+import "time"
+
+type AccountNameTombstoneModel struct {
+	Name       string    `db:"name"`
+	ReleasedAt time.Time `db:"released_at"`
+}
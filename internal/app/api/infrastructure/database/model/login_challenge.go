@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LoginChallengeModel struct {
+	ID         uuid.UUID `db:"id"`
+	AccountID  uuid.UUID `db:"account_id"`
+	Token      string    `db:"token"`
+	RememberMe bool      `db:"remember_me"`
+	Attempts   int       `db:"attempts"`
+	ExpiresAt  time.Time `db:"expires_at"`
+	CreatedAt  time.Time `db:"created_at"`
+}
@@ -0,0 +1,14 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+type LoginFailureModel struct {
+	TargetType   string       `db:"target_type"`
+	Target       string       `db:"target"`
+	Count        int          `db:"count"`
+	LockedUntil  sql.NullTime `db:"locked_until"`
+	LastFailedAt time.Time    `db:"last_failed_at"`
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PasskeyModel struct {
+	ID           uuid.UUID    `db:"id"`
+	AccountID    uuid.UUID    `db:"account_id"`
+	CredentialID []byte       `db:"credential_id"`
+	PublicKey    []byte       `db:"public_key"`
+	SignCount    uint32       `db:"sign_count"`
+	Name         string       `db:"name"`
+	LastUsedAt   sql.NullTime `db:"last_used_at"`
+	CreatedAt    time.Time    `db:"created_at"`
+}
@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PasskeyChallengeModel struct {
+	ID        uuid.UUID     `db:"id"`
+	AccountID uuid.NullUUID `db:"account_id"`
+	Purpose   string        `db:"purpose"`
+	Challenge string        `db:"challenge"`
+	ExpiresAt time.Time     `db:"expires_at"`
+	CreatedAt time.Time     `db:"created_at"`
+}
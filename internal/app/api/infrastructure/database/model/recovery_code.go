@@ -0,0 +1,14 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RecoveryCodeModel struct {
+	ID        uuid.UUID `db:"id"`
+	AccountID uuid.UUID `db:"account_id"`
+	Code      string    `db:"code"`
+	CreatedAt time.Time `db:"created_at"`
+}
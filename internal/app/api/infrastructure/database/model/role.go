@@ -0,0 +1,13 @@
+package model
+
+import "github.com/google/uuid"
+
+type RoleModel struct {
+	ID   uuid.UUID `db:"id"`
+	Name string    `db:"name"`
+}
+
+type RolePermissionModel struct {
+	RoleID     uuid.UUID `db:"role_id"`
+	Permission string    `db:"permission"`
+}
@@ -7,7 +7,16 @@ import (
 )
 
 type SessionModel struct {
-	AccountID uuid.UUID `db:"account_id"`
-	Token     string    `db:"token"`
-	ExpiresAt time.Time `db:"expires_at"`
+	ID               uuid.UUID `db:"id"`
+	AccountID        uuid.UUID `db:"account_id"`
+	Token            string    `db:"token"`
+	RefreshToken     string    `db:"refresh_token"`
+	UserAgent        string    `db:"user_agent"`
+	IPAddress        string    `db:"ip_address"`
+	IdleTimeout      int64     `db:"idle_timeout"`
+	ExpiresAt        time.Time `db:"expires_at"`
+	RefreshExpiresAt time.Time `db:"refresh_expires_at"`
+	IssuedAt         time.Time `db:"issued_at"`
+	LastUsedAt       time.Time `db:"last_used_at"`
+	CreatedAt        time.Time `db:"created_at"`
 }
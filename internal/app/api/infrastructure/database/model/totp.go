@@ -0,0 +1,10 @@
+package model
+
+import "github.com/google/uuid"
+
+type TOTPModel struct {
+	AccountID    uuid.UUID `db:"account_id"`
+	Secret       []byte    `db:"secret"`
+	Enabled      bool      `db:"enabled"`
+	LastUsedStep int64     `db:"last_used_step"`
+}
@@ -0,0 +1,146 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/transformer"
+)
+
+type passkeyRepository struct {
+	db *sqlx.DB
+}
+
+func NewDBPasskeyRepository(db *sqlx.DB) repository.PasskeyRepository {
+	return &passkeyRepository{
+		db: db,
+	}
+}
+
+func (r *passkeyRepository) Create(ctx context.Context, passkey *entity.Passkey) error {
+	const errMessage = "failed to create passkey"
+
+	if passkey == nil {
+		return errors.Wrap(repository.ErrNilPasskey, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToPasskeyModel(passkey)
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`INSERT INTO passkeys (id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?);`,
+		model.ID,
+		model.AccountID,
+		model.CredentialID,
+		model.PublicKey,
+		model.SignCount,
+		model.Name,
+		model.LastUsedAt,
+		model.CreatedAt,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *passkeyRepository) Update(ctx context.Context, passkey *entity.Passkey) error {
+	const errMessage = "failed to update passkey"
+
+	if passkey == nil {
+		return errors.Wrap(repository.ErrNilPasskey, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToPasskeyModel(passkey)
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`UPDATE passkeys SET sign_count = ?, name = ?, last_used_at = ? WHERE id = ? LIMIT 1;`,
+		model.SignCount,
+		model.Name,
+		model.LastUsedAt,
+		model.ID,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *passkeyRepository) Delete(ctx context.Context, passkey *entity.Passkey) error {
+	const errMessage = "failed to delete passkey"
+
+	if passkey == nil {
+		return errors.Wrap(repository.ErrNilPasskey, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToPasskeyModel(passkey)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM passkeys WHERE id = ? LIMIT 1;`, model.ID); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *passkeyRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.Passkey, error) {
+	driver := transaction.GetDriver(ctx, r.db)
+	var models []*model.PasskeyModel
+
+	if err := sqlx.SelectContext(
+		ctx,
+		driver,
+		&models,
+		`SELECT id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at FROM passkeys WHERE account_id = ? ORDER BY created_at ASC;`,
+		accountID,
+	); err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, "faild to find passkeys by account id")
+	}
+
+	return transformer.ToPasskeyEntities(models), nil
+}
+
+func (r *passkeyRepository) FindOneByIDAndAccountID(ctx context.Context, id, accountID uuid.UUID) (*entity.Passkey, error) {
+	return r.findOne(
+		ctx,
+		`SELECT id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at FROM passkeys WHERE id = ? AND account_id = ? LIMIT 1;`,
+		[]any{id, accountID},
+		"faild to find passkey by id and account id",
+	)
+}
+
+// NOTE: 署名カウンタの検証と更新の間に同じ認証情報で並行して認証されないよう, 行をロックする.
+func (r *passkeyRepository) FindOneByCredentialIDForUpdate(ctx context.Context, credentialID []byte) (*entity.Passkey, error) {
+	return r.findOne(
+		ctx,
+		`SELECT id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at FROM passkeys WHERE credential_id = ? LIMIT 1 FOR UPDATE;`,
+		[]any{credentialID},
+		"faild to find passkey by credential id",
+	)
+}
+
+func (r *passkeyRepository) findOne(ctx context.Context, query string, args []any, errMessage string) (*entity.Passkey, error) {
+	driver := transaction.GetDriver(ctx, r.db)
+	var model model.PasskeyModel
+
+	if err := driver.QueryRowxContext(ctx, query, args...).StructScan(&model); err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return transformer.ToPasskeyEntity(&model), nil
+}
@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/hash"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/transformer"
+)
+
+type passkeyChallengeRepository struct {
+	db     *sqlx.DB
+	secret []byte
+}
+
+// NOTE: DB漏洩時にチャレンジが悪用されないよう, チャレンジはハッシュ化した値のみを保存する.
+func NewDBPasskeyChallengeRepository(db *sqlx.DB, secret []byte) repository.PasskeyChallengeRepository {
+	return &passkeyChallengeRepository{
+		db:     db,
+		secret: secret,
+	}
+}
+
+func (r *passkeyChallengeRepository) Create(ctx context.Context, challenge *entity.PasskeyChallenge) error {
+	const errMessage = "failed to create passkey challenge"
+
+	if challenge == nil {
+		return errors.Wrap(repository.ErrNilPasskeyChallenge, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToPasskeyChallengeModel(challenge)
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`INSERT INTO passkey_challenges (id, account_id, purpose, challenge, expires_at, created_at) VALUES (?, ?, ?, ?, ?, ?);`,
+		model.ID,
+		model.AccountID,
+		model.Purpose,
+		hash.HMAC(r.secret, model.Challenge),
+		model.ExpiresAt,
+		model.CreatedAt,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *passkeyChallengeRepository) Delete(ctx context.Context, challenge *entity.PasskeyChallenge) error {
+	const errMessage = "failed to delete passkey challenge"
+
+	if challenge == nil {
+		return errors.Wrap(repository.ErrNilPasskeyChallenge, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToPasskeyChallengeModel(challenge)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM passkey_challenges WHERE id = ?;`, model.ID); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *passkeyChallengeRepository) DeleteExpired(ctx context.Context) error {
+	driver := transaction.GetDriver(ctx, r.db)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM passkey_challenges WHERE expires_at <= NOW(6);`); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, "failed to delete expired passkey challenges")
+	}
+
+	return nil
+}
+
+func (r *passkeyChallengeRepository) FindOneByPurposeAndChallengeAndNotExpiredForUpdate(ctx context.Context, purpose, challenge string) (*entity.PasskeyChallenge, error) {
+	const errMessage = "faild to find passkey challenge by purpose and challenge and not expired for update"
+
+	driver := transaction.GetDriver(ctx, r.db)
+	var model model.PasskeyChallengeModel
+
+	if err := driver.QueryRowxContext(
+		ctx,
+		`SELECT id, account_id, purpose, challenge, expires_at, created_at FROM passkey_challenges WHERE purpose = ? AND challenge = ? AND expires_at > NOW(6) LIMIT 1 FOR UPDATE;`,
+		purpose,
+		hash.HMAC(r.secret, challenge),
+	).StructScan(&model); err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return transformer.ToPasskeyChallengeEntity(&model), nil
+}
@@ -0,0 +1,289 @@
+package database_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/hash"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	mockDatabase "github.com/atsumarukun/holos-account-api/test/mock/database"
+)
+
+var passkeyChallengeColumns = []string{"id", "account_id", "purpose", "challenge", "expires_at", "created_at"}
+
+func TestPasskeyChallenge_Create(t *testing.T) {
+	registration := &entity.PasskeyChallenge{
+		ID:        uuid.New(),
+		AccountID: uuid.New(),
+		Purpose:   entity.PasskeyChallengePurposeRegistration,
+		Challenge: "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		ExpiresAt: time.Now().Add(time.Minute * 5),
+		CreatedAt: time.Now(),
+	}
+	authentication := &entity.PasskeyChallenge{
+		ID:        uuid.New(),
+		AccountID: uuid.Nil,
+		Purpose:   entity.PasskeyChallengePurposeAuthentication,
+		Challenge: "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		ExpiresAt: time.Now().Add(time.Minute * 5),
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name                  string
+		inputPasskeyChallenge *entity.PasskeyChallenge
+		expectError           error
+		setMockDB             func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:                  "successfully created registration challenge",
+			inputPasskeyChallenge: registration,
+			expectError:           nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO passkey_challenges (id, account_id, purpose, challenge, expires_at, created_at) VALUES (?, ?, ?, ?, ?, ?);`)).
+					WithArgs(registration.ID, uuid.NullUUID{UUID: registration.AccountID, Valid: true}, registration.Purpose, hash.HMAC(sessionTokenSecret, registration.Challenge), registration.ExpiresAt, registration.CreatedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:                  "successfully created authentication challenge",
+			inputPasskeyChallenge: authentication,
+			expectError:           nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO passkey_challenges (id, account_id, purpose, challenge, expires_at, created_at) VALUES (?, ?, ?, ?, ?, ?);`)).
+					WithArgs(authentication.ID, nil, authentication.Purpose, hash.HMAC(sessionTokenSecret, authentication.Challenge), authentication.ExpiresAt, authentication.CreatedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:                  "passkey challenge is nil",
+			inputPasskeyChallenge: nil,
+			expectError:           repository.ErrNilPasskeyChallenge,
+			setMockDB:             func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:                  "create error",
+			inputPasskeyChallenge: registration,
+			expectError:           sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO passkey_challenges (id, account_id, purpose, challenge, expires_at, created_at) VALUES (?, ?, ?, ?, ?, ?);`)).
+					WithArgs(registration.ID, uuid.NullUUID{UUID: registration.AccountID, Valid: true}, registration.Purpose, hash.HMAC(sessionTokenSecret, registration.Challenge), registration.ExpiresAt, registration.CreatedAt).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasskeyChallengeRepository(db, sessionTokenSecret)
+			err := repo.Create(t.Context(), tt.inputPasskeyChallenge)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPasskeyChallenge_Delete(t *testing.T) {
+	challenge := &entity.PasskeyChallenge{
+		ID:        uuid.New(),
+		AccountID: uuid.New(),
+		Purpose:   entity.PasskeyChallengePurposeRegistration,
+		Challenge: "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		ExpiresAt: time.Now().Add(time.Minute * 5),
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name                  string
+		inputPasskeyChallenge *entity.PasskeyChallenge
+		expectError           error
+		setMockDB             func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:                  "successfully deleted",
+			inputPasskeyChallenge: challenge,
+			expectError:           nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM passkey_challenges WHERE id = ?;`)).
+					WithArgs(challenge.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:                  "passkey challenge is nil",
+			inputPasskeyChallenge: nil,
+			expectError:           repository.ErrNilPasskeyChallenge,
+			setMockDB:             func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:                  "delete error",
+			inputPasskeyChallenge: challenge,
+			expectError:           sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM passkey_challenges WHERE id = ?;`)).
+					WithArgs(challenge.ID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasskeyChallengeRepository(db, sessionTokenSecret)
+			err := repo.Delete(t.Context(), tt.inputPasskeyChallenge)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPasskeyChallenge_DeleteExpired(t *testing.T) {
+	tests := []struct {
+		name        string
+		expectError error
+		setMockDB   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:        "successfully deleted",
+			expectError: nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM passkey_challenges WHERE expires_at <= NOW(6);`)).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:        "delete error",
+			expectError: sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM passkey_challenges WHERE expires_at <= NOW(6);`)).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasskeyChallengeRepository(db, sessionTokenSecret)
+			err := repo.DeleteExpired(t.Context())
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPasskeyChallenge_FindOneByPurposeAndChallengeAndNotExpiredForUpdate(t *testing.T) {
+	challenge := &entity.PasskeyChallenge{
+		ID:        uuid.New(),
+		AccountID: uuid.Nil,
+		Purpose:   entity.PasskeyChallengePurposeAuthentication,
+		Challenge: "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		ExpiresAt: time.Now().Add(time.Minute * 5),
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name           string
+		inputPurpose   string
+		inputChallenge string
+		expectResult   *entity.PasskeyChallenge
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully found",
+			inputPurpose:   challenge.Purpose,
+			inputChallenge: challenge.Challenge,
+			expectResult:   challenge,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, purpose, challenge, expires_at, created_at FROM passkey_challenges WHERE purpose = ? AND challenge = ? AND expires_at > NOW(6) LIMIT 1 FOR UPDATE;`)).
+					WithArgs(challenge.Purpose, hash.HMAC(sessionTokenSecret, challenge.Challenge)).
+					WillReturnRows(sqlmock.NewRows(passkeyChallengeColumns).AddRow(challenge.ID, nil, challenge.Purpose, challenge.Challenge, challenge.ExpiresAt, challenge.CreatedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "not found",
+			inputPurpose:   challenge.Purpose,
+			inputChallenge: challenge.Challenge,
+			expectResult:   nil,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, purpose, challenge, expires_at, created_at FROM passkey_challenges WHERE purpose = ? AND challenge = ? AND expires_at > NOW(6) LIMIT 1 FOR UPDATE;`)).
+					WithArgs(challenge.Purpose, hash.HMAC(sessionTokenSecret, challenge.Challenge)).
+					WillReturnRows(sqlmock.NewRows(passkeyChallengeColumns)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "find error",
+			inputPurpose:   challenge.Purpose,
+			inputChallenge: challenge.Challenge,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, purpose, challenge, expires_at, created_at FROM passkey_challenges WHERE purpose = ? AND challenge = ? AND expires_at > NOW(6) LIMIT 1 FOR UPDATE;`)).
+					WithArgs(challenge.Purpose, hash.HMAC(sessionTokenSecret, challenge.Challenge)).
+					WillReturnRows(sqlmock.NewRows(passkeyChallengeColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasskeyChallengeRepository(db, sessionTokenSecret)
+			result, err := repo.FindOneByPurposeAndChallengeAndNotExpiredForUpdate(t.Context(), tt.inputPurpose, tt.inputChallenge)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,455 @@
+package database_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	mockDatabase "github.com/atsumarukun/holos-account-api/test/mock/database"
+)
+
+var passkeyColumns = []string{"id", "account_id", "credential_id", "public_key", "sign_count", "name", "last_used_at", "created_at"}
+
+func TestPasskey_Create(t *testing.T) {
+	passkey := &entity.Passkey{
+		ID:           uuid.New(),
+		AccountID:    uuid.New(),
+		CredentialID: []byte("credential"),
+		PublicKey:    []byte("public key"),
+		SignCount:    1,
+		Name:         "laptop",
+		LastUsedAt:   time.Now(),
+		CreatedAt:    time.Now(),
+	}
+	passkey.LastUsedAt = time.Time{}
+
+	tests := []struct {
+		name         string
+		inputPasskey *entity.Passkey
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully created",
+			inputPasskey: passkey,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO passkeys (id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?);`)).
+					WithArgs(passkey.ID, passkey.AccountID, passkey.CredentialID, passkey.PublicKey, passkey.SignCount, passkey.Name, nil, passkey.CreatedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "passkey is nil",
+			inputPasskey: nil,
+			expectError:  repository.ErrNilPasskey,
+			setMockDB:    func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:         "create error",
+			inputPasskey: passkey,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO passkeys (id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?);`)).
+					WithArgs(passkey.ID, passkey.AccountID, passkey.CredentialID, passkey.PublicKey, passkey.SignCount, passkey.Name, nil, passkey.CreatedAt).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasskeyRepository(db)
+			err := repo.Create(t.Context(), tt.inputPasskey)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPasskey_Update(t *testing.T) {
+	passkey := &entity.Passkey{
+		ID:           uuid.New(),
+		AccountID:    uuid.New(),
+		CredentialID: []byte("credential"),
+		PublicKey:    []byte("public key"),
+		SignCount:    1,
+		Name:         "laptop",
+		LastUsedAt:   time.Now(),
+		CreatedAt:    time.Now(),
+	}
+
+	tests := []struct {
+		name         string
+		inputPasskey *entity.Passkey
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully updated",
+			inputPasskey: passkey,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE passkeys SET sign_count = ?, name = ?, last_used_at = ? WHERE id = ? LIMIT 1;`)).
+					WithArgs(passkey.SignCount, passkey.Name, passkey.LastUsedAt, passkey.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "passkey is nil",
+			inputPasskey: nil,
+			expectError:  repository.ErrNilPasskey,
+			setMockDB:    func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:         "update error",
+			inputPasskey: passkey,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE passkeys SET sign_count = ?, name = ?, last_used_at = ? WHERE id = ? LIMIT 1;`)).
+					WithArgs(passkey.SignCount, passkey.Name, passkey.LastUsedAt, passkey.ID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasskeyRepository(db)
+			err := repo.Update(t.Context(), tt.inputPasskey)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPasskey_Delete(t *testing.T) {
+	passkey := &entity.Passkey{
+		ID:           uuid.New(),
+		AccountID:    uuid.New(),
+		CredentialID: []byte("credential"),
+		PublicKey:    []byte("public key"),
+		SignCount:    1,
+		Name:         "laptop",
+		LastUsedAt:   time.Now(),
+		CreatedAt:    time.Now(),
+	}
+
+	tests := []struct {
+		name         string
+		inputPasskey *entity.Passkey
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully deleted",
+			inputPasskey: passkey,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM passkeys WHERE id = ? LIMIT 1;`)).
+					WithArgs(passkey.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "passkey is nil",
+			inputPasskey: nil,
+			expectError:  repository.ErrNilPasskey,
+			setMockDB:    func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:         "delete error",
+			inputPasskey: passkey,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM passkeys WHERE id = ? LIMIT 1;`)).
+					WithArgs(passkey.ID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasskeyRepository(db)
+			err := repo.Delete(t.Context(), tt.inputPasskey)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPasskey_FindByAccountID(t *testing.T) {
+	passkey := &entity.Passkey{
+		ID:           uuid.New(),
+		AccountID:    uuid.New(),
+		CredentialID: []byte("credential"),
+		PublicKey:    []byte("public key"),
+		SignCount:    1,
+		Name:         "laptop",
+		LastUsedAt:   time.Now(),
+		CreatedAt:    time.Now(),
+	}
+
+	tests := []struct {
+		name           string
+		inputAccountID uuid.UUID
+		expectResult   []*entity.Passkey
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully found",
+			inputAccountID: passkey.AccountID,
+			expectResult:   []*entity.Passkey{passkey},
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at FROM passkeys WHERE account_id = ? ORDER BY created_at ASC;`)).
+					WithArgs(passkey.AccountID).
+					WillReturnRows(sqlmock.NewRows(passkeyColumns).AddRow(passkey.ID, passkey.AccountID, passkey.CredentialID, passkey.PublicKey, passkey.SignCount, passkey.Name, passkey.LastUsedAt, passkey.CreatedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "not found",
+			inputAccountID: passkey.AccountID,
+			expectResult:   nil,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at FROM passkeys WHERE account_id = ? ORDER BY created_at ASC;`)).
+					WithArgs(passkey.AccountID).
+					WillReturnRows(sqlmock.NewRows(passkeyColumns)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "find error",
+			inputAccountID: passkey.AccountID,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at FROM passkeys WHERE account_id = ? ORDER BY created_at ASC;`)).
+					WithArgs(passkey.AccountID).
+					WillReturnRows(sqlmock.NewRows(passkeyColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasskeyRepository(db)
+			result, err := repo.FindByAccountID(t.Context(), tt.inputAccountID)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPasskey_FindOneByIDAndAccountID(t *testing.T) {
+	passkey := &entity.Passkey{
+		ID:           uuid.New(),
+		AccountID:    uuid.New(),
+		CredentialID: []byte("credential"),
+		PublicKey:    []byte("public key"),
+		SignCount:    1,
+		Name:         "laptop",
+		LastUsedAt:   time.Now(),
+		CreatedAt:    time.Now(),
+	}
+
+	tests := []struct {
+		name           string
+		inputID        uuid.UUID
+		inputAccountID uuid.UUID
+		expectResult   *entity.Passkey
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully found",
+			inputID:        passkey.ID,
+			inputAccountID: passkey.AccountID,
+			expectResult:   passkey,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at FROM passkeys WHERE id = ? AND account_id = ? LIMIT 1;`)).
+					WithArgs(passkey.ID, passkey.AccountID).
+					WillReturnRows(sqlmock.NewRows(passkeyColumns).AddRow(passkey.ID, passkey.AccountID, passkey.CredentialID, passkey.PublicKey, passkey.SignCount, passkey.Name, passkey.LastUsedAt, passkey.CreatedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "not found",
+			inputID:        passkey.ID,
+			inputAccountID: passkey.AccountID,
+			expectResult:   nil,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at FROM passkeys WHERE id = ? AND account_id = ? LIMIT 1;`)).
+					WithArgs(passkey.ID, passkey.AccountID).
+					WillReturnRows(sqlmock.NewRows(passkeyColumns)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "find error",
+			inputID:        passkey.ID,
+			inputAccountID: passkey.AccountID,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at FROM passkeys WHERE id = ? AND account_id = ? LIMIT 1;`)).
+					WithArgs(passkey.ID, passkey.AccountID).
+					WillReturnRows(sqlmock.NewRows(passkeyColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasskeyRepository(db)
+			result, err := repo.FindOneByIDAndAccountID(t.Context(), tt.inputID, tt.inputAccountID)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPasskey_FindOneByCredentialIDForUpdate(t *testing.T) {
+	passkey := &entity.Passkey{
+		ID:           uuid.New(),
+		AccountID:    uuid.New(),
+		CredentialID: []byte("credential"),
+		PublicKey:    []byte("public key"),
+		SignCount:    1,
+		Name:         "laptop",
+		LastUsedAt:   time.Now(),
+		CreatedAt:    time.Now(),
+	}
+	passkey.LastUsedAt = time.Time{}
+
+	tests := []struct {
+		name              string
+		inputCredentialID []byte
+		expectResult      *entity.Passkey
+		expectError       error
+		setMockDB         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:              "successfully found",
+			inputCredentialID: passkey.CredentialID,
+			expectResult:      passkey,
+			expectError:       nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at FROM passkeys WHERE credential_id = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(passkey.CredentialID).
+					WillReturnRows(sqlmock.NewRows(passkeyColumns).AddRow(passkey.ID, passkey.AccountID, passkey.CredentialID, passkey.PublicKey, passkey.SignCount, passkey.Name, nil, passkey.CreatedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:              "not found",
+			inputCredentialID: passkey.CredentialID,
+			expectResult:      nil,
+			expectError:       nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at FROM passkeys WHERE credential_id = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(passkey.CredentialID).
+					WillReturnRows(sqlmock.NewRows(passkeyColumns)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:              "find error",
+			inputCredentialID: passkey.CredentialID,
+			expectResult:      nil,
+			expectError:       sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, credential_id, public_key, sign_count, name, last_used_at, created_at FROM passkeys WHERE credential_id = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(passkey.CredentialID).
+					WillReturnRows(sqlmock.NewRows(passkeyColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasskeyRepository(db)
+			result, err := repo.FindOneByCredentialIDForUpdate(t.Context(), tt.inputCredentialID)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/hash"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/transformer"
+)
+
+type passwordResetRepository struct {
+	db     *sqlx.DB
+	secret []byte
+}
+
+// NOTE: 再設定トークンだけでパスワードを変更できるため, DB漏洩時にアカウントを乗っ取られないようハッシュ化した値のみを保存する.
+func NewDBPasswordResetRepository(db *sqlx.DB, secret []byte) repository.PasswordResetRepository {
+	return &passwordResetRepository{
+		db:     db,
+		secret: secret,
+	}
+}
+
+func (r *passwordResetRepository) Save(ctx context.Context, reset *entity.PasswordReset) error {
+	const errMessage = "failed to save password reset"
+
+	if reset == nil {
+		return errors.Wrap(repository.ErrNilPasswordReset, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToPasswordResetModel(reset)
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`INSERT INTO password_resets (account_id, token, expires_at, created_at) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE token = VALUES(token), expires_at = VALUES(expires_at), created_at = VALUES(created_at);`,
+		model.AccountID,
+		hash.HMAC(r.secret, model.Token),
+		model.ExpiresAt,
+		model.CreatedAt,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *passwordResetRepository) Delete(ctx context.Context, reset *entity.PasswordReset) error {
+	const errMessage = "failed to delete password reset"
+
+	if reset == nil {
+		return errors.Wrap(repository.ErrNilPasswordReset, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToPasswordResetModel(reset)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM password_resets WHERE account_id = ?;`, model.AccountID); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *passwordResetRepository) DeleteExpired(ctx context.Context) error {
+	driver := transaction.GetDriver(ctx, r.db)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM password_resets WHERE expires_at <= NOW(6);`); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, "failed to delete expired password resets")
+	}
+
+	return nil
+}
+
+func (r *passwordResetRepository) FindOneByTokenAndNotExpired(ctx context.Context, token string) (*entity.PasswordReset, error) {
+	const errMessage = "faild to find password reset by token and not expired"
+
+	driver := transaction.GetDriver(ctx, r.db)
+	var model model.PasswordResetModel
+
+	if err := driver.QueryRowxContext(
+		ctx,
+		`SELECT account_id, token, expires_at, created_at FROM password_resets WHERE token = ? AND expires_at > NOW(6) LIMIT 1 FOR UPDATE;`,
+		hash.HMAC(r.secret, token),
+	).StructScan(&model); err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return transformer.ToPasswordResetEntity(&model), nil
+}
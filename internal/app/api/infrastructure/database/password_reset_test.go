@@ -0,0 +1,260 @@
+package database_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/hash"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	mockDatabase "github.com/atsumarukun/holos-account-api/test/mock/database"
+)
+
+var passwordResetColumns = []string{"account_id", "token", "expires_at", "created_at"}
+
+func TestPasswordReset_Save(t *testing.T) {
+	reset := &entity.PasswordReset{
+		AccountID: uuid.New(),
+		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		ExpiresAt: time.Now().Add(time.Minute * 30),
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name               string
+		inputPasswordReset *entity.PasswordReset
+		expectError        error
+		setMockDB          func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:               "successfully saved",
+			inputPasswordReset: reset,
+			expectError:        nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO password_resets (account_id, token, expires_at, created_at) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE token = VALUES(token), expires_at = VALUES(expires_at), created_at = VALUES(created_at);`)).
+					WithArgs(reset.AccountID, hash.HMAC(sessionTokenSecret, reset.Token), reset.ExpiresAt, reset.CreatedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:               "password reset is nil",
+			inputPasswordReset: nil,
+			expectError:        repository.ErrNilPasswordReset,
+			setMockDB:          func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:               "save error",
+			inputPasswordReset: reset,
+			expectError:        sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO password_resets (account_id, token, expires_at, created_at) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE token = VALUES(token), expires_at = VALUES(expires_at), created_at = VALUES(created_at);`)).
+					WithArgs(reset.AccountID, hash.HMAC(sessionTokenSecret, reset.Token), reset.ExpiresAt, reset.CreatedAt).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasswordResetRepository(db, sessionTokenSecret)
+			err := repo.Save(t.Context(), tt.inputPasswordReset)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPasswordReset_Delete(t *testing.T) {
+	reset := &entity.PasswordReset{
+		AccountID: uuid.New(),
+		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		ExpiresAt: time.Now().Add(time.Minute * 30),
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name               string
+		inputPasswordReset *entity.PasswordReset
+		expectError        error
+		setMockDB          func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:               "successfully deleted",
+			inputPasswordReset: reset,
+			expectError:        nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM password_resets WHERE account_id = ?;`)).
+					WithArgs(reset.AccountID).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:               "password reset is nil",
+			inputPasswordReset: nil,
+			expectError:        repository.ErrNilPasswordReset,
+			setMockDB:          func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:               "delete error",
+			inputPasswordReset: reset,
+			expectError:        sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM password_resets WHERE account_id = ?;`)).
+					WithArgs(reset.AccountID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasswordResetRepository(db, sessionTokenSecret)
+			err := repo.Delete(t.Context(), tt.inputPasswordReset)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPasswordReset_DeleteExpired(t *testing.T) {
+	tests := []struct {
+		name        string
+		expectError error
+		setMockDB   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:        "successfully deleted",
+			expectError: nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM password_resets WHERE expires_at <= NOW(6);`)).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:        "delete error",
+			expectError: sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM password_resets WHERE expires_at <= NOW(6);`)).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasswordResetRepository(db, sessionTokenSecret)
+			err := repo.DeleteExpired(t.Context())
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPasswordReset_FindOneByTokenAndNotExpired(t *testing.T) {
+	reset := &entity.PasswordReset{
+		AccountID: uuid.New(),
+		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		ExpiresAt: time.Now().Add(time.Minute * 30),
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name         string
+		inputToken   string
+		expectResult *entity.PasswordReset
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully found",
+			inputToken:   reset.Token,
+			expectResult: reset,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, token, expires_at, created_at FROM password_resets WHERE token = ? AND expires_at > NOW(6) LIMIT 1 FOR UPDATE;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, reset.Token)).
+					WillReturnRows(sqlmock.NewRows(passwordResetColumns).AddRow(reset.AccountID, reset.Token, reset.ExpiresAt, reset.CreatedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "not found",
+			inputToken:   reset.Token,
+			expectResult: nil,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, token, expires_at, created_at FROM password_resets WHERE token = ? AND expires_at > NOW(6) LIMIT 1 FOR UPDATE;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, reset.Token)).
+					WillReturnRows(sqlmock.NewRows(passwordResetColumns)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "find error",
+			inputToken:   reset.Token,
+			expectResult: nil,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, token, expires_at, created_at FROM password_resets WHERE token = ? AND expires_at > NOW(6) LIMIT 1 FOR UPDATE;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, reset.Token)).
+					WillReturnRows(sqlmock.NewRows(passwordResetColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBPasswordResetRepository(db, sessionTokenSecret)
+			result, err := repo.FindOneByTokenAndNotExpired(t.Context(), tt.inputToken)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package lock
+
+import (
+	"context"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/lock"
+)
+
+type lockObject struct {
+	db *sqlx.DB
+}
+
+func NewDBLockObject(db *sqlx.DB) lock.LockObject {
+	return &lockObject{
+		db: db,
+	}
+}
+
+// NOTE: ロックはコネクション単位で保持されるため, 解放まで同一コネクションを占有する.
+// ロックを取得できなかった場合は他のプロセスが処理中のため, 関数を実行せずに終了する.
+func (lo *lockObject) Lock(ctx context.Context, name string, fn func(context.Context) error) error {
+	conn, err := lo.db.Connx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, "failed to get connection")
+	}
+	defer conn.Close()
+
+	var acquired *int64
+	if err := conn.QueryRowxContext(ctx, `SELECT GET_LOCK(?, 0);`, name).Scan(&acquired); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, "failed to get lock")
+	}
+	if acquired == nil || *acquired != 1 {
+		return nil
+	}
+
+	defer func() {
+		_, _ = conn.ExecContext(context.WithoutCancel(ctx), `SELECT RELEASE_LOCK(?);`, name)
+	}()
+
+	return fn(ctx)
+}
@@ -0,0 +1,112 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/hash"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/transformer"
+)
+
+type recoveryCodeRepository struct {
+	db     *sqlx.DB
+	secret []byte
+}
+
+// NOTE: DB漏洩時にコードが悪用されないよう, コードはハッシュ化した値のみを保存する.
+func NewDBRecoveryCodeRepository(db *sqlx.DB, secret []byte) repository.RecoveryCodeRepository {
+	return &recoveryCodeRepository{
+		db:     db,
+		secret: secret,
+	}
+}
+
+func (r *recoveryCodeRepository) Create(ctx context.Context, code *entity.RecoveryCode) error {
+	const errMessage = "failed to create recovery code"
+
+	if code == nil {
+		return errors.Wrap(repository.ErrNilRecoveryCode, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToRecoveryCodeModel(code)
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`INSERT INTO recovery_codes (id, account_id, code, created_at) VALUES (?, ?, ?, ?);`,
+		model.ID,
+		model.AccountID,
+		hash.HMAC(r.secret, model.Code),
+		model.CreatedAt,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *recoveryCodeRepository) Delete(ctx context.Context, code *entity.RecoveryCode) error {
+	const errMessage = "failed to delete recovery code"
+
+	if code == nil {
+		return errors.Wrap(repository.ErrNilRecoveryCode, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToRecoveryCodeModel(code)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM recovery_codes WHERE id = ?;`, model.ID); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *recoveryCodeRepository) DeleteByAccountID(ctx context.Context, accountID uuid.UUID) error {
+	driver := transaction.GetDriver(ctx, r.db)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM recovery_codes WHERE account_id = ?;`, accountID); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, "failed to delete recovery codes by account id")
+	}
+
+	return nil
+}
+
+func (r *recoveryCodeRepository) CountByAccountID(ctx context.Context, accountID uuid.UUID) (int, error) {
+	driver := transaction.GetDriver(ctx, r.db)
+	var count int
+
+	if err := driver.QueryRowxContext(ctx, `SELECT COUNT(*) FROM recovery_codes WHERE account_id = ?;`, accountID).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, errors.CodeInternalServerError, "failed to count recovery codes by account id")
+	}
+
+	return count, nil
+}
+
+func (r *recoveryCodeRepository) FindOneByAccountIDAndCodeForUpdate(ctx context.Context, accountID uuid.UUID, code string) (*entity.RecoveryCode, error) {
+	driver := transaction.GetDriver(ctx, r.db)
+	var model model.RecoveryCodeModel
+
+	if err := driver.QueryRowxContext(
+		ctx,
+		`SELECT id, account_id, code, created_at FROM recovery_codes WHERE account_id = ? AND code = ? LIMIT 1 FOR UPDATE;`,
+		accountID,
+		hash.HMAC(r.secret, code),
+	).StructScan(&model); err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, "faild to find recovery code by account id and code")
+	}
+
+	return transformer.ToRecoveryCodeEntity(&model), nil
+}
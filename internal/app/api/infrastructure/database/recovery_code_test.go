@@ -0,0 +1,328 @@
+package database_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/hash"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	mockDatabase "github.com/atsumarukun/holos-account-api/test/mock/database"
+)
+
+var recoveryCodeColumns = []string{"id", "account_id", "code", "created_at"}
+
+func TestRecoveryCode_Create(t *testing.T) {
+	code := &entity.RecoveryCode{
+		ID:        uuid.New(),
+		AccountID: uuid.New(),
+		Code:      "abcde23456",
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name              string
+		inputRecoveryCode *entity.RecoveryCode
+		expectError       error
+		setMockDB         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:              "successfully created",
+			inputRecoveryCode: code,
+			expectError:       nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO recovery_codes (id, account_id, code, created_at) VALUES (?, ?, ?, ?);`)).
+					WithArgs(code.ID, code.AccountID, hash.HMAC(sessionTokenSecret, code.Code), code.CreatedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:              "recovery code is nil",
+			inputRecoveryCode: nil,
+			expectError:       repository.ErrNilRecoveryCode,
+			setMockDB:         func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:              "create error",
+			inputRecoveryCode: code,
+			expectError:       sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO recovery_codes (id, account_id, code, created_at) VALUES (?, ?, ?, ?);`)).
+					WithArgs(code.ID, code.AccountID, hash.HMAC(sessionTokenSecret, code.Code), code.CreatedAt).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBRecoveryCodeRepository(db, sessionTokenSecret)
+			err := repo.Create(t.Context(), tt.inputRecoveryCode)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRecoveryCode_Delete(t *testing.T) {
+	code := &entity.RecoveryCode{
+		ID:        uuid.New(),
+		AccountID: uuid.New(),
+		Code:      "abcde23456",
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name              string
+		inputRecoveryCode *entity.RecoveryCode
+		expectError       error
+		setMockDB         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:              "successfully deleted",
+			inputRecoveryCode: code,
+			expectError:       nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM recovery_codes WHERE id = ?;`)).
+					WithArgs(code.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:              "recovery code is nil",
+			inputRecoveryCode: nil,
+			expectError:       repository.ErrNilRecoveryCode,
+			setMockDB:         func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:              "delete error",
+			inputRecoveryCode: code,
+			expectError:       sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM recovery_codes WHERE id = ?;`)).
+					WithArgs(code.ID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBRecoveryCodeRepository(db, sessionTokenSecret)
+			err := repo.Delete(t.Context(), tt.inputRecoveryCode)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRecoveryCode_DeleteByAccountID(t *testing.T) {
+	accountID := uuid.New()
+
+	tests := []struct {
+		name           string
+		inputAccountID uuid.UUID
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully deleted",
+			inputAccountID: accountID,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM recovery_codes WHERE account_id = ?;`)).
+					WithArgs(accountID).
+					WillReturnResult(sqlmock.NewResult(0, 10)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "delete error",
+			inputAccountID: accountID,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM recovery_codes WHERE account_id = ?;`)).
+					WithArgs(accountID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBRecoveryCodeRepository(db, sessionTokenSecret)
+			err := repo.DeleteByAccountID(t.Context(), tt.inputAccountID)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRecoveryCode_CountByAccountID(t *testing.T) {
+	accountID := uuid.New()
+
+	tests := []struct {
+		name           string
+		inputAccountID uuid.UUID
+		expectResult   int
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully counted",
+			inputAccountID: accountID,
+			expectResult:   8,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM recovery_codes WHERE account_id = ?;`)).
+					WithArgs(accountID).
+					WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(8)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "count error",
+			inputAccountID: accountID,
+			expectResult:   0,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM recovery_codes WHERE account_id = ?;`)).
+					WithArgs(accountID).
+					WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBRecoveryCodeRepository(db, sessionTokenSecret)
+			result, err := repo.CountByAccountID(t.Context(), tt.inputAccountID)
+			assert.Error(t, err, tt.expectError)
+
+			if result != tt.expectResult {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectResult, result)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRecoveryCode_FindOneByAccountIDAndCodeForUpdate(t *testing.T) {
+	code := &entity.RecoveryCode{
+		ID:        uuid.New(),
+		AccountID: uuid.New(),
+		Code:      "abcde23456",
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name           string
+		inputAccountID uuid.UUID
+		inputCode      string
+		expectResult   *entity.RecoveryCode
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully found",
+			inputAccountID: code.AccountID,
+			inputCode:      code.Code,
+			expectResult:   code,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, code, created_at FROM recovery_codes WHERE account_id = ? AND code = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(code.AccountID, hash.HMAC(sessionTokenSecret, code.Code)).
+					WillReturnRows(sqlmock.NewRows(recoveryCodeColumns).AddRow(code.ID, code.AccountID, code.Code, code.CreatedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "not found",
+			inputAccountID: code.AccountID,
+			inputCode:      code.Code,
+			expectResult:   nil,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, code, created_at FROM recovery_codes WHERE account_id = ? AND code = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(code.AccountID, hash.HMAC(sessionTokenSecret, code.Code)).
+					WillReturnRows(sqlmock.NewRows(recoveryCodeColumns)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "find error",
+			inputAccountID: code.AccountID,
+			inputCode:      code.Code,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, code, created_at FROM recovery_codes WHERE account_id = ? AND code = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(code.AccountID, hash.HMAC(sessionTokenSecret, code.Code)).
+					WillReturnRows(sqlmock.NewRows(recoveryCodeColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBRecoveryCodeRepository(db, sessionTokenSecret)
+			result, err := repo.FindOneByAccountIDAndCodeForUpdate(t.Context(), tt.inputAccountID, tt.inputCode)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/transformer"
+)
+
+type roleRepository struct {
+	db *sqlx.DB
+}
+
+func NewDBRoleRepository(db *sqlx.DB) repository.RoleRepository {
+	return &roleRepository{
+		db: db,
+	}
+}
+
+func (r *roleRepository) Assign(ctx context.Context, role *entity.Role, account *entity.Account) error {
+	const errMessage = "failed to assign role"
+
+	if role == nil {
+		return errors.Wrap(repository.ErrNilRole, errors.CodeInternalServerError, errMessage)
+	}
+	if account == nil {
+		return errors.Wrap(repository.ErrNilAccount, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+
+	if _, err := driver.ExecContext(ctx, `INSERT INTO account_roles (account_id, role_id) VALUES (?, ?);`, account.ID, role.ID); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+// NOTE: 削除済みのアカウントは権限を行使できないため数えない.
+func (r *roleRepository) CountAccountsByID(ctx context.Context, id uuid.UUID) (int, error) {
+	driver := transaction.GetDriver(ctx, r.db)
+	var count int
+
+	if err := driver.QueryRowxContext(
+		ctx,
+		`SELECT COUNT(*) FROM account_roles INNER JOIN accounts ON accounts.id = account_roles.account_id WHERE account_roles.role_id = ? AND accounts.deleted_at IS NULL;`,
+		id,
+	).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, errors.CodeInternalServerError, "failed to count accounts by role id")
+	}
+
+	return count, nil
+}
+
+func (r *roleRepository) FindOneByName(ctx context.Context, name string) (*entity.Role, error) {
+	const errMessage = "faild to find role by name"
+
+	driver := transaction.GetDriver(ctx, r.db)
+	var role model.RoleModel
+	var permissions []*model.RolePermissionModel
+
+	if err := driver.QueryRowxContext(ctx, `SELECT id, name FROM roles WHERE name = ? LIMIT 1;`, name).StructScan(&role); err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	if err := sqlx.SelectContext(
+		ctx,
+		driver,
+		&permissions,
+		`SELECT role_id, permission FROM role_permissions WHERE role_id = ? ORDER BY permission ASC;`,
+		role.ID,
+	); err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return transformer.ToRoleEntity(&role, permissions), nil
+}
+
+func (r *roleRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.Role, error) {
+	const errMessage = "faild to find roles by account id"
+
+	driver := transaction.GetDriver(ctx, r.db)
+	var roles []*model.RoleModel
+	var permissions []*model.RolePermissionModel
+
+	if err := sqlx.SelectContext(
+		ctx,
+		driver,
+		&roles,
+		`SELECT roles.id, roles.name FROM roles INNER JOIN account_roles ON account_roles.role_id = roles.id WHERE account_roles.account_id = ? ORDER BY roles.name ASC;`,
+		accountID,
+	); err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+	if len(roles) == 0 {
+		return nil, nil
+	}
+
+	if err := sqlx.SelectContext(
+		ctx,
+		driver,
+		&permissions,
+		`SELECT role_permissions.role_id, role_permissions.permission FROM role_permissions INNER JOIN account_roles ON account_roles.role_id = role_permissions.role_id WHERE account_roles.account_id = ? ORDER BY role_permissions.permission ASC;`,
+		accountID,
+	); err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return transformer.ToRoleEntities(roles, permissions), nil
+}
@@ -0,0 +1,337 @@
+package database_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	mockDatabase "github.com/atsumarukun/holos-account-api/test/mock/database"
+)
+
+func TestRole_Assign(t *testing.T) {
+	role := &entity.Role{
+		ID:          uuid.New(),
+		Name:        entity.RoleNameAdmin,
+		Permissions: []entity.Permission{entity.PermissionAccountsRead, entity.PermissionAccountsWrite},
+	}
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+
+	tests := []struct {
+		name         string
+		inputRole    *entity.Role
+		inputAccount *entity.Account
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully assigned",
+			inputRole:    role,
+			inputAccount: account,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO account_roles (account_id, role_id) VALUES (?, ?);`)).
+					WithArgs(account.ID, role.ID).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "role is nil",
+			inputRole:    nil,
+			inputAccount: account,
+			expectError:  repository.ErrNilRole,
+			setMockDB:    func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:         "account is nil",
+			inputRole:    role,
+			inputAccount: nil,
+			expectError:  repository.ErrNilAccount,
+			setMockDB:    func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:         "assign error",
+			inputRole:    role,
+			inputAccount: account,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO account_roles (account_id, role_id) VALUES (?, ?);`)).
+					WithArgs(account.ID, role.ID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBRoleRepository(db)
+			err := repo.Assign(t.Context(), tt.inputRole, tt.inputAccount)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRole_CountAccountsByID(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name         string
+		inputID      uuid.UUID
+		expectResult int
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully counted",
+			inputID:      id,
+			expectResult: 1,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM account_roles INNER JOIN accounts ON accounts.id = account_roles.account_id WHERE account_roles.role_id = ? AND accounts.deleted_at IS NULL;`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "count error",
+			inputID:      id,
+			expectResult: 0,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM account_roles INNER JOIN accounts ON accounts.id = account_roles.account_id WHERE account_roles.role_id = ? AND accounts.deleted_at IS NULL;`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"count"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBRoleRepository(db)
+			result, err := repo.CountAccountsByID(t.Context(), tt.inputID)
+			assert.Error(t, err, tt.expectError)
+
+			if result != tt.expectResult {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectResult, result)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRole_FindOneByName(t *testing.T) {
+	role := &entity.Role{
+		ID:          uuid.New(),
+		Name:        entity.RoleNameAdmin,
+		Permissions: []entity.Permission{entity.PermissionAccountsRead, entity.PermissionAccountsWrite},
+	}
+
+	tests := []struct {
+		name         string
+		inputName    string
+		expectResult *entity.Role
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "successfully found",
+			inputName:    role.Name,
+			expectResult: role,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name FROM roles WHERE name = ? LIMIT 1;`)).
+					WithArgs(role.Name).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(role.ID, role.Name)).
+					WillReturnError(nil)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT role_id, permission FROM role_permissions WHERE role_id = ? ORDER BY permission ASC;`)).
+					WithArgs(role.ID).
+					WillReturnRows(sqlmock.NewRows([]string{"role_id", "permission"}).AddRow(role.ID, role.Permissions[0]).AddRow(role.ID, role.Permissions[1])).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "not found",
+			inputName:    role.Name,
+			expectResult: nil,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name FROM roles WHERE name = ? LIMIT 1;`)).
+					WithArgs(role.Name).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"})).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "find error",
+			inputName:    role.Name,
+			expectResult: nil,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name FROM roles WHERE name = ? LIMIT 1;`)).
+					WithArgs(role.Name).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+		{
+			name:         "find permissions error",
+			inputName:    role.Name,
+			expectResult: nil,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name FROM roles WHERE name = ? LIMIT 1;`)).
+					WithArgs(role.Name).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(role.ID, role.Name)).
+					WillReturnError(nil)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT role_id, permission FROM role_permissions WHERE role_id = ? ORDER BY permission ASC;`)).
+					WithArgs(role.ID).
+					WillReturnRows(sqlmock.NewRows([]string{"role_id", "permission"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBRoleRepository(db)
+			result, err := repo.FindOneByName(t.Context(), tt.inputName)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRole_FindByAccountID(t *testing.T) {
+	role := &entity.Role{
+		ID:          uuid.New(),
+		Name:        entity.RoleNameAdmin,
+		Permissions: []entity.Permission{entity.PermissionAccountsRead, entity.PermissionAccountsWrite},
+	}
+	accountID := uuid.New()
+
+	tests := []struct {
+		name           string
+		inputAccountID uuid.UUID
+		expectResult   []*entity.Role
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully found",
+			inputAccountID: accountID,
+			expectResult:   []*entity.Role{role},
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT roles.id, roles.name FROM roles INNER JOIN account_roles ON account_roles.role_id = roles.id WHERE account_roles.account_id = ? ORDER BY roles.name ASC;`)).
+					WithArgs(accountID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(role.ID, role.Name)).
+					WillReturnError(nil)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT role_permissions.role_id, role_permissions.permission FROM role_permissions INNER JOIN account_roles ON account_roles.role_id = role_permissions.role_id WHERE account_roles.account_id = ? ORDER BY role_permissions.permission ASC;`)).
+					WithArgs(accountID).
+					WillReturnRows(sqlmock.NewRows([]string{"role_id", "permission"}).AddRow(role.ID, role.Permissions[0]).AddRow(role.ID, role.Permissions[1])).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "not found",
+			inputAccountID: accountID,
+			expectResult:   nil,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT roles.id, roles.name FROM roles INNER JOIN account_roles ON account_roles.role_id = roles.id WHERE account_roles.account_id = ? ORDER BY roles.name ASC;`)).
+					WithArgs(accountID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"})).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "find error",
+			inputAccountID: accountID,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT roles.id, roles.name FROM roles INNER JOIN account_roles ON account_roles.role_id = roles.id WHERE account_roles.account_id = ? ORDER BY roles.name ASC;`)).
+					WithArgs(accountID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+		{
+			name:           "find permissions error",
+			inputAccountID: accountID,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT roles.id, roles.name FROM roles INNER JOIN account_roles ON account_roles.role_id = roles.id WHERE account_roles.account_id = ? ORDER BY roles.name ASC;`)).
+					WithArgs(accountID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(role.ID, role.Name)).
+					WillReturnError(nil)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT role_permissions.role_id, role_permissions.permission FROM role_permissions INNER JOIN account_roles ON account_roles.role_id = role_permissions.role_id WHERE account_roles.account_id = ? ORDER BY role_permissions.permission ASC;`)).
+					WithArgs(accountID).
+					WillReturnRows(sqlmock.NewRows([]string{"role_id", "permission"})).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBRoleRepository(db)
+			result, err := repo.FindByAccountID(t.Context(), tt.inputAccountID)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
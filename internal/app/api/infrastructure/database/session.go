@@ -12,22 +12,93 @@ import (
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/hash"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/transaction"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/transformer"
 )
 
 type sessionRepository struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	secret []byte
 }
 
-func NewDBSessionRepository(db *sqlx.DB) repository.SessionRepository {
+func NewDBSessionRepository(db *sqlx.DB, secret []byte) repository.SessionRepository {
 	return &sessionRepository{
-		db: db,
+		db:     db,
+		secret: secret,
 	}
 }
 
-func (r *sessionRepository) Save(ctx context.Context, session *entity.Session) error {
-	const errMessage = "failed to save session"
+func (r *sessionRepository) Create(ctx context.Context, session *entity.Session) error {
+	const errMessage = "failed to create session"
+
+	if session == nil {
+		return errors.Wrap(repository.ErrNilSession, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := r.toModel(session)
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`INSERT INTO sessions (id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`,
+		model.ID,
+		model.AccountID,
+		model.Token,
+		model.RefreshToken,
+		model.UserAgent,
+		model.IPAddress,
+		model.IdleTimeout,
+		model.ExpiresAt,
+		model.RefreshExpiresAt,
+		model.IssuedAt,
+		model.LastUsedAt,
+		model.CreatedAt,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) Update(ctx context.Context, session *entity.Session) error {
+	const errMessage = "failed to update session"
+
+	if session == nil {
+		return errors.Wrap(repository.ErrNilSession, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := r.toModel(session)
+
+	// NOTE: リフレッシュトークンの再利用を検知するため, ローテーションされる旧トークンを使用済みとして保持する.
+	if _, err := driver.ExecContext(
+		ctx,
+		`INSERT INTO used_refresh_tokens (token, session_id) SELECT refresh_token, id FROM sessions WHERE id = ? AND refresh_token <> ?;`,
+		model.ID,
+		model.RefreshToken,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`UPDATE sessions SET token = ?, refresh_token = ?, expires_at = ?, issued_at = ?, last_used_at = ? WHERE id = ?;`,
+		model.Token,
+		model.RefreshToken,
+		model.ExpiresAt,
+		model.IssuedAt,
+		model.LastUsedAt,
+		model.ID,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) UpdateLastUsedAt(ctx context.Context, session *entity.Session) error {
+	const errMessage = "failed to update session last_used_at"
 
 	if session == nil {
 		return errors.Wrap(repository.ErrNilSession, errors.CodeInternalServerError, errMessage)
@@ -36,7 +107,7 @@ func (r *sessionRepository) Save(ctx context.Context, session *entity.Session) e
 	driver := transaction.GetDriver(ctx, r.db)
 	model := transformer.ToSessionModel(session)
 
-	if _, err := driver.ExecContext(ctx, `REPLACE sessions (account_id, token, expires_at) VALUES (?, ?, ?);`, model.AccountID, model.Token, model.ExpiresAt); err != nil {
+	if _, err := driver.ExecContext(ctx, `UPDATE sessions SET last_used_at = ? WHERE id = ?;`, model.LastUsedAt, model.ID); err != nil {
 		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
 	}
 
@@ -53,20 +124,53 @@ func (r *sessionRepository) Delete(ctx context.Context, session *entity.Session)
 	driver := transaction.GetDriver(ctx, r.db)
 	model := transformer.ToSessionModel(session)
 
-	if _, err := driver.ExecContext(ctx, `DELETE FROM sessions WHERE account_id = ?;`, model.AccountID); err != nil {
+	if _, err := driver.ExecContext(ctx, `DELETE FROM sessions WHERE id = ?;`, model.ID); err != nil {
 		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
 	}
 
 	return nil
 }
 
-func (r *sessionRepository) FindOneByAccountID(ctx context.Context, accountID uuid.UUID) (*entity.Session, error) {
-	const errMessage = "faild to find session by account_id"
+func (r *sessionRepository) DeleteByAccountID(ctx context.Context, accountID uuid.UUID) error {
+	driver := transaction.GetDriver(ctx, r.db)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM sessions WHERE account_id = ?;`, accountID); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, "failed to delete sessions by account_id")
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) DeleteByAccountIDExcludingID(ctx context.Context, accountID, id uuid.UUID) error {
+	driver := transaction.GetDriver(ctx, r.db)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM sessions WHERE account_id = ? AND id <> ?;`, accountID, id); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, "failed to delete sessions by account_id excluding id")
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) DeleteExpired(ctx context.Context) error {
+	driver := transaction.GetDriver(ctx, r.db)
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`DELETE FROM sessions WHERE refresh_expires_at <= NOW(6) OR DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) <= NOW(6);`,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, "failed to delete expired sessions")
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) FindOneByIDAndAccountID(ctx context.Context, id, accountID uuid.UUID) (*entity.Session, error) {
+	const errMessage = "faild to find session by id and account_id"
 
 	return r.findOne(
 		ctx,
-		`SELECT account_id, token, expires_at FROM sessions WHERE account_id = ?;`,
-		[]any{accountID},
+		`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE id = ? AND account_id = ?;`,
+		[]any{id, accountID},
 		errMessage,
 	)
 }
@@ -76,12 +180,53 @@ func (r *sessionRepository) FindOneByTokenAndNotExpired(ctx context.Context, tok
 
 	return r.findOne(
 		ctx,
-		`SELECT account_id, token, expires_at FROM sessions WHERE token = ? AND expires_at > NOW(6);`,
-		[]any{token},
+		`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE token = ? AND expires_at > NOW(6) AND DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) > NOW(6);`,
+		[]any{hash.HMAC(r.secret, token)},
 		errMessage,
 	)
 }
 
+func (r *sessionRepository) FindOneByRefreshTokenAndNotExpiredForUpdate(ctx context.Context, refreshToken string) (*entity.Session, error) {
+	const errMessage = "faild to find session by refresh_token and not expired for update"
+
+	return r.findOne(
+		ctx,
+		`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE refresh_token = ? AND refresh_expires_at > NOW(6) AND DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) > NOW(6) LIMIT 1 FOR UPDATE;`,
+		[]any{hash.HMAC(r.secret, refreshToken)},
+		errMessage,
+	)
+}
+
+func (r *sessionRepository) FindOneByUsedRefreshToken(ctx context.Context, refreshToken string) (*entity.Session, error) {
+	const errMessage = "faild to find session by used refresh_token"
+
+	return r.findOne(
+		ctx,
+		`SELECT s.id, s.account_id, s.token, s.refresh_token, s.user_agent, s.ip_address, s.idle_timeout, s.expires_at, s.refresh_expires_at, s.issued_at, s.last_used_at, s.created_at FROM sessions AS s INNER JOIN used_refresh_tokens AS u ON u.session_id = s.id WHERE u.token = ?;`,
+		[]any{hash.HMAC(r.secret, refreshToken)},
+		errMessage,
+	)
+}
+
+func (r *sessionRepository) FindByAccountIDAndNotExpired(ctx context.Context, accountID uuid.UUID) ([]*entity.Session, error) {
+	const errMessage = "faild to find sessions by account_id and not expired"
+
+	return r.find(
+		ctx,
+		`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE account_id = ? AND refresh_expires_at > NOW(6) AND DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) > NOW(6) ORDER BY created_at DESC;`,
+		[]any{accountID},
+		errMessage,
+	)
+}
+
+// NOTE: DB漏洩時にトークンが悪用されないよう, トークンはハッシュ化した値のみを保存する.
+func (r *sessionRepository) toModel(session *entity.Session) *model.SessionModel {
+	model := transformer.ToSessionModel(session)
+	model.Token = hash.HMAC(r.secret, model.Token)
+	model.RefreshToken = hash.HMAC(r.secret, model.RefreshToken)
+	return model
+}
+
 // nolint:dupl // 集約単位のrepository実装. 集約境界を保つためrepository間での共通化は行わず重複を許容.
 func (r *sessionRepository) findOne(ctx context.Context, query string, args []any, errMessage string) (*entity.Session, error) {
 	driver := transaction.GetDriver(ctx, r.db)
@@ -96,3 +241,14 @@ func (r *sessionRepository) findOne(ctx context.Context, query string, args []an
 
 	return transformer.ToSessionEntity(&model), nil
 }
+
+func (r *sessionRepository) find(ctx context.Context, query string, args []any, errMessage string) ([]*entity.Session, error) {
+	driver := transaction.GetDriver(ctx, r.db)
+	var models []*model.SessionModel
+
+	if err := sqlx.SelectContext(ctx, driver, &models, query, args...); err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return transformer.ToSessionEntities(models), nil
+}
@@ -13,15 +13,100 @@ import (
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/hash"
 	"github.com/atsumarukun/holos-account-api/test/assert"
 	mockDatabase "github.com/atsumarukun/holos-account-api/test/mock/database"
 )
 
+var (
+	sessionTokenSecret = []byte("secret")
+	sessionColumns     = []string{"id", "account_id", "token", "refresh_token", "user_agent", "ip_address", "idle_timeout", "expires_at", "refresh_expires_at", "issued_at", "last_used_at", "created_at"}
+)
+
 func TestSession_Create(t *testing.T) {
 	session := &entity.Session{
-		AccountID: uuid.New(),
-		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
-		ExpiresAt: time.Now().Add(time.Hour * 24 * 7),
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		IdleTimeout:      time.Hour * 2,
+		ExpiresAt:        time.Now().Add(time.Minute * 15),
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24),
+		IssuedAt:         time.Now(),
+		LastUsedAt:       time.Now(),
+		CreatedAt:        time.Now(),
+	}
+
+	tests := []struct {
+		name         string
+		inputSession *entity.Session
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "success",
+			inputSession: session,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO sessions (id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`)).
+					WithArgs(session.ID, session.AccountID, hash.HMAC(sessionTokenSecret, session.Token), hash.HMAC(sessionTokenSecret, session.RefreshToken), session.UserAgent, session.IPAddress, int64(session.IdleTimeout/time.Second), session.ExpiresAt, session.RefreshExpiresAt, session.IssuedAt, session.LastUsedAt, session.CreatedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "session is nil",
+			inputSession: nil,
+			expectError:  repository.ErrNilSession,
+			setMockDB:    func(mock sqlmock.Sqlmock) {},
+		},
+
+		{
+			name:         "insert error",
+			inputSession: session,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO sessions (id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`)).
+					WithArgs(session.ID, session.AccountID, hash.HMAC(sessionTokenSecret, session.Token), hash.HMAC(sessionTokenSecret, session.RefreshToken), session.UserAgent, session.IPAddress, int64(session.IdleTimeout/time.Second), session.ExpiresAt, session.RefreshExpiresAt, session.IssuedAt, session.LastUsedAt, session.CreatedAt).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBSessionRepository(db, sessionTokenSecret)
+			err := repo.Create(t.Context(), tt.inputSession)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSession_Update(t *testing.T) {
+	session := &entity.Session{
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		IdleTimeout:      time.Hour * 2,
+		ExpiresAt:        time.Now().Add(time.Minute * 15),
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24),
+		IssuedAt:         time.Now(),
+		LastUsedAt:       time.Now(),
+		CreatedAt:        time.Now(),
 	}
 
 	tests := []struct {
@@ -35,8 +120,12 @@ func TestSession_Create(t *testing.T) {
 			inputSession: session,
 			expectError:  nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(regexp.QuoteMeta(`REPLACE sessions (account_id, token, expires_at) VALUES (?, ?, ?);`)).
-					WithArgs(session.AccountID, session.Token, session.ExpiresAt).
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO used_refresh_tokens (token, session_id) SELECT refresh_token, id FROM sessions WHERE id = ? AND refresh_token <> ?;`)).
+					WithArgs(session.ID, hash.HMAC(sessionTokenSecret, session.RefreshToken)).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE sessions SET token = ?, refresh_token = ?, expires_at = ?, issued_at = ?, last_used_at = ? WHERE id = ?;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, session.Token), hash.HMAC(sessionTokenSecret, session.RefreshToken), session.ExpiresAt, session.IssuedAt, session.LastUsedAt, session.ID).
 					WillReturnResult(sqlmock.NewResult(1, 1)).
 					WillReturnError(nil)
 			},
@@ -49,12 +138,27 @@ func TestSession_Create(t *testing.T) {
 		},
 
 		{
-			name:         "replace error",
+			name:         "insert used refresh token error",
+			inputSession: session,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO used_refresh_tokens (token, session_id) SELECT refresh_token, id FROM sessions WHERE id = ? AND refresh_token <> ?;`)).
+					WithArgs(session.ID, hash.HMAC(sessionTokenSecret, session.RefreshToken)).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+		{
+			name:         "update error",
 			inputSession: session,
 			expectError:  sql.ErrConnDone,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(regexp.QuoteMeta(`REPLACE sessions (account_id, token, expires_at) VALUES (?, ?, ?);`)).
-					WithArgs(session.AccountID, session.Token, session.ExpiresAt).
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO used_refresh_tokens (token, session_id) SELECT refresh_token, id FROM sessions WHERE id = ? AND refresh_token <> ?;`)).
+					WithArgs(session.ID, hash.HMAC(sessionTokenSecret, session.RefreshToken)).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE sessions SET token = ?, refresh_token = ?, expires_at = ?, issued_at = ?, last_used_at = ? WHERE id = ?;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, session.Token), hash.HMAC(sessionTokenSecret, session.RefreshToken), session.ExpiresAt, session.IssuedAt, session.LastUsedAt, session.ID).
 					WillReturnResult(nil).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -67,8 +171,78 @@ func TestSession_Create(t *testing.T) {
 
 			tt.setMockDB(mock)
 
-			repo := database.NewDBSessionRepository(db)
-			err := repo.Save(t.Context(), tt.inputSession)
+			repo := database.NewDBSessionRepository(db, sessionTokenSecret)
+			err := repo.Update(t.Context(), tt.inputSession)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSession_UpdateLastUsedAt(t *testing.T) {
+	session := &entity.Session{
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		IdleTimeout:      time.Hour * 2,
+		ExpiresAt:        time.Now().Add(time.Minute * 15),
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24),
+		IssuedAt:         time.Now(),
+		LastUsedAt:       time.Now(),
+		CreatedAt:        time.Now(),
+	}
+
+	tests := []struct {
+		name         string
+		inputSession *entity.Session
+		expectError  error
+		setMockDB    func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:         "success",
+			inputSession: session,
+			expectError:  nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE sessions SET last_used_at = ? WHERE id = ?;`)).
+					WithArgs(session.LastUsedAt, session.ID).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:         "session is nil",
+			inputSession: nil,
+			expectError:  repository.ErrNilSession,
+			setMockDB:    func(mock sqlmock.Sqlmock) {},
+		},
+
+		{
+			name:         "update error",
+			inputSession: session,
+			expectError:  sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE sessions SET last_used_at = ? WHERE id = ?;`)).
+					WithArgs(session.LastUsedAt, session.ID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBSessionRepository(db, sessionTokenSecret)
+			err := repo.UpdateLastUsedAt(t.Context(), tt.inputSession)
 			assert.Error(t, err, tt.expectError)
 
 			if err := mock.ExpectationsWereMet(); err != nil {
@@ -80,9 +254,18 @@ func TestSession_Create(t *testing.T) {
 
 func TestSession_Delete(t *testing.T) {
 	session := &entity.Session{
-		AccountID: uuid.New(),
-		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
-		ExpiresAt: time.Now().Add(time.Hour * 24 * 7),
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		IdleTimeout:      time.Hour * 2,
+		ExpiresAt:        time.Now().Add(time.Minute * 15),
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24),
+		IssuedAt:         time.Now(),
+		LastUsedAt:       time.Now(),
+		CreatedAt:        time.Now(),
 	}
 
 	tests := []struct {
@@ -96,8 +279,8 @@ func TestSession_Delete(t *testing.T) {
 			inputSession: session,
 			expectError:  nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM sessions WHERE account_id = ?;`)).
-					WithArgs(session.AccountID).
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM sessions WHERE id = ?;`)).
+					WithArgs(session.ID).
 					WillReturnResult(sqlmock.NewResult(1, 1)).
 					WillReturnError(nil)
 			},
@@ -114,8 +297,8 @@ func TestSession_Delete(t *testing.T) {
 			inputSession: session,
 			expectError:  sql.ErrConnDone,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM sessions WHERE account_id = ?;`)).
-					WithArgs(session.AccountID).
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM sessions WHERE id = ?;`)).
+					WithArgs(session.ID).
 					WillReturnResult(nil).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -128,7 +311,7 @@ func TestSession_Delete(t *testing.T) {
 
 			tt.setMockDB(mock)
 
-			repo := database.NewDBSessionRepository(db)
+			repo := database.NewDBSessionRepository(db, sessionTokenSecret)
 			err := repo.Delete(t.Context(), tt.inputSession)
 			assert.Error(t, err, tt.expectError)
 
@@ -139,15 +322,173 @@ func TestSession_Delete(t *testing.T) {
 	}
 }
 
-func TestSession_FindOneByAccountID(t *testing.T) {
+func TestSession_DeleteByAccountID(t *testing.T) {
+	accountID := uuid.New()
+
+	tests := []struct {
+		name           string
+		inputAccountID uuid.UUID
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "success",
+			inputAccountID: accountID,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM sessions WHERE account_id = ?;`)).
+					WithArgs(accountID).
+					WillReturnResult(sqlmock.NewResult(0, 2)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "delete error",
+			inputAccountID: accountID,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM sessions WHERE account_id = ?;`)).
+					WithArgs(accountID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBSessionRepository(db, sessionTokenSecret)
+			err := repo.DeleteByAccountID(t.Context(), tt.inputAccountID)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSession_DeleteByAccountIDExcludingID(t *testing.T) {
+	accountID := uuid.New()
+	id := uuid.New()
+
+	tests := []struct {
+		name           string
+		inputAccountID uuid.UUID
+		inputID        uuid.UUID
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "success",
+			inputAccountID: accountID,
+			inputID:        id,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM sessions WHERE account_id = ? AND id <> ?;`)).
+					WithArgs(accountID, id).
+					WillReturnResult(sqlmock.NewResult(0, 2)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "delete error",
+			inputAccountID: accountID,
+			inputID:        id,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM sessions WHERE account_id = ? AND id <> ?;`)).
+					WithArgs(accountID, id).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBSessionRepository(db, sessionTokenSecret)
+			err := repo.DeleteByAccountIDExcludingID(t.Context(), tt.inputAccountID, tt.inputID)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSession_DeleteExpired(t *testing.T) {
+	tests := []struct {
+		name        string
+		expectError error
+		setMockDB   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:        "success",
+			expectError: nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM sessions WHERE refresh_expires_at <= NOW(6) OR DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) <= NOW(6);`)).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+
+		{
+			name:        "delete error",
+			expectError: sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM sessions WHERE refresh_expires_at <= NOW(6) OR DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) <= NOW(6);`)).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBSessionRepository(db, sessionTokenSecret)
+			err := repo.DeleteExpired(t.Context())
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSession_FindOneByIDAndAccountID(t *testing.T) {
 	session := &entity.Session{
-		AccountID: uuid.New(),
-		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
-		ExpiresAt: time.Now().Add(time.Hour * 24 * 7),
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		IdleTimeout:      time.Hour * 2,
+		ExpiresAt:        time.Now().Add(time.Minute * 15),
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24),
+		IssuedAt:         time.Now(),
+		LastUsedAt:       time.Now(),
+		CreatedAt:        time.Now(),
 	}
 
 	tests := []struct {
 		name           string
+		inputID        uuid.UUID
 		inputAccountID uuid.UUID
 		expectResult   *entity.Session
 		expectError    error
@@ -155,38 +496,41 @@ func TestSession_FindOneByAccountID(t *testing.T) {
 	}{
 		{
 			name:           "success",
+			inputID:        session.ID,
 			inputAccountID: session.AccountID,
 			expectResult:   session,
 			expectError:    nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, token, expires_at FROM sessions WHERE account_id = ?;`)).
-					WithArgs(session.AccountID).
-					WillReturnRows(sqlmock.NewRows([]string{"account_id", "token", "expires_at"}).AddRow(session.AccountID, session.Token, session.ExpiresAt)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE id = ? AND account_id = ?;`)).
+					WithArgs(session.ID, session.AccountID).
+					WillReturnRows(sqlmock.NewRows(sessionColumns).AddRow(session.ID, session.AccountID, session.Token, session.RefreshToken, session.UserAgent, session.IPAddress, int64(session.IdleTimeout/time.Second), session.ExpiresAt, session.RefreshExpiresAt, session.IssuedAt, session.LastUsedAt, session.CreatedAt)).
 					WillReturnError(nil)
 			},
 		},
 		{
 			name:           "not found",
+			inputID:        session.ID,
 			inputAccountID: session.AccountID,
 			expectResult:   nil,
 			expectError:    nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, token, expires_at FROM sessions WHERE account_id = ?;`)).
-					WithArgs(session.AccountID).
-					WillReturnRows(sqlmock.NewRows([]string{"account_id", "token", "expires_at"})).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE id = ? AND account_id = ?;`)).
+					WithArgs(session.ID, session.AccountID).
+					WillReturnRows(sqlmock.NewRows(sessionColumns)).
 					WillReturnError(nil)
 			},
 		},
 
 		{
 			name:           "find error",
+			inputID:        session.ID,
 			inputAccountID: session.AccountID,
 			expectResult:   nil,
 			expectError:    sql.ErrConnDone,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, token, expires_at FROM sessions WHERE account_id = ?;`)).
-					WithArgs(session.AccountID).
-					WillReturnRows(sqlmock.NewRows([]string{"account_id", "token", "expires_at"})).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE id = ? AND account_id = ?;`)).
+					WithArgs(session.ID, session.AccountID).
+					WillReturnRows(sqlmock.NewRows(sessionColumns)).
 					WillReturnError(sql.ErrConnDone)
 			},
 		},
@@ -198,8 +542,8 @@ func TestSession_FindOneByAccountID(t *testing.T) {
 
 			tt.setMockDB(mock)
 
-			repo := database.NewDBSessionRepository(db)
-			result, err := repo.FindOneByAccountID(t.Context(), tt.inputAccountID)
+			repo := database.NewDBSessionRepository(db, sessionTokenSecret)
+			result, err := repo.FindOneByIDAndAccountID(t.Context(), tt.inputID, tt.inputAccountID)
 			assert.Error(t, err, tt.expectError)
 
 			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
@@ -215,9 +559,18 @@ func TestSession_FindOneByAccountID(t *testing.T) {
 
 func TestSession_FindOneByTokenAndNotExpired(t *testing.T) {
 	session := &entity.Session{
-		AccountID: uuid.New(),
-		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
-		ExpiresAt: time.Now().Add(time.Hour * 24 * 7),
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		IdleTimeout:      time.Hour * 2,
+		ExpiresAt:        time.Now().Add(time.Minute * 15),
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24),
+		IssuedAt:         time.Now(),
+		LastUsedAt:       time.Now(),
+		CreatedAt:        time.Now(),
 	}
 
 	tests := []struct {
@@ -233,9 +586,9 @@ func TestSession_FindOneByTokenAndNotExpired(t *testing.T) {
 			expectResult: session,
 			expectError:  nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, token, expires_at FROM sessions WHERE token = ? AND expires_at > NOW(6);`)).
-					WithArgs("1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS").
-					WillReturnRows(sqlmock.NewRows([]string{"account_id", "token", "expires_at"}).AddRow(session.AccountID, session.Token, session.ExpiresAt)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE token = ? AND expires_at > NOW(6) AND DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) > NOW(6);`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS")).
+					WillReturnRows(sqlmock.NewRows(sessionColumns).AddRow(session.ID, session.AccountID, session.Token, session.RefreshToken, session.UserAgent, session.IPAddress, int64(session.IdleTimeout/time.Second), session.ExpiresAt, session.RefreshExpiresAt, session.IssuedAt, session.LastUsedAt, session.CreatedAt)).
 					WillReturnError(nil)
 			},
 		},
@@ -245,9 +598,9 @@ func TestSession_FindOneByTokenAndNotExpired(t *testing.T) {
 			expectResult: nil,
 			expectError:  nil,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, token, expires_at FROM sessions WHERE token = ? AND expires_at > NOW(6);`)).
-					WithArgs("1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS").
-					WillReturnRows(sqlmock.NewRows([]string{"account_id", "token", "expires_at"})).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE token = ? AND expires_at > NOW(6) AND DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) > NOW(6);`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS")).
+					WillReturnRows(sqlmock.NewRows(sessionColumns)).
 					WillReturnError(nil)
 			},
 		},
@@ -258,9 +611,9 @@ func TestSession_FindOneByTokenAndNotExpired(t *testing.T) {
 			expectResult: nil,
 			expectError:  sql.ErrConnDone,
 			setMockDB: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, token, expires_at FROM sessions WHERE token = ? AND expires_at > NOW(6);`)).
-					WithArgs("1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS").
-					WillReturnRows(sqlmock.NewRows([]string{"account_id", "token", "expires_at"})).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE token = ? AND expires_at > NOW(6) AND DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) > NOW(6);`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS")).
+					WillReturnRows(sqlmock.NewRows(sessionColumns)).
 					WillReturnError(sql.ErrConnDone)
 			},
 		},
@@ -272,7 +625,7 @@ func TestSession_FindOneByTokenAndNotExpired(t *testing.T) {
 
 			tt.setMockDB(mock)
 
-			repo := database.NewDBSessionRepository(db)
+			repo := database.NewDBSessionRepository(db, sessionTokenSecret)
 			result, err := repo.FindOneByTokenAndNotExpired(t.Context(), tt.inputToken)
 			assert.Error(t, err, tt.expectError)
 
@@ -286,3 +639,252 @@ func TestSession_FindOneByTokenAndNotExpired(t *testing.T) {
 		})
 	}
 }
+
+func TestSession_FindOneByRefreshTokenAndNotExpiredForUpdate(t *testing.T) {
+	session := &entity.Session{
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		IdleTimeout:      time.Hour * 2,
+		ExpiresAt:        time.Now().Add(time.Minute * 15),
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24),
+		IssuedAt:         time.Now(),
+		LastUsedAt:       time.Now(),
+		CreatedAt:        time.Now(),
+	}
+
+	tests := []struct {
+		name              string
+		inputRefreshToken string
+		expectResult      *entity.Session
+		expectError       error
+		setMockDB         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:              "success",
+			inputRefreshToken: "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+			expectResult:      session,
+			expectError:       nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE refresh_token = ? AND refresh_expires_at > NOW(6) AND DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) > NOW(6) LIMIT 1 FOR UPDATE;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K")).
+					WillReturnRows(sqlmock.NewRows(sessionColumns).AddRow(session.ID, session.AccountID, session.Token, session.RefreshToken, session.UserAgent, session.IPAddress, int64(session.IdleTimeout/time.Second), session.ExpiresAt, session.RefreshExpiresAt, session.IssuedAt, session.LastUsedAt, session.CreatedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:              "not found",
+			inputRefreshToken: "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+			expectResult:      nil,
+			expectError:       nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE refresh_token = ? AND refresh_expires_at > NOW(6) AND DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) > NOW(6) LIMIT 1 FOR UPDATE;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K")).
+					WillReturnRows(sqlmock.NewRows(sessionColumns)).
+					WillReturnError(nil)
+			},
+		},
+
+		{
+			name:              "find error",
+			inputRefreshToken: "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+			expectResult:      nil,
+			expectError:       sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE refresh_token = ? AND refresh_expires_at > NOW(6) AND DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) > NOW(6) LIMIT 1 FOR UPDATE;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K")).
+					WillReturnRows(sqlmock.NewRows(sessionColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBSessionRepository(db, sessionTokenSecret)
+			result, err := repo.FindOneByRefreshTokenAndNotExpiredForUpdate(t.Context(), tt.inputRefreshToken)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSession_FindOneByUsedRefreshToken(t *testing.T) {
+	session := &entity.Session{
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		IdleTimeout:      time.Hour * 2,
+		ExpiresAt:        time.Now().Add(time.Minute * 15),
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24),
+		IssuedAt:         time.Now(),
+		LastUsedAt:       time.Now(),
+		CreatedAt:        time.Now(),
+	}
+
+	tests := []struct {
+		name              string
+		inputRefreshToken string
+		expectResult      *entity.Session
+		expectError       error
+		setMockDB         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:              "success",
+			inputRefreshToken: "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+			expectResult:      session,
+			expectError:       nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT s.id, s.account_id, s.token, s.refresh_token, s.user_agent, s.ip_address, s.idle_timeout, s.expires_at, s.refresh_expires_at, s.issued_at, s.last_used_at, s.created_at FROM sessions AS s INNER JOIN used_refresh_tokens AS u ON u.session_id = s.id WHERE u.token = ?;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K")).
+					WillReturnRows(sqlmock.NewRows(sessionColumns).AddRow(session.ID, session.AccountID, session.Token, session.RefreshToken, session.UserAgent, session.IPAddress, int64(session.IdleTimeout/time.Second), session.ExpiresAt, session.RefreshExpiresAt, session.IssuedAt, session.LastUsedAt, session.CreatedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:              "not found",
+			inputRefreshToken: "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+			expectResult:      nil,
+			expectError:       nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT s.id, s.account_id, s.token, s.refresh_token, s.user_agent, s.ip_address, s.idle_timeout, s.expires_at, s.refresh_expires_at, s.issued_at, s.last_used_at, s.created_at FROM sessions AS s INNER JOIN used_refresh_tokens AS u ON u.session_id = s.id WHERE u.token = ?;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K")).
+					WillReturnRows(sqlmock.NewRows(sessionColumns)).
+					WillReturnError(nil)
+			},
+		},
+
+		{
+			name:              "find error",
+			inputRefreshToken: "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+			expectResult:      nil,
+			expectError:       sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT s.id, s.account_id, s.token, s.refresh_token, s.user_agent, s.ip_address, s.idle_timeout, s.expires_at, s.refresh_expires_at, s.issued_at, s.last_used_at, s.created_at FROM sessions AS s INNER JOIN used_refresh_tokens AS u ON u.session_id = s.id WHERE u.token = ?;`)).
+					WithArgs(hash.HMAC(sessionTokenSecret, "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K")).
+					WillReturnRows(sqlmock.NewRows(sessionColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBSessionRepository(db, sessionTokenSecret)
+			result, err := repo.FindOneByUsedRefreshToken(t.Context(), tt.inputRefreshToken)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSession_FindByAccountIDAndNotExpired(t *testing.T) {
+	session := &entity.Session{
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		IdleTimeout:      time.Hour * 2,
+		ExpiresAt:        time.Now().Add(time.Minute * 15),
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24),
+		IssuedAt:         time.Now(),
+		LastUsedAt:       time.Now(),
+		CreatedAt:        time.Now(),
+	}
+
+	tests := []struct {
+		name           string
+		inputAccountID uuid.UUID
+		expectResult   []*entity.Session
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "success",
+			inputAccountID: session.AccountID,
+			expectResult:   []*entity.Session{session},
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE account_id = ? AND refresh_expires_at > NOW(6) AND DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) > NOW(6) ORDER BY created_at DESC;`)).
+					WithArgs(session.AccountID).
+					WillReturnRows(sqlmock.NewRows(sessionColumns).AddRow(session.ID, session.AccountID, session.Token, session.RefreshToken, session.UserAgent, session.IPAddress, int64(session.IdleTimeout/time.Second), session.ExpiresAt, session.RefreshExpiresAt, session.IssuedAt, session.LastUsedAt, session.CreatedAt)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "not found",
+			inputAccountID: session.AccountID,
+			expectResult:   nil,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE account_id = ? AND refresh_expires_at > NOW(6) AND DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) > NOW(6) ORDER BY created_at DESC;`)).
+					WithArgs(session.AccountID).
+					WillReturnRows(sqlmock.NewRows(sessionColumns)).
+					WillReturnError(nil)
+			},
+		},
+
+		{
+			name:           "find error",
+			inputAccountID: session.AccountID,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, account_id, token, refresh_token, user_agent, ip_address, idle_timeout, expires_at, refresh_expires_at, issued_at, last_used_at, created_at FROM sessions WHERE account_id = ? AND refresh_expires_at > NOW(6) AND DATE_ADD(last_used_at, INTERVAL idle_timeout SECOND) > NOW(6) ORDER BY created_at DESC;`)).
+					WithArgs(session.AccountID).
+					WillReturnRows(sqlmock.NewRows(sessionColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBSessionRepository(db, sessionTokenSecret)
+			result, err := repo.FindByAccountIDAndNotExpired(t.Context(), tt.inputAccountID)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/encryption"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/transformer"
+)
+
+type totpRepository struct {
+	db     *sqlx.DB
+	secret []byte
+}
+
+// NOTE: TOTPのシークレットは認証時に元の値が必要なため, ハッシュ化ではなく暗号化した値を保存する.
+func NewDBTOTPRepository(db *sqlx.DB, secret []byte) repository.TOTPRepository {
+	return &totpRepository{
+		db:     db,
+		secret: secret,
+	}
+}
+
+func (r *totpRepository) Save(ctx context.Context, totp *entity.TOTP) error {
+	const errMessage = "failed to save totp"
+
+	if totp == nil {
+		return errors.Wrap(repository.ErrNilTOTP, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToTOTPModel(totp)
+
+	secret, err := encryption.Encrypt(r.secret, model.Secret)
+	if err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	if _, err := driver.ExecContext(
+		ctx,
+		`INSERT INTO totps (account_id, secret, enabled, last_used_step) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE secret = VALUES(secret), enabled = VALUES(enabled), last_used_step = VALUES(last_used_step);`,
+		model.AccountID,
+		secret,
+		model.Enabled,
+		model.LastUsedStep,
+	); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *totpRepository) Delete(ctx context.Context, totp *entity.TOTP) error {
+	const errMessage = "failed to delete totp"
+
+	if totp == nil {
+		return errors.Wrap(repository.ErrNilTOTP, errors.CodeInternalServerError, errMessage)
+	}
+
+	driver := transaction.GetDriver(ctx, r.db)
+	model := transformer.ToTOTPModel(totp)
+
+	if _, err := driver.ExecContext(ctx, `DELETE FROM totps WHERE account_id = ? LIMIT 1;`, model.AccountID); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
+
+func (r *totpRepository) FindOneByAccountID(ctx context.Context, accountID uuid.UUID) (*entity.TOTP, error) {
+	return r.findOne(
+		ctx,
+		`SELECT account_id, secret, enabled, last_used_step FROM totps WHERE account_id = ? LIMIT 1;`,
+		accountID,
+		"faild to find totp by account_id",
+	)
+}
+
+func (r *totpRepository) FindOneByAccountIDForUpdate(ctx context.Context, accountID uuid.UUID) (*entity.TOTP, error) {
+	return r.findOne(
+		ctx,
+		`SELECT account_id, secret, enabled, last_used_step FROM totps WHERE account_id = ? LIMIT 1 FOR UPDATE;`,
+		accountID,
+		"faild to find totp by account_id for update",
+	)
+}
+
+func (r *totpRepository) findOne(ctx context.Context, query string, accountID uuid.UUID, errMessage string) (*entity.TOTP, error) {
+	driver := transaction.GetDriver(ctx, r.db)
+	var model model.TOTPModel
+
+	if err := driver.QueryRowxContext(ctx, query, accountID).StructScan(&model); err != nil {
+		if stderr.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	secret, err := encryption.Decrypt(r.secret, model.Secret)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+	model.Secret = secret
+
+	return transformer.ToTOTPEntity(&model), nil
+}
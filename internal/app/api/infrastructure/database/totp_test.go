@@ -0,0 +1,294 @@
+package database_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/encryption"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	mockDatabase "github.com/atsumarukun/holos-account-api/test/mock/database"
+)
+
+var totpColumns = []string{"account_id", "secret", "enabled", "last_used_step"}
+
+func TestTOTP_Save(t *testing.T) {
+	totp := &entity.TOTP{
+		AccountID:    uuid.New(),
+		Secret:       []byte("12345678901234567890"),
+		Enabled:      true,
+		LastUsedStep: 58000000,
+	}
+
+	tests := []struct {
+		name        string
+		inputTOTP   *entity.TOTP
+		expectError error
+		setMockDB   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:        "successfully saved",
+			inputTOTP:   totp,
+			expectError: nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO totps (account_id, secret, enabled, last_used_step) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE secret = VALUES(secret), enabled = VALUES(enabled), last_used_step = VALUES(last_used_step);`)).
+					WithArgs(totp.AccountID, sqlmock.AnyArg(), totp.Enabled, totp.LastUsedStep).
+					WillReturnResult(sqlmock.NewResult(1, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:        "totp is nil",
+			inputTOTP:   nil,
+			expectError: repository.ErrNilTOTP,
+			setMockDB:   func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:        "save error",
+			inputTOTP:   totp,
+			expectError: sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO totps (account_id, secret, enabled, last_used_step) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE secret = VALUES(secret), enabled = VALUES(enabled), last_used_step = VALUES(last_used_step);`)).
+					WithArgs(totp.AccountID, sqlmock.AnyArg(), totp.Enabled, totp.LastUsedStep).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBTOTPRepository(db, sessionTokenSecret)
+			err := repo.Save(t.Context(), tt.inputTOTP)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestTOTP_Delete(t *testing.T) {
+	totp := &entity.TOTP{
+		AccountID: uuid.New(),
+		Secret:    []byte("12345678901234567890"),
+	}
+
+	tests := []struct {
+		name        string
+		inputTOTP   *entity.TOTP
+		expectError error
+		setMockDB   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:        "successfully deleted",
+			inputTOTP:   totp,
+			expectError: nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM totps WHERE account_id = ? LIMIT 1;`)).
+					WithArgs(totp.AccountID).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:        "totp is nil",
+			inputTOTP:   nil,
+			expectError: repository.ErrNilTOTP,
+			setMockDB:   func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:        "delete error",
+			inputTOTP:   totp,
+			expectError: sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM totps WHERE account_id = ? LIMIT 1;`)).
+					WithArgs(totp.AccountID).
+					WillReturnResult(nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBTOTPRepository(db, sessionTokenSecret)
+			err := repo.Delete(t.Context(), tt.inputTOTP)
+			assert.Error(t, err, tt.expectError)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestTOTP_FindOneByAccountID(t *testing.T) {
+	totp := &entity.TOTP{
+		AccountID:    uuid.New(),
+		Secret:       []byte("12345678901234567890"),
+		Enabled:      true,
+		LastUsedStep: 58000000,
+	}
+	encrypted, err := encryption.Encrypt(sessionTokenSecret, totp.Secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		inputAccountID uuid.UUID
+		expectResult   *entity.TOTP
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully found",
+			inputAccountID: totp.AccountID,
+			expectResult:   totp,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, secret, enabled, last_used_step FROM totps WHERE account_id = ? LIMIT 1;`)).
+					WithArgs(totp.AccountID).
+					WillReturnRows(sqlmock.NewRows(totpColumns).AddRow(totp.AccountID, encrypted, totp.Enabled, totp.LastUsedStep)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "not found",
+			inputAccountID: totp.AccountID,
+			expectResult:   nil,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, secret, enabled, last_used_step FROM totps WHERE account_id = ? LIMIT 1;`)).
+					WithArgs(totp.AccountID).
+					WillReturnRows(sqlmock.NewRows(totpColumns)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "decrypt error",
+			inputAccountID: totp.AccountID,
+			expectResult:   nil,
+			expectError:    encryption.ErrInvalidCiphertext,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, secret, enabled, last_used_step FROM totps WHERE account_id = ? LIMIT 1;`)).
+					WithArgs(totp.AccountID).
+					WillReturnRows(sqlmock.NewRows(totpColumns).AddRow(totp.AccountID, totp.Secret, totp.Enabled, totp.LastUsedStep)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "find error",
+			inputAccountID: totp.AccountID,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, secret, enabled, last_used_step FROM totps WHERE account_id = ? LIMIT 1;`)).
+					WithArgs(totp.AccountID).
+					WillReturnRows(sqlmock.NewRows(totpColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBTOTPRepository(db, sessionTokenSecret)
+			result, err := repo.FindOneByAccountID(t.Context(), tt.inputAccountID)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestTOTP_FindOneByAccountIDForUpdate(t *testing.T) {
+	totp := &entity.TOTP{
+		AccountID: uuid.New(),
+		Secret:    []byte("12345678901234567890"),
+	}
+	encrypted, err := encryption.Encrypt(sessionTokenSecret, totp.Secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		inputAccountID uuid.UUID
+		expectResult   *entity.TOTP
+		expectError    error
+		setMockDB      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:           "successfully found",
+			inputAccountID: totp.AccountID,
+			expectResult:   totp,
+			expectError:    nil,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, secret, enabled, last_used_step FROM totps WHERE account_id = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(totp.AccountID).
+					WillReturnRows(sqlmock.NewRows(totpColumns).AddRow(totp.AccountID, encrypted, totp.Enabled, totp.LastUsedStep)).
+					WillReturnError(nil)
+			},
+		},
+		{
+			name:           "find error",
+			inputAccountID: totp.AccountID,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockDB: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id, secret, enabled, last_used_step FROM totps WHERE account_id = ? LIMIT 1 FOR UPDATE;`)).
+					WithArgs(totp.AccountID).
+					WillReturnRows(sqlmock.NewRows(totpColumns)).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := mockDatabase.NewMockDatabase(t)
+			defer db.Close()
+
+			tt.setMockDB(mock)
+
+			repo := database.NewDBTOTPRepository(db, sessionTokenSecret)
+			result, err := repo.FindOneByAccountIDForUpdate(t.Context(), tt.inputAccountID)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package transformer
 
 import (
+	"database/sql"
+
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
 )
@@ -11,9 +13,13 @@ func ToAccountModel(account *entity.Account) *model.AccountModel {
 	}
 
 	return &model.AccountModel{
-		ID:       account.ID,
-		Name:     account.Name,
-		Password: account.Password,
+		ID:        account.ID,
+		Name:      account.Name,
+		Password:  account.Password,
+		Email:     sql.NullString{String: account.Email, Valid: account.Email != ""},
+		CreatedAt: account.CreatedAt,
+		UpdatedAt: account.UpdatedAt,
+		DeletedAt: sql.NullTime{Time: account.DeletedAt, Valid: !account.DeletedAt.IsZero()},
 	}
 }
 
@@ -22,5 +28,17 @@ func ToAccountEntity(account *model.AccountModel) *entity.Account {
 		return nil
 	}
 
-	return entity.RestoreAccount(account.ID, account.Name, account.Password)
+	return entity.RestoreAccount(account.ID, account.Name, account.Password, account.Email.String, account.CreatedAt, account.UpdatedAt, account.DeletedAt.Time)
+}
+
+func ToAccountEntities(accounts []*model.AccountModel) []*entity.Account {
+	if accounts == nil {
+		return nil
+	}
+
+	entities := make([]*entity.Account, len(accounts))
+	for i, account := range accounts {
+		entities[i] = ToAccountEntity(account)
+	}
+	return entities
 }
@@ -0,0 +1,25 @@
+package transformer
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+)
+
+func ToAccountNameTombstoneModel(tombstone *entity.AccountNameTombstone) *model.AccountNameTombstoneModel {
+	if tombstone == nil {
+		return nil
+	}
+
+	return &model.AccountNameTombstoneModel{
+		Name:       tombstone.Name,
+		ReleasedAt: tombstone.ReleasedAt,
+	}
+}
+
+func ToAccountNameTombstoneEntity(tombstone *model.AccountNameTombstoneModel) *entity.AccountNameTombstone {
+	if tombstone == nil {
+		return nil
+	}
+
+	return entity.RestoreAccountNameTombstone(tombstone.Name, tombstone.ReleasedAt)
+}
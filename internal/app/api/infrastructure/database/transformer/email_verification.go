@@ -0,0 +1,34 @@
+package transformer
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+)
+
+func ToEmailVerificationModel(verification *entity.EmailVerification) *model.EmailVerificationModel {
+	if verification == nil {
+		return nil
+	}
+
+	return &model.EmailVerificationModel{
+		AccountID: verification.AccountID,
+		Email:     verification.Email,
+		Token:     verification.Token,
+		ExpiresAt: verification.ExpiresAt,
+		CreatedAt: verification.CreatedAt,
+	}
+}
+
+func ToEmailVerificationEntity(verification *model.EmailVerificationModel) *entity.EmailVerification {
+	if verification == nil {
+		return nil
+	}
+
+	return entity.RestoreEmailVerification(
+		verification.AccountID,
+		verification.Email,
+		verification.Token,
+		verification.ExpiresAt,
+		verification.CreatedAt,
+	)
+}
@@ -0,0 +1,38 @@
+package transformer
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+)
+
+func ToLoginChallengeModel(challenge *entity.LoginChallenge) *model.LoginChallengeModel {
+	if challenge == nil {
+		return nil
+	}
+
+	return &model.LoginChallengeModel{
+		ID:         challenge.ID,
+		AccountID:  challenge.AccountID,
+		Token:      challenge.Token,
+		RememberMe: challenge.RememberMe,
+		Attempts:   challenge.Attempts,
+		ExpiresAt:  challenge.ExpiresAt,
+		CreatedAt:  challenge.CreatedAt,
+	}
+}
+
+func ToLoginChallengeEntity(challenge *model.LoginChallengeModel) *entity.LoginChallenge {
+	if challenge == nil {
+		return nil
+	}
+
+	return entity.RestoreLoginChallenge(
+		challenge.ID,
+		challenge.AccountID,
+		challenge.Token,
+		challenge.RememberMe,
+		challenge.Attempts,
+		challenge.ExpiresAt,
+		challenge.CreatedAt,
+	)
+}
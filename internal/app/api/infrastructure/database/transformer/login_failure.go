@@ -0,0 +1,36 @@
+package transformer
+
+import (
+	"database/sql"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+)
+
+func ToLoginFailureModel(failure *entity.LoginFailure) *model.LoginFailureModel {
+	if failure == nil {
+		return nil
+	}
+
+	return &model.LoginFailureModel{
+		TargetType:   failure.TargetType,
+		Target:       failure.Target,
+		Count:        failure.Count,
+		LockedUntil:  sql.NullTime{Time: failure.LockedUntil, Valid: !failure.LockedUntil.IsZero()},
+		LastFailedAt: failure.LastFailedAt,
+	}
+}
+
+func ToLoginFailureEntity(failure *model.LoginFailureModel) *entity.LoginFailure {
+	if failure == nil {
+		return nil
+	}
+
+	return entity.RestoreLoginFailure(
+		failure.TargetType,
+		failure.Target,
+		failure.Count,
+		failure.LockedUntil.Time,
+		failure.LastFailedAt,
+	)
+}
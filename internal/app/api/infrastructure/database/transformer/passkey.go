@@ -0,0 +1,54 @@
+package transformer
+
+import (
+	"database/sql"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+)
+
+func ToPasskeyModel(passkey *entity.Passkey) *model.PasskeyModel {
+	if passkey == nil {
+		return nil
+	}
+
+	return &model.PasskeyModel{
+		ID:           passkey.ID,
+		AccountID:    passkey.AccountID,
+		CredentialID: passkey.CredentialID,
+		PublicKey:    passkey.PublicKey,
+		SignCount:    passkey.SignCount,
+		Name:         passkey.Name,
+		LastUsedAt:   sql.NullTime{Time: passkey.LastUsedAt, Valid: !passkey.LastUsedAt.IsZero()},
+		CreatedAt:    passkey.CreatedAt,
+	}
+}
+
+func ToPasskeyEntity(passkey *model.PasskeyModel) *entity.Passkey {
+	if passkey == nil {
+		return nil
+	}
+
+	return entity.RestorePasskey(
+		passkey.ID,
+		passkey.AccountID,
+		passkey.CredentialID,
+		passkey.PublicKey,
+		passkey.SignCount,
+		passkey.Name,
+		passkey.LastUsedAt.Time,
+		passkey.CreatedAt,
+	)
+}
+
+func ToPasskeyEntities(passkeys []*model.PasskeyModel) []*entity.Passkey {
+	if passkeys == nil {
+		return nil
+	}
+
+	entities := make([]*entity.Passkey, len(passkeys))
+	for i, passkey := range passkeys {
+		entities[i] = ToPasskeyEntity(passkey)
+	}
+	return entities
+}
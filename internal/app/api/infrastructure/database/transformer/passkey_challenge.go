@@ -0,0 +1,38 @@
+package transformer
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+)
+
+func ToPasskeyChallengeModel(challenge *entity.PasskeyChallenge) *model.PasskeyChallengeModel {
+	if challenge == nil {
+		return nil
+	}
+
+	return &model.PasskeyChallengeModel{
+		ID:        challenge.ID,
+		AccountID: uuid.NullUUID{UUID: challenge.AccountID, Valid: challenge.AccountID != uuid.Nil},
+		Purpose:   challenge.Purpose,
+		Challenge: challenge.Challenge,
+		ExpiresAt: challenge.ExpiresAt,
+		CreatedAt: challenge.CreatedAt,
+	}
+}
+
+func ToPasskeyChallengeEntity(challenge *model.PasskeyChallengeModel) *entity.PasskeyChallenge {
+	if challenge == nil {
+		return nil
+	}
+
+	return entity.RestorePasskeyChallenge(
+		challenge.ID,
+		challenge.AccountID.UUID,
+		challenge.Purpose,
+		challenge.Challenge,
+		challenge.ExpiresAt,
+		challenge.CreatedAt,
+	)
+}
@@ -0,0 +1,32 @@
+package transformer
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+)
+
+func ToPasswordResetModel(reset *entity.PasswordReset) *model.PasswordResetModel {
+	if reset == nil {
+		return nil
+	}
+
+	return &model.PasswordResetModel{
+		AccountID: reset.AccountID,
+		Token:     reset.Token,
+		ExpiresAt: reset.ExpiresAt,
+		CreatedAt: reset.CreatedAt,
+	}
+}
+
+func ToPasswordResetEntity(reset *model.PasswordResetModel) *entity.PasswordReset {
+	if reset == nil {
+		return nil
+	}
+
+	return entity.RestorePasswordReset(
+		reset.AccountID,
+		reset.Token,
+		reset.ExpiresAt,
+		reset.CreatedAt,
+	)
+}
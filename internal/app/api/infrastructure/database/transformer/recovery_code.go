@@ -0,0 +1,32 @@
+package transformer
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+)
+
+func ToRecoveryCodeModel(code *entity.RecoveryCode) *model.RecoveryCodeModel {
+	if code == nil {
+		return nil
+	}
+
+	return &model.RecoveryCodeModel{
+		ID:        code.ID,
+		AccountID: code.AccountID,
+		Code:      code.Code,
+		CreatedAt: code.CreatedAt,
+	}
+}
+
+func ToRecoveryCodeEntity(code *model.RecoveryCodeModel) *entity.RecoveryCode {
+	if code == nil {
+		return nil
+	}
+
+	return entity.RestoreRecoveryCode(
+		code.ID,
+		code.AccountID,
+		code.Code,
+		code.CreatedAt,
+	)
+}
@@ -0,0 +1,34 @@
+package transformer
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+)
+
+// NOTE: 権限は複数のロールの分をまとめて取得するため, ロールIDが一致するもののみを割り当てる.
+func ToRoleEntity(role *model.RoleModel, permissions []*model.RolePermissionModel) *entity.Role {
+	if role == nil {
+		return nil
+	}
+
+	var rolePermissions []entity.Permission
+	for _, permission := range permissions {
+		if permission.RoleID == role.ID {
+			rolePermissions = append(rolePermissions, entity.Permission(permission.Permission))
+		}
+	}
+
+	return entity.RestoreRole(role.ID, role.Name, rolePermissions)
+}
+
+func ToRoleEntities(roles []*model.RoleModel, permissions []*model.RolePermissionModel) []*entity.Role {
+	if roles == nil {
+		return nil
+	}
+
+	entities := make([]*entity.Role, len(roles))
+	for i, role := range roles {
+		entities[i] = ToRoleEntity(role, permissions)
+	}
+	return entities
+}
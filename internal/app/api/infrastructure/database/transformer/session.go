@@ -1,6 +1,8 @@
 package transformer
 
 import (
+	"time"
+
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
 )
@@ -11,9 +13,18 @@ func ToSessionModel(session *entity.Session) *model.SessionModel {
 	}
 
 	return &model.SessionModel{
-		AccountID: session.AccountID,
-		Token:     session.Token,
-		ExpiresAt: session.ExpiresAt,
+		ID:               session.ID,
+		AccountID:        session.AccountID,
+		Token:            session.Token,
+		RefreshToken:     session.RefreshToken,
+		UserAgent:        session.UserAgent,
+		IPAddress:        session.IPAddress,
+		IdleTimeout:      int64(session.IdleTimeout / time.Second),
+		ExpiresAt:        session.ExpiresAt,
+		RefreshExpiresAt: session.RefreshExpiresAt,
+		IssuedAt:         session.IssuedAt,
+		LastUsedAt:       session.LastUsedAt,
+		CreatedAt:        session.CreatedAt,
 	}
 }
 
@@ -22,5 +33,30 @@ func ToSessionEntity(session *model.SessionModel) *entity.Session {
 		return nil
 	}
 
-	return entity.RestoreSession(session.AccountID, session.Token, session.ExpiresAt)
+	return entity.RestoreSession(
+		session.ID,
+		session.AccountID,
+		session.Token,
+		session.RefreshToken,
+		session.UserAgent,
+		session.IPAddress,
+		time.Duration(session.IdleTimeout)*time.Second,
+		session.ExpiresAt,
+		session.RefreshExpiresAt,
+		session.IssuedAt,
+		session.LastUsedAt,
+		session.CreatedAt,
+	)
+}
+
+func ToSessionEntities(sessions []*model.SessionModel) []*entity.Session {
+	if sessions == nil {
+		return nil
+	}
+
+	entities := make([]*entity.Session, len(sessions))
+	for i, session := range sessions {
+		entities[i] = ToSessionEntity(session)
+	}
+	return entities
 }
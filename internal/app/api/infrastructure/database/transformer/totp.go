@@ -0,0 +1,32 @@
+package transformer
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/model"
+)
+
+func ToTOTPModel(totp *entity.TOTP) *model.TOTPModel {
+	if totp == nil {
+		return nil
+	}
+
+	return &model.TOTPModel{
+		AccountID:    totp.AccountID,
+		Secret:       totp.Secret,
+		Enabled:      totp.Enabled,
+		LastUsedStep: totp.LastUsedStep,
+	}
+}
+
+func ToTOTPEntity(totp *model.TOTPModel) *entity.TOTP {
+	if totp == nil {
+		return nil
+	}
+
+	return entity.RestoreTOTP(
+		totp.AccountID,
+		totp.Secret,
+		totp.Enabled,
+		totp.LastUsedStep,
+	)
+}
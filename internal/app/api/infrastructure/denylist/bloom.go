@@ -0,0 +1,104 @@
+package denylist
+
+import (
+	"bufio"
+	stderr "errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+var ErrInvalidFalsePositiveRate = stderr.New("false positive rate must be between 0 and 1")
+
+type bloomDenylist struct {
+	bits   []uint64
+	size   uint64
+	hashes uint64
+}
+
+// NOTE: パスワードを1行ずつ記載したファイルを読み込み, ブルームフィルタとして保持する.
+// 偽陽性により稀に一覧に無いパスワードも拒否されるが, 一覧そのものを保持するより大幅にメモリを削減できる.
+func NewBloomDenylist(path string, falsePositiveRate float64) (entity.PasswordDenylist, error) {
+	if falsePositiveRate <= 0 || 1 <= falsePositiveRate {
+		return nil, ErrInvalidFalsePositiveRate
+	}
+
+	passwords, err := readPasswords(path)
+	if err != nil {
+		return nil, err
+	}
+
+	n := math.Max(float64(len(passwords)), 1)
+	size := uint64(math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	hashes := uint64(math.Max(math.Round(float64(size)/n*math.Ln2), 1))
+
+	d := &bloomDenylist{
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: hashes,
+	}
+	for _, password := range passwords {
+		d.add(password)
+	}
+
+	return d, nil
+}
+
+func (d *bloomDenylist) Contains(password string) bool {
+	h1, h2 := hash(password)
+	for i := range d.hashes {
+		bit := (h1 + i*h2) % d.size
+		if d.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *bloomDenylist) add(password string) {
+	h1, h2 := hash(password)
+	for i := range d.hashes {
+		bit := (h1 + i*h2) % d.size
+		d.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// NOTE: 大文字小文字のみ異なるパスワードも推測が容易なため, NFKCで正規化し小文字に揃えてからハッシュ化する.
+// 128bitのハッシュ値を2つに分割し, その線形結合で各ハッシュ関数の値を求める.
+func hash(password string) (uint64, uint64) {
+	h := fnv.New128a()
+	h.Write([]byte(strings.ToLower(entity.NormalizePassword(password))))
+	sum := h.Sum(nil)
+
+	var h1, h2 uint64
+	for i := range 8 {
+		h1 = h1<<8 | uint64(sum[i])
+		h2 = h2<<8 | uint64(sum[i+8])
+	}
+	return h1, h2 | 1
+}
+
+func readPasswords(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var passwords []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if password := strings.TrimSpace(scanner.Text()); password != "" {
+			passwords = append(passwords, password)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return passwords, nil
+}
@@ -0,0 +1,76 @@
+package denylist_test
+
+import (
+	stderr "errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/denylist"
+)
+
+func writeDenylist(t *testing.T, passwords []string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "denylist.txt")
+	if err := os.WriteFile(path, []byte(strings.Join(passwords, "\n")+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestBloomDenylist_New(t *testing.T) {
+	path := writeDenylist(t, []string{"password"})
+
+	tests := []struct {
+		name                   string
+		inputPath              string
+		inputFalsePositiveRate float64
+		expectError            error
+	}{
+		{name: "successfully loaded", inputPath: path, inputFalsePositiveRate: 0.001, expectError: nil},
+		{name: "file not found", inputPath: filepath.Join(t.TempDir(), "missing.txt"), inputFalsePositiveRate: 0.001, expectError: os.ErrNotExist},
+		{name: "invalid false positive rate", inputPath: path, inputFalsePositiveRate: 1, expectError: denylist.ErrInvalidFalsePositiveRate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := denylist.NewBloomDenylist(tt.inputPath, tt.inputFalsePositiveRate)
+			if !stderr.Is(err, tt.expectError) {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestBloomDenylist_Contains(t *testing.T) {
+	passwords := make([]string, 1000)
+	for i := range passwords {
+		passwords[i] = fmt.Sprintf("password%d", i)
+	}
+
+	d, err := denylist.NewBloomDenylist(writeDenylist(t, passwords), 0.001)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, password := range passwords {
+		if !d.Contains(password) {
+			t.Errorf("%s is not contained", password)
+		}
+	}
+	if !d.Contains("PASSWORD0") {
+		t.Error("upper case password is not contained")
+	}
+
+	var falsePositives int
+	for i := range 10000 {
+		if d.Contains(fmt.Sprintf("Tr0ub4dor&%d", i)) {
+			falsePositives++
+		}
+	}
+	if 100 < falsePositives {
+		t.Errorf("too many false positives: %d", falsePositives)
+	}
+}
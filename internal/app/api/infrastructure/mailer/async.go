@@ -0,0 +1,36 @@
+package mailer
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/mailer"
+)
+
+const asyncSendTimeout = time.Minute
+
+type asyncMailer struct {
+	mailer mailer.Mailer
+}
+
+// NOTE: 送信に要する時間や送信結果を呼び出し元に返さないよう, 送信はリクエストと切り離して行い, エラーはログに記録する.
+func NewAsyncMailer(mailer mailer.Mailer) mailer.Mailer {
+	return &asyncMailer{
+		mailer: mailer,
+	}
+}
+
+func (m *asyncMailer) Send(ctx context.Context, mail *mailer.Mail) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), asyncSendTimeout)
+
+	go func() {
+		defer cancel()
+
+		if err := m.mailer.Send(ctx, mail); err != nil {
+			slog.ErrorContext(ctx, err.Error())
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package mailer_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/mailer"
+	infraMailer "github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/mailer"
+	mockMailer "github.com/atsumarukun/holos-account-api/test/mock/domain/pkg/mailer"
+)
+
+func TestAsyncMailer_Send(t *testing.T) {
+	mail := &mailer.Mail{To: "name@example.com", Subject: "subject", Body: "body"}
+
+	tests := []struct {
+		name       string
+		sendError  error
+		cancelCall bool
+	}{
+		{
+			name:      "successfully sent",
+			sendError: nil,
+		},
+		{
+			name:      "send error",
+			sendError: errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to send mail"),
+		},
+		{
+			name:       "request canceled",
+			sendError:  nil,
+			cancelCall: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx, cancel := context.WithCancel(t.Context())
+			defer cancel()
+
+			release := make(chan struct{})
+			done := make(chan error, 1)
+
+			m := mockMailer.NewMockMailer(ctrl)
+			m.
+				EXPECT().
+				Send(gomock.Any(), mail).
+				DoAndReturn(func(ctx context.Context, _ *mailer.Mail) error {
+					<-release
+					done <- ctx.Err()
+					return tt.sendError
+				}).
+				Times(1)
+
+			if err := infraMailer.NewAsyncMailer(m).Send(ctx, mail); err != nil {
+				t.Errorf("\nexpect: %v\ngot: %v", nil, err)
+			}
+			if tt.cancelCall {
+				cancel()
+			}
+			close(release)
+
+			select {
+			case err := <-done:
+				if err != nil {
+					t.Errorf("\nexpect: %v\ngot: %v", nil, err)
+				}
+			case <-time.After(time.Second):
+				t.Error("mail was not sent")
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package mailer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/mailer"
+)
+
+type fileMailer struct {
+	dir  string
+	from string
+}
+
+// NOTE: 開発環境やテスト用に, メールを送信せずディレクトリへ.eml形式のファイルとして書き出す.
+func NewFileMailer(dir, from string) mailer.Mailer {
+	return &fileMailer{
+		dir:  dir,
+		from: from,
+	}
+}
+
+func (m *fileMailer) Send(_ context.Context, mail *mailer.Mail) error {
+	const errMessage = "failed to send mail"
+
+	now := time.Now()
+
+	message, err := buildMessage(m.from, mail, now)
+	if err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	if err := os.MkdirAll(m.dir, 0o700); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	name := now.UTC().Format("20060102T150405.000000000Z") + "_" + hex.EncodeToString(suffix) + ".eml"
+	if err := os.WriteFile(filepath.Join(m.dir, name), message, 0o600); err != nil {
+		return errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return nil
+}
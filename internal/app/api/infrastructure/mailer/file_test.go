@@ -0,0 +1,79 @@
+package mailer_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/mailer"
+	infraMailer "github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/mailer"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+)
+
+func TestFileMailer_Send(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputMail   *mailer.Mail
+		expectError error
+		expectLines []string
+	}{
+		{
+			name:        "successfully written",
+			inputMail:   &mailer.Mail{To: "name@example.com", Subject: "subject", Body: "line1\nline2"},
+			expectError: nil,
+			expectLines: []string{"From: noreply@example.com\r\n", "To: name@example.com\r\n", "Subject: subject\r\n", "Content-Type: text/plain; charset=UTF-8\r\n", "\r\n\r\nline1\r\nline2"},
+		},
+		{
+			name:        "non ascii subject",
+			inputMail:   &mailer.Mail{To: "name@example.com", Subject: "確認", Body: "body"},
+			expectError: nil,
+			expectLines: []string{"Subject: =?UTF-8?q?=E7=A2=BA=E8=AA=8D?=\r\n"},
+		},
+		{
+			name:        "mail is nil",
+			inputMail:   nil,
+			expectError: infraMailer.ErrNilMail,
+		},
+		{
+			name:        "recipient is not set",
+			inputMail:   &mailer.Mail{To: "", Subject: "subject", Body: "body"},
+			expectError: infraMailer.ErrMailRecipientNotSet,
+		},
+		{
+			name:        "header injection",
+			inputMail:   &mailer.Mail{To: "name@example.com", Subject: "subject\r\nBcc: other@example.com", Body: "body"},
+			expectError: infraMailer.ErrInvalidHeaderValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "mails")
+
+			m := infraMailer.NewFileMailer(dir, "noreply@example.com")
+			err := m.Send(t.Context(), tt.inputMail)
+			assert.Error(t, err, tt.expectError)
+
+			files, _ := filepath.Glob(filepath.Join(dir, "*.eml"))
+			if tt.expectError != nil {
+				if len(files) != 0 {
+					t.Errorf("\nexpect: %v\ngot: %v", 0, len(files))
+				}
+				return
+			}
+			if len(files) != 1 {
+				t.Fatalf("\nexpect: %v\ngot: %v", 1, len(files))
+			}
+
+			content, err := os.ReadFile(files[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, line := range tt.expectLines {
+				if !strings.Contains(string(content), line) {
+					t.Errorf("%q is not contained in %q", line, content)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package mailer
+
+import (
+	"bytes"
+	stderr "errors"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/mailer"
+)
+
+var (
+	ErrNilMail             = stderr.New("mail must not be nil")
+	ErrInvalidHeaderValue  = stderr.New("mail header must not contain line breaks")
+	ErrMailRecipientNotSet = stderr.New("mail recipient is not set")
+)
+
+// NOTE: 本文はUTF-8のプレーンテキストとし, 件名は非ASCII文字を含む場合のみエンコードする.
+// ヘッダインジェクションを防ぐため, 改行を含むヘッダ値は拒否する.
+func buildMessage(from string, mail *mailer.Mail, now time.Time) ([]byte, error) {
+	if mail == nil {
+		return nil, ErrNilMail
+	}
+	if mail.To == "" {
+		return nil, ErrMailRecipientNotSet
+	}
+	for _, value := range []string{from, mail.To, mail.Subject} {
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, ErrInvalidHeaderValue
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", mail.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(mail.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,146 @@
+package mailer_test
+
+import (
+	"encoding/base64"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/mailer"
+	infraMailer "github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/mailer"
+)
+
+type received struct {
+	auth string
+	from string
+	to   string
+	data string
+}
+
+// NOTE: 1接続のみを受け付け, 受信した内容を返す最小限のSMTPサーバー.
+func startSMTPServer(t *testing.T, rejectRcpt bool) (string, string, <-chan *received) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	ch := make(chan *received, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := &received{}
+		defer func() { ch <- r }()
+
+		tp := textproto.NewConn(conn)
+		_ = tp.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			cmd, arg, _ := strings.Cut(line, " ")
+			switch strings.ToUpper(cmd) {
+			case "EHLO":
+				_ = tp.PrintfLine("250-localhost")
+				_ = tp.PrintfLine("250 AUTH PLAIN")
+			case "AUTH":
+				_, credential, _ := strings.Cut(arg, " ")
+				decoded, _ := base64.StdEncoding.DecodeString(credential)
+				r.auth = string(decoded)
+				_ = tp.PrintfLine("235 2.7.0 Authentication successful")
+			case "MAIL":
+				r.from = arg
+				_ = tp.PrintfLine("250 OK")
+			case "RCPT":
+				if rejectRcpt {
+					_ = tp.PrintfLine("550 5.1.1 User unknown")
+					continue
+				}
+				r.to = arg
+				_ = tp.PrintfLine("250 OK")
+			case "DATA":
+				_ = tp.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+				data, err := tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				r.data = string(data)
+				_ = tp.PrintfLine("250 OK")
+			case "QUIT":
+				_ = tp.PrintfLine("221 Bye")
+				return
+			default:
+				_ = tp.PrintfLine("502 Command not implemented")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	return host, port, ch
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	mail := &mailer.Mail{To: "name@example.com", Subject: "subject", Body: "body"}
+
+	tests := []struct {
+		name        string
+		username    string
+		rejectRcpt  bool
+		expectError bool
+		expectAuth  string
+	}{
+		{name: "successfully sent", username: "", rejectRcpt: false, expectError: false, expectAuth: ""},
+		{name: "successfully sent with authentication", username: "user", rejectRcpt: false, expectError: false, expectAuth: "\x00user\x00password"},
+		{name: "recipient rejected", username: "", rejectRcpt: true, expectError: true, expectAuth: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, port, ch := startSMTPServer(t, tt.rejectRcpt)
+
+			m := infraMailer.NewSMTPMailer(host, port, tt.username, "password", "noreply@example.com")
+			err := m.Send(t.Context(), mail)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("\nexpect error: %v\ngot: %v", tt.expectError, err)
+			}
+
+			r := <-ch
+			if r.auth != tt.expectAuth {
+				t.Errorf("\nexpect: %q\ngot: %q", tt.expectAuth, r.auth)
+			}
+			if tt.expectError {
+				return
+			}
+			if r.from != "FROM:<noreply@example.com>" {
+				t.Errorf("\nexpect: %v\ngot: %v", "FROM:<noreply@example.com>", r.from)
+			}
+			if r.to != "TO:<name@example.com>" {
+				t.Errorf("\nexpect: %v\ngot: %v", "TO:<name@example.com>", r.to)
+			}
+			if !strings.Contains(r.data, "Subject: subject\n") || !strings.HasSuffix(r.data, "\nbody\n") {
+				t.Errorf("unexpected data: %q", r.data)
+			}
+		})
+	}
+}
+
+func TestSMTPMailer_Send_ConnectionRefused(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	listener.Close()
+
+	m := infraMailer.NewSMTPMailer(host, port, "", "", "noreply@example.com")
+	if err := m.Send(t.Context(), &mailer.Mail{To: "name@example.com", Subject: "subject", Body: "body"}); err == nil {
+		t.Error("expect error but got nil")
+	}
+}
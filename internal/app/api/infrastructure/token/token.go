@@ -0,0 +1,150 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	stderr "errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/token"
+)
+
+var (
+	ErrNoKeyFiles       = stderr.New("no key files")
+	ErrInvalidKeyFile   = stderr.New("key file must be a PEM encoded PKCS #8 private key")
+	ErrNotEd25519Key    = stderr.New("key must be an Ed25519 private key")
+	ErrNilTokenSubjects = stderr.New("account and session must not be nil")
+)
+
+type signingKey struct {
+	id        string
+	key       ed25519.PrivateKey
+	publicKey ed25519.PublicKey
+}
+
+type claims struct {
+	Name      string `json:"name"`
+	SessionID string `json:"sid"`
+	jwt.RegisteredClaims
+}
+
+type accessTokenIssuer struct {
+	keys     []*signingKey
+	issuer   string
+	lifetime time.Duration
+}
+
+// NOTE: 先頭の鍵で署名し, 全ての鍵の公開鍵を公開する.
+// 新しい鍵を先頭に追加し, 旧鍵で署名したトークンの有効期限が切れた後に旧鍵を削除することで鍵をローテーションする.
+func NewEd25519AccessTokenIssuer(keyFiles []string, issuer string, lifetime time.Duration) (token.AccessTokenIssuer, error) {
+	if len(keyFiles) == 0 {
+		return nil, ErrNoKeyFiles
+	}
+
+	keys := make([]*signingKey, len(keyFiles))
+	for i, keyFile := range keyFiles {
+		key, err := loadKey(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+
+	return &accessTokenIssuer{
+		keys:     keys,
+		issuer:   issuer,
+		lifetime: lifetime,
+	}, nil
+}
+
+func (i *accessTokenIssuer) Issue(account *entity.Account, session *entity.Session) (string, error) {
+	const errMessage = "failed to issue access token"
+
+	if account == nil || session == nil {
+		return "", errors.Wrap(ErrNilTokenSubjects, errors.CodeInternalServerError, errMessage)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(i.lifetime)
+	if expiresAt.After(session.RefreshExpiresAt) {
+		expiresAt = session.RefreshExpiresAt
+	}
+
+	t := jwt.NewWithClaims(jwt.SigningMethodEdDSA, &claims{
+		Name:      account.Name,
+		SessionID: session.ID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    i.issuer,
+			Subject:   account.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+	t.Header["kid"] = i.keys[0].id
+
+	signed, err := t.SignedString(i.keys[0].key)
+	if err != nil {
+		return "", errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return signed, nil
+}
+
+func (i *accessTokenIssuer) PublicKeys() []*token.PublicKey {
+	keys := make([]*token.PublicKey, len(i.keys))
+	for j, key := range i.keys {
+		keys[j] = &token.PublicKey{
+			ID:  key.id,
+			Key: key.publicKey,
+		}
+	}
+	return keys
+}
+
+func loadKey(keyFile string) (*signingKey, error) {
+	b, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", keyFile, err)
+	}
+
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("%s: %w", keyFile, ErrInvalidKeyFile)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", keyFile, ErrInvalidKeyFile)
+	}
+
+	key, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", keyFile, ErrNotEd25519Key)
+	}
+	publicKey, ok := key.Public().(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", keyFile, ErrNotEd25519Key)
+	}
+
+	return &signingKey{
+		id:        thumbprint(publicKey),
+		key:       key,
+		publicKey: publicKey,
+	}, nil
+}
+
+// NOTE: 鍵IDはRFC 7638のJWK Thumbprintとし, 鍵ファイルの内容のみから一意に決まるようにする.
+func thumbprint(key ed25519.PublicKey) string {
+	x := base64.RawURLEncoding.EncodeToString(key)
+	sum := sha256.Sum256([]byte(`{"crv":"Ed25519","kty":"OKP","x":"` + x + `"}`))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,127 @@
+package token_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	stderr "errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/token"
+)
+
+func writeKeyFile(t *testing.T, name string) string {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestAccessTokenIssuer_New(t *testing.T) {
+	invalidKeyFile := filepath.Join(t.TempDir(), "invalid.pem")
+	if err := os.WriteFile(invalidKeyFile, []byte("invalid"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		inputKeyFiles []string
+		expectError   error
+	}{
+		{
+			name:          "success",
+			inputKeyFiles: []string{writeKeyFile(t, "current.pem"), writeKeyFile(t, "previous.pem")},
+			expectError:   nil,
+		},
+		{
+			name:          "no key files",
+			inputKeyFiles: nil,
+			expectError:   token.ErrNoKeyFiles,
+		},
+		{
+			name:          "invalid key file",
+			inputKeyFiles: []string{invalidKeyFile},
+			expectError:   token.ErrInvalidKeyFile,
+		},
+		{
+			name:          "key file not found",
+			inputKeyFiles: []string{filepath.Join(t.TempDir(), "missing.pem")},
+			expectError:   os.ErrNotExist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := token.NewEd25519AccessTokenIssuer(tt.inputKeyFiles, "holos-account-api", time.Minute*5)
+			if !stderr.Is(err, tt.expectError) {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestAccessTokenIssuer_Issue(t *testing.T) {
+	account := &entity.Account{
+		ID:   uuid.New(),
+		Name: "name",
+	}
+	session := &entity.Session{
+		ID:               uuid.New(),
+		AccountID:        account.ID,
+		RefreshExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	issuer, err := token.NewEd25519AccessTokenIssuer([]string{writeKeyFile(t, "current.pem"), writeKeyFile(t, "previous.pem")}, "holos-account-api", time.Minute*5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signed, err := issuer.Issue(account, session)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := issuer.PublicKeys()
+	if len(keys) != 2 {
+		t.Fatalf("\nexpect: %v\ngot: %v", 2, len(keys))
+	}
+
+	var claims jwt.MapClaims
+	parsed, err := jwt.ParseWithClaims(signed, &claims, func(t *jwt.Token) (any, error) {
+		if kid := t.Header["kid"]; kid != keys[0].ID {
+			return nil, jwt.ErrTokenUnverifiable
+		}
+		return keys[0].Key, nil
+	}, jwt.WithValidMethods([]string{"EdDSA"}), jwt.WithIssuer("holos-account-api"), jwt.WithSubject(account.ID.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.Valid {
+		t.Error("token is invalid")
+	}
+	if claims["name"] != account.Name {
+		t.Errorf("\nexpect: %v\ngot: %v", account.Name, claims["name"])
+	}
+	if claims["sid"] != session.ID.String() {
+		t.Errorf("\nexpect: %v\ngot: %v", session.ID.String(), claims["sid"])
+	}
+}
@@ -3,34 +3,157 @@ package api
 import (
 	"github.com/jmoiron/sqlx"
 
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/token"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/service"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/lock"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/database/pkg/transaction"
+	infraMailer "github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/mailer"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/handler"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/job"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/middleware"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cache"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cookie"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/ratelimit"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
 )
 
 var (
-	healthHdl        handler.HealthHandler
-	accountHdl       handler.AccountHandler
-	sessionHdl       handler.SessionHandler
-	authenticationMW middleware.AuthenticationMiddleware
+	healthHdl               handler.HealthHandler
+	accountHdl              handler.AccountHandler
+	sessionHdl              handler.SessionHandler
+	totpHdl                 handler.TOTPHandler
+	recoveryCodeHdl         handler.RecoveryCodeHandler
+	passkeyHdl              handler.PasskeyHandler
+	emailHdl                handler.EmailHandler
+	passwordResetHdl        handler.PasswordResetHandler
+	keyHdl                  handler.KeyHandler
+	adminAccountHdl         handler.AdminAccountHandler
+	authenticationMW        middleware.AuthenticationMiddleware
+	authorizationMW         middleware.AuthorizationMiddleware
+	serviceAuthenticationMW middleware.ServiceAuthenticationMiddleware
+	accountRateLimitMW      middleware.RateLimitMiddleware
+	sessionRateLimitMW      middleware.RateLimitMiddleware
+	cleanupJob              job.CleanupJob
+	bootstrapJob            job.BootstrapJob
 )
 
-func inject(db *sqlx.DB) {
+func inject(db *sqlx.DB, accessTokenIssuer token.AccessTokenIssuer, rateLimitStore ratelimit.Store, passwordPolicy entity.PasswordPolicy, conf *serverConfig) {
 	transactionObj := transaction.NewDBTransactionObject(db)
+	lockObj := lock.NewDBLockObject(db)
 
 	healthHdl = handler.NewHealthHandler()
 
 	accountRepo := database.NewDBAccountRepository(db)
-	accountServ := service.NewAccountService(accountRepo)
-	accountUC := usecase.NewAccountUsecase(transactionObj, accountRepo, accountServ)
-	accountHdl = handler.NewAccountHandler(accountUC)
+	accountNameTombstoneRepo := database.NewDBAccountNameTombstoneRepository(db)
+	sessionRepo := database.NewDBSessionRepository(db, []byte(conf.session.TokenSecret))
+	loginFailureRepo := database.NewDBLoginFailureRepository(db)
+	totpRepo := database.NewDBTOTPRepository(db, []byte(conf.session.TokenSecret))
+	loginChallengeRepo := database.NewDBLoginChallengeRepository(db, []byte(conf.session.TokenSecret))
+	recoveryCodeRepo := database.NewDBRecoveryCodeRepository(db, []byte(conf.session.TokenSecret))
+	passkeyRepo := database.NewDBPasskeyRepository(db)
+	passkeyChallengeRepo := database.NewDBPasskeyChallengeRepository(db, []byte(conf.session.TokenSecret))
+	emailVerificationRepo := database.NewDBEmailVerificationRepository(db, []byte(conf.session.TokenSecret))
+	passwordResetRepo := database.NewDBPasswordResetRepository(db, []byte(conf.session.TokenSecret))
+	roleRepo := database.NewDBRoleRepository(db)
 
-	sessionRepo := database.NewDBSessionRepository(db)
-	sessionUC := usecase.NewSessionUsecase(transactionObj, sessionRepo, accountRepo)
-	sessionHdl = handler.NewSessionHandler(sessionUC)
+	sessionCache := cache.NewSessionCache(conf.session.CacheTTL, conf.session.CacheSize)
+	sessionCookie := cookie.NewSessionCookie(conf.session.CookieDomain, conf.session.CookieSecure, conf.session.CookieSameSite, []byte(conf.session.TokenSecret))
 
-	authenticationMW = middleware.NewAuthenticationMiddleware(sessionUC)
+	passwordHasher := NewPasswordHasher(&conf.password)
+	relyingParty := webauthn.NewRelyingParty(conf.webauthn.RPID, conf.webauthn.RPName, conf.webauthn.Origins)
+	mailer := NewMailer(&conf.mail)
+	asyncMailer := infraMailer.NewAsyncMailer(mailer)
+
+	accountLoginPolicy := entity.LoginThrottlePolicy{
+		Threshold:  conf.login.AccountThreshold,
+		Lockout:    conf.login.Lockout,
+		MaxLockout: conf.login.MaxLockout,
+		Window:     conf.login.FailureWindow,
+	}
+	ipLoginPolicy := entity.LoginThrottlePolicy{
+		Threshold:  conf.login.IPThreshold,
+		Lockout:    conf.login.Lockout,
+		MaxLockout: conf.login.MaxLockout,
+		Window:     conf.login.FailureWindow,
+	}
+	accountRetentionPolicy := entity.AccountRetentionPolicy{
+		Retention:    conf.job.AccountRetention,
+		NameCooldown: conf.job.AccountNameCooldown,
+	}
+	accountServ := service.NewAccountService(accountRepo, accountNameTombstoneRepo, accountRetentionPolicy)
+	accountUC := usecase.NewAccountUsecase(transactionObj, accountRepo, sessionRepo, totpRepo, passkeyRepo, loginFailureRepo, accountServ, passwordPolicy, passwordHasher, conf.job.AccountRetention, accountLoginPolicy, ipLoginPolicy)
+	accountHdl = handler.NewAccountHandler(accountUC, sessionCache, sessionCookie)
+
+	sessionPolicy := entity.SessionPolicy{
+		Lifetime:    conf.session.Lifetime,
+		IdleTimeout: conf.session.IdleTimeout,
+	}
+	rememberMeSessionPolicy := entity.SessionPolicy{
+		Lifetime:    conf.session.RememberMeLifetime,
+		IdleTimeout: conf.session.RememberMeIdleTimeout,
+	}
+	sessionUC := usecase.NewSessionUsecase(
+		transactionObj,
+		sessionRepo,
+		accountRepo,
+		loginFailureRepo,
+		totpRepo,
+		loginChallengeRepo,
+		recoveryCodeRepo,
+		passkeyRepo,
+		passkeyChallengeRepo,
+		roleRepo,
+		relyingParty,
+		accessTokenIssuer,
+		passwordHasher,
+		sessionPolicy,
+		rememberMeSessionPolicy,
+		accountLoginPolicy,
+		ipLoginPolicy,
+	)
+	sessionHdl = handler.NewSessionHandler(sessionUC, sessionCache, sessionCookie)
+
+	totpUC := usecase.NewTOTPUsecase(transactionObj, accountRepo, totpRepo, recoveryCodeRepo, passwordHasher, conf.totp.Issuer)
+	totpHdl = handler.NewTOTPHandler(totpUC)
+
+	recoveryCodeUC := usecase.NewRecoveryCodeUsecase(transactionObj, accountRepo, totpRepo, recoveryCodeRepo, passwordHasher)
+	recoveryCodeHdl = handler.NewRecoveryCodeHandler(recoveryCodeUC)
+
+	passkeyUC := usecase.NewPasskeyUsecase(transactionObj, accountRepo, passkeyRepo, passkeyChallengeRepo, relyingParty)
+	passkeyHdl = handler.NewPasskeyHandler(passkeyUC)
+
+	emailUC := usecase.NewEmailUsecase(transactionObj, accountRepo, emailVerificationRepo, accountServ, mailer, passwordHasher)
+	emailHdl = handler.NewEmailHandler(emailUC)
+
+	passwordResetUC := usecase.NewPasswordResetUsecase(transactionObj, accountRepo, passwordResetRepo, sessionRepo, asyncMailer, passwordPolicy, passwordHasher)
+	passwordResetHdl = handler.NewPasswordResetHandler(passwordResetUC, sessionCache)
+
+	keyUC := usecase.NewKeyUsecase(accessTokenIssuer)
+	keyHdl = handler.NewKeyHandler(keyUC)
+
+	adminAccountUC := usecase.NewAdminAccountUsecase(transactionObj, accountRepo, sessionRepo, totpRepo, passkeyRepo, roleRepo, passwordResetRepo, asyncMailer, conf.job.AccountRetention)
+	adminAccountHdl = handler.NewAdminAccountHandler(adminAccountUC, sessionCache)
+
+	authenticationMW = middleware.NewAuthenticationMiddleware(sessionUC, sessionCache, sessionCookie)
+	authorizationMW = middleware.NewAuthorizationMiddleware()
+
+	serviceAuthenticationMW = middleware.NewServiceAuthenticationMiddleware(conf.introspection.Clients)
+
+	accountRateLimitMW = middleware.NewRateLimitMiddleware(rateLimitStore, "accounts", ratelimit.Rate{
+		Limit:  conf.rateLimit.AccountLimit,
+		Period: conf.rateLimit.AccountPeriod,
+	})
+	sessionRateLimitMW = middleware.NewRateLimitMiddleware(rateLimitStore, "sessions", ratelimit.Rate{
+		Limit:  conf.rateLimit.SessionLimit,
+		Period: conf.rateLimit.SessionPeriod,
+	})
+
+	cleanupUC := usecase.NewCleanupUsecase(lockObj, transactionObj, sessionRepo, loginChallengeRepo, passkeyChallengeRepo, emailVerificationRepo, passwordResetRepo, accountRepo, accountNameTombstoneRepo, loginFailureRepo, accountServ, accountRetentionPolicy, conf.login.FailureWindow)
+	cleanupJob = job.NewCleanupJob(cleanupUC)
+
+	roleUC := usecase.NewRoleUsecase(lockObj, transactionObj, accountRepo, roleRepo)
+	bootstrapJob = job.NewBootstrapJob(roleUC, conf.admin.BootstrapAccount)
 }
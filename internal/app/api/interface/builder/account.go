@@ -11,6 +11,50 @@ func ToAccountResponse(account *dto.AccountDTO) *schema.AccountResponse {
 	}
 
 	return &schema.AccountResponse{
-		Name: account.Name,
+		ID:        account.ID,
+		Name:      account.Name,
+		Email:     account.Email,
+		CreatedAt: account.CreatedAt,
+		UpdatedAt: account.UpdatedAt,
+	}
+}
+
+func ToGetAccountResponse(account *dto.AccountDTO, security *dto.AccountSecurityDTO) *schema.GetAccountResponse {
+	if account == nil || security == nil {
+		return nil
+	}
+
+	return &schema.GetAccountResponse{
+		ID:        account.ID,
+		Name:      account.Name,
+		Email:     account.Email,
+		CreatedAt: account.CreatedAt,
+		UpdatedAt: account.UpdatedAt,
+		Security: &schema.AccountSecurityResponse{
+			TwoFactorEnabled: security.TwoFactorEnabled,
+			PasskeyCount:     security.PasskeyCount,
+		},
+	}
+}
+
+func ToUpdateAccountPasswordResponse(account *dto.AccountDTO, session *dto.SessionDTO) *schema.UpdateAccountPasswordResponse {
+	if account == nil {
+		return nil
+	}
+
+	return &schema.UpdateAccountPasswordResponse{
+		Name:    account.Name,
+		Session: ToSessionResponse(session),
+	}
+}
+
+func ToDeleteAccountResponse(account *dto.DeletedAccountDTO) *schema.DeleteAccountResponse {
+	if account == nil {
+		return nil
+	}
+
+	return &schema.DeleteAccountResponse{
+		Name:            account.Name,
+		RestoreDeadline: account.RestoreDeadline,
 	}
 }
@@ -0,0 +1,68 @@
+package builder
+
+import (
+	"time"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+func ToAdminAccountResponse(account *dto.AccountDTO) *schema.AdminAccountResponse {
+	if account == nil {
+		return nil
+	}
+
+	return &schema.AdminAccountResponse{
+		ID:        account.ID,
+		Name:      account.Name,
+		Email:     account.Email,
+		Roles:     append([]string{}, account.Roles...),
+		CreatedAt: account.CreatedAt,
+		UpdatedAt: account.UpdatedAt,
+		DeletedAt: toDeletedAt(account.DeletedAt),
+	}
+}
+
+func ToGetAdminAccountsResponse(page *dto.AccountPageDTO) *schema.GetAdminAccountsResponse {
+	if page == nil {
+		return nil
+	}
+
+	accounts := make([]*schema.AdminAccountResponse, len(page.Accounts))
+	for i, account := range page.Accounts {
+		accounts[i] = ToAdminAccountResponse(account)
+	}
+
+	return &schema.GetAdminAccountsResponse{
+		Accounts:   accounts,
+		NextCursor: page.NextCursor,
+	}
+}
+
+func ToGetAdminAccountResponse(account *dto.AccountDTO, security *dto.AccountSecurityDTO) *schema.GetAdminAccountResponse {
+	if account == nil || security == nil {
+		return nil
+	}
+
+	return &schema.GetAdminAccountResponse{
+		ID:        account.ID,
+		Name:      account.Name,
+		Email:     account.Email,
+		Roles:     append([]string{}, account.Roles...),
+		CreatedAt: account.CreatedAt,
+		UpdatedAt: account.UpdatedAt,
+		DeletedAt: toDeletedAt(account.DeletedAt),
+		Security: &schema.AccountSecurityResponse{
+			TwoFactorEnabled: security.TwoFactorEnabled,
+			PasskeyCount:     security.PasskeyCount,
+		},
+	}
+}
+
+// NOTE: 削除されていないアカウントは削除日時を返却しない.
+func toDeletedAt(deletedAt time.Time) *time.Time {
+	if deletedAt.IsZero() {
+		return nil
+	}
+	return &deletedAt
+}
@@ -0,0 +1,33 @@
+package builder
+
+import (
+	"encoding/base64"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+func ToJWKResponse(key *dto.PublicKeyDTO) *schema.JWKResponse {
+	if key == nil {
+		return nil
+	}
+
+	return &schema.JWKResponse{
+		KeyType:   "OKP",
+		Curve:     "Ed25519",
+		X:         base64.RawURLEncoding.EncodeToString(key.Key),
+		KeyID:     key.ID,
+		Use:       "sig",
+		Algorithm: "EdDSA",
+	}
+}
+
+func ToJWKSResponse(keys []*dto.PublicKeyDTO) *schema.JWKSResponse {
+	responses := make([]*schema.JWKResponse, len(keys))
+	for i, key := range keys {
+		responses[i] = ToJWKResponse(key)
+	}
+	return &schema.JWKSResponse{
+		Keys: responses,
+	}
+}
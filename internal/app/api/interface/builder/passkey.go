@@ -0,0 +1,87 @@
+package builder
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+const (
+	passkeyCredentialType = "public-key"
+	passkeyRequired       = "required"
+)
+
+func ToPasskeyResponse(passkey *dto.PasskeyDTO) *schema.PasskeyResponse {
+	if passkey == nil {
+		return nil
+	}
+
+	response := &schema.PasskeyResponse{
+		ID:        passkey.ID,
+		Name:      passkey.Name,
+		CreatedAt: passkey.CreatedAt,
+	}
+	if !passkey.LastUsedAt.IsZero() {
+		response.LastUsedAt = &passkey.LastUsedAt
+	}
+	return response
+}
+
+func ToPasskeyResponses(passkeys []*dto.PasskeyDTO) []*schema.PasskeyResponse {
+	responses := make([]*schema.PasskeyResponse, len(passkeys))
+	for i, passkey := range passkeys {
+		responses[i] = ToPasskeyResponse(passkey)
+	}
+	return responses
+}
+
+// NOTE: パスワードを用いないログインに使用するため, 検出可能な認証情報とユーザー検証を必須とする.
+func ToPasskeyRegistrationOptionsResponse(options *dto.PasskeyRegistrationOptionsDTO) *schema.PasskeyRegistrationOptionsResponse {
+	if options == nil {
+		return nil
+	}
+
+	params := make([]schema.PasskeyCredentialParameterResponse, len(options.Algorithms))
+	for i, algorithm := range options.Algorithms {
+		params[i] = schema.PasskeyCredentialParameterResponse{Type: passkeyCredentialType, Alg: algorithm}
+	}
+
+	excludeCredentials := make([]schema.PasskeyCredentialDescriptorResponse, len(options.ExcludeCredentialIDs))
+	for i, id := range options.ExcludeCredentialIDs {
+		excludeCredentials[i] = schema.PasskeyCredentialDescriptorResponse{Type: passkeyCredentialType, ID: id}
+	}
+
+	return &schema.PasskeyRegistrationOptionsResponse{
+		Challenge: options.Challenge,
+		RP: schema.PasskeyRelyingPartyResponse{
+			ID:   options.RPID,
+			Name: options.RPName,
+		},
+		User: schema.PasskeyUserResponse{
+			ID:          options.UserID,
+			Name:        options.UserName,
+			DisplayName: options.UserName,
+		},
+		PubKeyCredParams:   params,
+		Timeout:            options.Timeout.Milliseconds(),
+		ExcludeCredentials: excludeCredentials,
+		AuthenticatorSelection: schema.PasskeyAuthenticatorSelectionResponse{
+			ResidentKey:      passkeyRequired,
+			UserVerification: passkeyRequired,
+		},
+		Attestation: webauthn.AttestationFormatNone,
+	}
+}
+
+func ToPasskeyAuthenticationOptionsResponse(options *dto.PasskeyAuthenticationOptionsDTO) *schema.PasskeyAuthenticationOptionsResponse {
+	if options == nil {
+		return nil
+	}
+
+	return &schema.PasskeyAuthenticationOptionsResponse{
+		Challenge:        options.Challenge,
+		RPID:             options.RPID,
+		Timeout:          options.Timeout.Milliseconds(),
+		UserVerification: passkeyRequired,
+	}
+}
@@ -0,0 +1,26 @@
+package builder
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+func ToRecoveryCodesResponse(codes *dto.RecoveryCodesDTO) *schema.RecoveryCodesResponse {
+	if codes == nil {
+		return nil
+	}
+
+	return &schema.RecoveryCodesResponse{
+		Codes: codes.Codes,
+	}
+}
+
+func ToRecoveryCodeCountResponse(count *dto.RecoveryCodeCountDTO) *schema.RecoveryCodeCountResponse {
+	if count == nil {
+		return nil
+	}
+
+	return &schema.RecoveryCodeCountResponse{
+		Remaining: count.Remaining,
+	}
+}
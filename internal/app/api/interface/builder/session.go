@@ -1,6 +1,10 @@
 package builder
 
 import (
+	"strings"
+
+	"github.com/google/uuid"
+
 	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
 )
@@ -11,17 +15,85 @@ func ToSessionResponse(session *dto.SessionDTO) *schema.SessionResponse {
 	}
 
 	return &schema.SessionResponse{
-		Token: session.Token,
+		Token:        session.Token,
+		RefreshToken: session.RefreshToken,
+		AccessToken:  session.AccessToken,
+	}
+}
+
+func ToCookieSessionResponse(session *dto.SessionDTO, csrfToken string) *schema.CookieSessionResponse {
+	if session == nil {
+		return nil
+	}
+
+	return &schema.CookieSessionResponse{
+		CSRFToken:   csrfToken,
+		AccessToken: session.AccessToken,
+	}
+}
+
+func ToLoginChallengeResponse(challenge *dto.LoginChallengeDTO) *schema.LoginChallengeResponse {
+	if challenge == nil {
+		return nil
+	}
+
+	return &schema.LoginChallengeResponse{
+		ChallengeToken: challenge.Token,
+		Methods:        challenge.Methods,
+		ExpiresAt:      challenge.ExpiresAt,
+	}
+}
+
+func ToSessionDetailResponse(session *dto.SessionDTO, currentID uuid.UUID) *schema.SessionDetailResponse {
+	if session == nil {
+		return nil
+	}
+
+	return &schema.SessionDetailResponse{
+		ID:         session.ID,
+		UserAgent:  session.UserAgent,
+		IPAddress:  session.IPAddress,
+		Current:    session.ID == currentID,
+		ExpiresAt:  session.RefreshExpiresAt,
+		LastUsedAt: session.LastUsedAt,
+		CreatedAt:  session.CreatedAt,
+	}
+}
+
+func ToSessionDetailResponses(sessions []*dto.SessionDTO, currentID uuid.UUID) []*schema.SessionDetailResponse {
+	responses := make([]*schema.SessionDetailResponse, len(sessions))
+	for i, session := range sessions {
+		responses[i] = ToSessionDetailResponse(session, currentID)
 	}
+	return responses
 }
 
-func ToVerifiedSessionResponse(account *dto.AccountDTO) *schema.VerifiedSessionResponse {
-	if account == nil {
+func ToVerifiedSessionResponse(session *dto.VerifiedSessionDTO) *schema.VerifiedSessionResponse {
+	if session == nil {
 		return nil
 	}
 
+	// NOTE: 他サービスで扱いやすいよう, ロールや権限が存在しない場合もnullではなく空配列を返す.
 	return &schema.VerifiedSessionResponse{
-		ID:   account.ID,
-		Name: account.Name,
+		ID:          session.Account.ID,
+		Name:        session.Account.Name,
+		Roles:       append([]string{}, session.Account.Roles...),
+		Permissions: append([]string{}, session.Account.Permissions...),
+	}
+}
+
+func ToIntrospectSessionResponse(session *dto.VerifiedSessionDTO) *schema.IntrospectSessionResponse {
+	if session == nil {
+		return &schema.IntrospectSessionResponse{Active: false}
+	}
+
+	return &schema.IntrospectSessionResponse{
+		Active:    true,
+		Scope:     strings.Join(session.Account.Permissions, " "),
+		Username:  session.Account.Name,
+		TokenType: "Session",
+		Exp:       session.Session.ExpiresAt.Unix(),
+		Iat:       session.Session.IssuedAt.Unix(),
+		Sub:       session.Account.ID.String(),
 	}
 }
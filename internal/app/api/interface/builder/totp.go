@@ -0,0 +1,17 @@
+package builder
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+func ToTOTPEnrollmentResponse(enrollment *dto.TOTPEnrollmentDTO) *schema.TOTPEnrollmentResponse {
+	if enrollment == nil {
+		return nil
+	}
+
+	return &schema.TOTPEnrollmentResponse{
+		Secret: enrollment.Secret,
+		URI:    enrollment.URI,
+	}
+}
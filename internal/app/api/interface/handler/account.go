@@ -8,6 +8,8 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/builder"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cache"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cookie"
 	hdlerr "github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/errors"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/parameter"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
@@ -15,22 +17,46 @@ import (
 )
 
 type AccountHandler interface {
+	Get(*gin.Context)
 	Create(*gin.Context)
 	UpdateName(*gin.Context)
 	UpdatePassword(*gin.Context)
 	Delete(*gin.Context)
+	Restore(*gin.Context)
 }
 
 type accountHandler struct {
-	accountUC usecase.AccountUsecase
+	accountUC     usecase.AccountUsecase
+	sessionCache  cache.SessionCache
+	sessionCookie cookie.SessionCookie
 }
 
-func NewAccountHandler(accountUC usecase.AccountUsecase) AccountHandler {
+func NewAccountHandler(accountUC usecase.AccountUsecase, sessionCache cache.SessionCache, sessionCookie cookie.SessionCookie) AccountHandler {
 	return &accountHandler{
-		accountUC: accountUC,
+		accountUC:     accountUC,
+		sessionCache:  sessionCache,
+		sessionCookie: sessionCookie,
 	}
 }
 
+func (h *accountHandler) Get(c *gin.Context) {
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to get account"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	account, security, err := h.accountUC.Get(ctx, accountID)
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, builder.ToGetAccountResponse(account, security))
+}
+
 func (h *accountHandler) Create(c *gin.Context) {
 	var req schema.CreateAccountRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -86,15 +112,27 @@ func (h *accountHandler) UpdatePassword(c *gin.Context) {
 		return
 	}
 
+	sessionID, err := parameter.GetContextParameter[uuid.UUID](c, "sessionID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to update account password"))
+		return
+	}
+
 	ctx := c.Request.Context()
 
-	account, err := h.accountUC.UpdatePassword(ctx, accountID, req.Password, req.NewPassword, req.ConfirmPassword)
+	account, session, err := h.accountUC.UpdatePassword(ctx, accountID, sessionID, req.Password, req.NewPassword, req.ConfirmPassword)
 	if err != nil {
 		hdlerr.Handle(c, err)
 		return
 	}
+	h.sessionCache.DeleteByAccountID(accountID)
 
-	c.JSON(http.StatusOK, builder.ToAccountResponse(account))
+	// NOTE: Cookieで認証している場合は再発行したトークンでCookieを更新する.
+	if cookieAuthenticated, err := parameter.GetContextParameter[bool](c, "cookieAuthenticated"); err == nil && cookieAuthenticated {
+		h.sessionCookie.Set(c, session)
+	}
+
+	c.JSON(http.StatusOK, builder.ToUpdateAccountPasswordResponse(account, session))
 }
 
 func (h *accountHandler) Delete(c *gin.Context) {
@@ -112,10 +150,31 @@ func (h *accountHandler) Delete(c *gin.Context) {
 
 	ctx := c.Request.Context()
 
-	if err := h.accountUC.Delete(ctx, accountID, req.Password); err != nil {
+	account, err := h.accountUC.Delete(ctx, accountID, req.Password)
+	if err != nil {
 		hdlerr.Handle(c, err)
 		return
 	}
+	h.sessionCache.DeleteByAccountID(accountID)
+	h.sessionCookie.Clear(c)
+
+	c.JSON(http.StatusOK, builder.ToDeleteAccountResponse(account))
+}
+
+func (h *accountHandler) Restore(c *gin.Context) {
+	var req schema.RestoreAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to restore account"))
+		return
+	}
 
-	c.Status(http.StatusNoContent)
+	ctx := c.Request.Context()
+
+	account, err := h.accountUC.Restore(ctx, req.Name, req.Password, c.ClientIP())
+	if err != nil {
+		handleLoginError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, builder.ToAccountResponse(account))
 }
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/atsumarukun/holos-api-pkg/errors"
 	"github.com/gin-gonic/gin"
@@ -18,17 +19,126 @@ import (
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/service"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/handler"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cache"
+	appUsecase "github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
 	"github.com/atsumarukun/holos-account-api/test/mock/usecase"
 )
 
+func TestAccount_Get(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	accountDTO := &dto.AccountDTO{
+		ID:        uuid.New(),
+		Name:      "name",
+		Email:     "name@example.com",
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	securityDTO := &dto.AccountSecurityDTO{
+		TwoFactorEnabled: true,
+		PasskeyCount:     2,
+	}
+
+	tests := []struct {
+		name                  string
+		hasAccountIDInContext bool
+		expectCode            int
+		expectResponse        []byte
+		setMockAccountUC      func(context.Context, *usecase.MockAccountUsecase)
+	}{
+		{
+			name:                  "successfully got",
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusOK,
+			expectResponse:        fmt.Appendf(nil, `{"id":"%s","name":"name","email":"name@example.com","created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-02T00:00:00Z","security":{"two_factor_enabled":true,"passkey_count":2}}`, accountDTO.ID),
+			setMockAccountUC: func(ctx context.Context, accountUC *usecase.MockAccountUsecase) {
+				accountUC.
+					EXPECT().
+					Get(ctx, gomock.Any()).
+					Return(accountDTO, securityDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "account id not set",
+			hasAccountIDInContext: false,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockAccountUC:      func(context.Context, *usecase.MockAccountUsecase) {},
+		},
+		{
+			name:                  "account not found",
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockAccountUC: func(ctx context.Context, accountUC *usecase.MockAccountUsecase) {
+				accountUC.
+					EXPECT().
+					Get(ctx, gomock.Any()).
+					Return(nil, nil, errors.Wrap(appUsecase.ErrAccountNotFound, errors.CodeUnauthenticated, "failed to get account")).
+					Times(1)
+			},
+		},
+		{
+			name:                  "internal server error",
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusInternalServerError,
+			expectResponse:        []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockAccountUC: func(ctx context.Context, accountUC *usecase.MockAccountUsecase) {
+				accountUC.
+					EXPECT().
+					Get(ctx, gomock.Any()).
+					Return(nil, nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find account by id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "GET", "/accounts/me", nil)
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accountUC := usecase.NewMockAccountUsecase(ctrl)
+			tt.setMockAccountUC(ctx, accountUC)
+
+			hdl := handler.NewAccountHandler(accountUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
+			hdl.Get(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
 func TestAccount_Create(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	accountDTO := &dto.AccountDTO{
-		ID:       uuid.New(),
-		Name:     "name",
-		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		ID:        uuid.New(),
+		Name:      "name",
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
 	}
 
 	tests := []struct {
@@ -42,7 +152,7 @@ func TestAccount_Create(t *testing.T) {
 			name:           "successfully created",
 			requestBody:    []byte(`{"name":"name","password":"password","confirm_password":"password"}`),
 			expectCode:     http.StatusCreated,
-			expectResponse: fmt.Appendf(nil, `{"name":"%s"}`, accountDTO.Name),
+			expectResponse: fmt.Appendf(nil, `{"id":"%s","name":"%s","created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-02T00:00:00Z"}`, accountDTO.ID, accountDTO.Name),
 			setMockAccountUC: func(ctx context.Context, accountUC *usecase.MockAccountUsecase) {
 				accountUC.
 					EXPECT().
@@ -116,7 +226,7 @@ func TestAccount_Create(t *testing.T) {
 			accountUC := usecase.NewMockAccountUsecase(ctrl)
 			tt.setMockAccountUC(ctx, accountUC)
 
-			hdl := handler.NewAccountHandler(accountUC)
+			hdl := handler.NewAccountHandler(accountUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
 			hdl.Create(c)
 
 			c.Writer.WriteHeaderNow()
@@ -136,9 +246,10 @@ func TestAccount_UpdateName(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	accountDTO := &dto.AccountDTO{
-		ID:       uuid.New(),
-		Name:     "name",
-		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		ID:        uuid.New(),
+		Name:      "name",
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
 	}
 
 	tests := []struct {
@@ -154,7 +265,7 @@ func TestAccount_UpdateName(t *testing.T) {
 			requestBody:           []byte(`{"password":"password","name": "name"}`),
 			hasAccountIDInContext: true,
 			expectCode:            http.StatusOK,
-			expectResponse:        fmt.Appendf(nil, `{"name":"%s"}`, accountDTO.Name),
+			expectResponse:        fmt.Appendf(nil, `{"id":"%s","name":"%s","created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-02T00:00:00Z"}`, accountDTO.ID, accountDTO.Name),
 			setMockAccountUC: func(ctx context.Context, accountUC *usecase.MockAccountUsecase) {
 				accountUC.
 					EXPECT().
@@ -243,7 +354,7 @@ func TestAccount_UpdateName(t *testing.T) {
 			accountUC := usecase.NewMockAccountUsecase(ctrl)
 			tt.setMockAccountUC(ctx, accountUC)
 
-			hdl := handler.NewAccountHandler(accountUC)
+			hdl := handler.NewAccountHandler(accountUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
 			hdl.UpdateName(c)
 
 			c.Writer.WriteHeaderNow()
@@ -263,15 +374,23 @@ func TestAccount_UpdatePassword(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	accountDTO := &dto.AccountDTO{
-		ID:       uuid.New(),
-		Name:     "name",
-		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		ID:        uuid.New(),
+		Name:      "name",
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	sessionDTO := &dto.SessionDTO{
+		ID:           uuid.New(),
+		AccountID:    accountDTO.ID,
+		Token:        "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken: "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
 	}
 
 	tests := []struct {
 		name                  string
 		requestBody           []byte
 		hasAccountIDInContext bool
+		hasSessionIDInContext bool
 		expectCode            int
 		expectResponse        []byte
 		setMockAccountUC      func(context.Context, *usecase.MockAccountUsecase)
@@ -280,13 +399,14 @@ func TestAccount_UpdatePassword(t *testing.T) {
 			name:                  "successfully updated",
 			requestBody:           []byte(`{"password":"password","new_password":"password","confirm_password":"password"}`),
 			hasAccountIDInContext: true,
+			hasSessionIDInContext: true,
 			expectCode:            http.StatusOK,
-			expectResponse:        fmt.Appendf(nil, `{"name":"%s"}`, accountDTO.Name),
+			expectResponse:        fmt.Appendf(nil, `{"name":"%s","session":{"token":"%s","refresh_token":"%s"}}`, accountDTO.Name, sessionDTO.Token, sessionDTO.RefreshToken),
 			setMockAccountUC: func(ctx context.Context, accountUC *usecase.MockAccountUsecase) {
 				accountUC.
 					EXPECT().
-					UpdatePassword(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(accountDTO, nil).
+					UpdatePassword(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(accountDTO, sessionDTO, nil).
 					Times(1)
 			},
 		},
@@ -294,6 +414,7 @@ func TestAccount_UpdatePassword(t *testing.T) {
 			name:                  "bad request",
 			requestBody:           nil,
 			hasAccountIDInContext: true,
+			hasSessionIDInContext: true,
 			expectCode:            http.StatusBadRequest,
 			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
 			setMockAccountUC:      func(context.Context, *usecase.MockAccountUsecase) {},
@@ -302,6 +423,16 @@ func TestAccount_UpdatePassword(t *testing.T) {
 			name:                  "account id not set",
 			requestBody:           []byte(`{"password":"password","new_password":"password","confirm_password":"password"}`),
 			hasAccountIDInContext: false,
+			hasSessionIDInContext: true,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockAccountUC:      func(context.Context, *usecase.MockAccountUsecase) {},
+		},
+		{
+			name:                  "session id not set",
+			requestBody:           []byte(`{"password":"password","new_password":"password","confirm_password":"password"}`),
+			hasAccountIDInContext: true,
+			hasSessionIDInContext: false,
 			expectCode:            http.StatusUnauthorized,
 			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
 			setMockAccountUC:      func(context.Context, *usecase.MockAccountUsecase) {},
@@ -310,13 +441,14 @@ func TestAccount_UpdatePassword(t *testing.T) {
 			name:                  "invalid input",
 			requestBody:           []byte(`{"password":"password","new_password":"パスワード","confirm_password":"パスワード"}`),
 			hasAccountIDInContext: true,
+			hasSessionIDInContext: true,
 			expectCode:            http.StatusUnprocessableEntity,
-			expectResponse:        []byte(`{"error":{"code":"INVALID_INPUT","message":"password contains invalid characters"}}`),
+			expectResponse:        []byte(`{"error":{"code":"INVALID_INPUT","message":"password does not satisfy the policy","details":["password contains invalid characters","password is too weak"]}}`),
 			setMockAccountUC: func(ctx context.Context, accountUC *usecase.MockAccountUsecase) {
 				accountUC.
 					EXPECT().
-					UpdatePassword(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil, errors.Wrap(entity.ErrAccountPasswordInvalidChars, errors.CodeInvalidInput, "failed to set account password")).
+					UpdatePassword(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.Wrap(&entity.PasswordPolicyError{Violations: []error{entity.ErrAccountPasswordInvalidChars, entity.ErrPasswordTooWeak}}, errors.CodeInvalidInput, "failed to set account password")).
 					Times(1)
 			},
 		},
@@ -324,13 +456,14 @@ func TestAccount_UpdatePassword(t *testing.T) {
 			name:                  "internal server error",
 			requestBody:           []byte(`{"password":"password","new_password":"password","confirm_password":"password"}`),
 			hasAccountIDInContext: true,
+			hasSessionIDInContext: true,
 			expectCode:            http.StatusInternalServerError,
 			expectResponse:        []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
 			setMockAccountUC: func(ctx context.Context, accountUC *usecase.MockAccountUsecase) {
 				accountUC.
 					EXPECT().
-					UpdatePassword(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to update account")).
+					UpdatePassword(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to update account")).
 					Times(1)
 			},
 		},
@@ -349,6 +482,9 @@ func TestAccount_UpdatePassword(t *testing.T) {
 			if tt.hasAccountIDInContext {
 				c.Set("accountID", uuid.New())
 			}
+			if tt.hasSessionIDInContext {
+				c.Set("sessionID", uuid.New())
+			}
 
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
@@ -356,7 +492,7 @@ func TestAccount_UpdatePassword(t *testing.T) {
 			accountUC := usecase.NewMockAccountUsecase(ctrl)
 			tt.setMockAccountUC(ctx, accountUC)
 
-			hdl := handler.NewAccountHandler(accountUC)
+			hdl := handler.NewAccountHandler(accountUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
 			hdl.UpdatePassword(c)
 
 			c.Writer.WriteHeaderNow()
@@ -387,13 +523,13 @@ func TestAccount_Delete(t *testing.T) {
 			name:                  "successfully deleted",
 			requestBody:           []byte(`{"password":"password"}`),
 			hasAccountIDInContext: true,
-			expectCode:            http.StatusNoContent,
-			expectResponse:        nil,
+			expectCode:            http.StatusOK,
+			expectResponse:        []byte(`{"name":"name","restore_deadline":"2025-04-19T00:00:00Z"}`),
 			setMockAccountUC: func(ctx context.Context, accountUC *usecase.MockAccountUsecase) {
 				accountUC.
 					EXPECT().
 					Delete(ctx, gomock.Any(), gomock.Any()).
-					Return(nil).
+					Return(&dto.DeletedAccountDTO{Name: "name", RestoreDeadline: time.Date(2025, 4, 19, 0, 0, 0, 0, time.UTC)}, nil).
 					Times(1)
 			},
 		},
@@ -423,7 +559,7 @@ func TestAccount_Delete(t *testing.T) {
 				accountUC.
 					EXPECT().
 					Delete(ctx, gomock.Any(), gomock.Any()).
-					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to find account by id")).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to find account by id")).
 					Times(1)
 			},
 		},
@@ -449,7 +585,7 @@ func TestAccount_Delete(t *testing.T) {
 			accountUC := usecase.NewMockAccountUsecase(ctrl)
 			tt.setMockAccountUC(ctx, accountUC)
 
-			hdl := handler.NewAccountHandler(accountUC)
+			hdl := handler.NewAccountHandler(accountUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
 			hdl.Delete(c)
 
 			c.Writer.WriteHeaderNow()
@@ -464,3 +600,121 @@ func TestAccount_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestAccount_Restore(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	accountDTO := &dto.AccountDTO{
+		ID:        uuid.New(),
+		Name:      "name",
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name             string
+		requestBody      []byte
+		expectCode       int
+		expectResponse   []byte
+		expectRetryAfter string
+		setMockAccountUC func(context.Context, *usecase.MockAccountUsecase)
+	}{
+		{
+			name:           "successfully restored",
+			requestBody:    []byte(`{"name":"name","password":"password"}`),
+			expectCode:     http.StatusOK,
+			expectResponse: fmt.Appendf(nil, `{"id":"%s","name":"%s","created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-02T00:00:00Z"}`, accountDTO.ID, accountDTO.Name),
+			setMockAccountUC: func(ctx context.Context, accountUC *usecase.MockAccountUsecase) {
+				accountUC.
+					EXPECT().
+					Restore(ctx, "name", "password", "192.0.2.1").
+					Return(accountDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:             "bad request",
+			requestBody:      nil,
+			expectCode:       http.StatusBadRequest,
+			expectResponse:   []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockAccountUC: func(context.Context, *usecase.MockAccountUsecase) {},
+		},
+		{
+			name:           "unauthenticated",
+			requestBody:    []byte(`{"name":"name","password":"password"}`),
+			expectCode:     http.StatusUnauthorized,
+			expectResponse: []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockAccountUC: func(ctx context.Context, accountUC *usecase.MockAccountUsecase) {
+				accountUC.
+					EXPECT().
+					Restore(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(appUsecase.ErrAccountNotFound, errors.CodeUnauthenticated, "failed to restore account")).
+					Times(1)
+			},
+		},
+		{
+			name:             "login locked",
+			requestBody:      []byte(`{"name":"name","password":"password"}`),
+			expectCode:       http.StatusTooManyRequests,
+			expectResponse:   []byte(`{"error":{"code":"LOGIN_LOCKED","message":"login locked"}}`),
+			expectRetryAfter: "91",
+			setMockAccountUC: func(ctx context.Context, accountUC *usecase.MockAccountUsecase) {
+				accountUC.
+					EXPECT().
+					Restore(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(&entity.LoginLockedError{RetryAfter: time.Second*90 + time.Millisecond}, errors.CodeUnauthenticated, "failed to authenticate")).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			requestBody:    []byte(`{"name":"name","password":"password"}`),
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockAccountUC: func(ctx context.Context, accountUC *usecase.MockAccountUsecase) {
+				accountUC.
+					EXPECT().
+					Restore(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to restore account")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/accounts/restore", bytes.NewBuffer(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+			c.Request.RemoteAddr = "192.0.2.1:12345"
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accountUC := usecase.NewMockAccountUsecase(ctrl)
+			tt.setMockAccountUC(ctx, accountUC)
+
+			hdl := handler.NewAccountHandler(accountUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
+			hdl.Restore(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+
+			if retryAfter := w.Header().Get("Retry-After"); retryAfter != tt.expectRetryAfter {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectRetryAfter, retryAfter)
+			}
+		})
+	}
+}
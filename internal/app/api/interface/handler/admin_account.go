@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/builder"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cache"
+	hdlerr "github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/errors"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+type AdminAccountHandler interface {
+	GetAll(*gin.Context)
+	Get(*gin.Context)
+	ResetPassword(*gin.Context)
+	RevokeSessions(*gin.Context)
+	Delete(*gin.Context)
+	Restore(*gin.Context)
+}
+
+type adminAccountHandler struct {
+	adminAccountUC usecase.AdminAccountUsecase
+	sessionCache   cache.SessionCache
+}
+
+func NewAdminAccountHandler(adminAccountUC usecase.AdminAccountUsecase, sessionCache cache.SessionCache) AdminAccountHandler {
+	return &adminAccountHandler{
+		adminAccountUC: adminAccountUC,
+		sessionCache:   sessionCache,
+	}
+}
+
+func (h *adminAccountHandler) GetAll(c *gin.Context) {
+	var req schema.GetAdminAccountsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to get accounts"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	page, err := h.adminAccountUC.GetAll(ctx, &dto.AccountSearchDTO{
+		NamePrefix:    req.Name,
+		Deleted:       req.Deleted,
+		CreatedAfter:  req.CreatedAfter,
+		CreatedBefore: req.CreatedBefore,
+		Cursor:        req.Cursor,
+		Limit:         req.Limit,
+	})
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, builder.ToGetAdminAccountsResponse(page))
+}
+
+func (h *adminAccountHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to get account"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	account, security, err := h.adminAccountUC.Get(ctx, id)
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, builder.ToGetAdminAccountResponse(account, security))
+}
+
+func (h *adminAccountHandler) ResetPassword(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to reset account password"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if err := h.adminAccountUC.ResetPassword(ctx, id); err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+	h.sessionCache.DeleteByAccountID(id)
+
+	c.Status(http.StatusAccepted)
+}
+
+func (h *adminAccountHandler) RevokeSessions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to revoke account sessions"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if err := h.adminAccountUC.RevokeSessions(ctx, id); err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+	h.sessionCache.DeleteByAccountID(id)
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *adminAccountHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to delete account"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if err := h.adminAccountUC.Delete(ctx, id); err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+	h.sessionCache.DeleteByAccountID(id)
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *adminAccountHandler) Restore(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to restore account"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if err := h.adminAccountUC.Restore(ctx, id); err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,632 @@
+package handler_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/handler"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cache"
+	appUsecase "github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/test/mock/usecase"
+)
+
+func TestAdminAccount_GetAll(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	accountDTO := &dto.AccountDTO{
+		ID:        uuid.New(),
+		Name:      "name",
+		Email:     "name@example.com",
+		Roles:     []string{"admin"},
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	deletedAccountDTO := &dto.AccountDTO{
+		ID:        uuid.New(),
+		Name:      "deleted",
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		DeletedAt: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+	}
+	deleted := true
+
+	tests := []struct {
+		name                  string
+		query                 string
+		expectCode            int
+		expectResponse        []byte
+		setMockAdminAccountUC func(context.Context, *usecase.MockAdminAccountUsecase)
+	}{
+		{
+			name:           "successfully got",
+			query:          "",
+			expectCode:     http.StatusOK,
+			expectResponse: fmt.Appendf(nil, `{"accounts":[{"id":"%s","name":"name","email":"name@example.com","roles":["admin"],"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-02T00:00:00Z"},{"id":"%s","name":"deleted","roles":[],"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-02T00:00:00Z","deleted_at":"2025-01-03T00:00:00Z"}],"next_cursor":"cursor"}`, accountDTO.ID, deletedAccountDTO.ID),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					GetAll(ctx, &dto.AccountSearchDTO{}).
+					Return(&dto.AccountPageDTO{Accounts: []*dto.AccountDTO{accountDTO, deletedAccountDTO}, NextCursor: "cursor"}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "with filters",
+			query:          "?name=na&deleted=true&created_after=2025-01-01T00:00:00Z&created_before=2025-02-01T00:00:00Z&cursor=cursor&limit=10",
+			expectCode:     http.StatusOK,
+			expectResponse: []byte(`{"accounts":[]}`),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					GetAll(ctx, &dto.AccountSearchDTO{
+						NamePrefix:    "na",
+						Deleted:       &deleted,
+						CreatedAfter:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						CreatedBefore: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+						Cursor:        "cursor",
+						Limit:         10,
+					}).
+					Return(&dto.AccountPageDTO{}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "invalid query",
+			query:                 "?limit=invalid",
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockAdminAccountUC: func(context.Context, *usecase.MockAdminAccountUsecase) {},
+		},
+		{
+			name:           "invalid limit",
+			query:          "?limit=101",
+			expectCode:     http.StatusUnprocessableEntity,
+			expectResponse: []byte(`{"error":{"code":"INVALID_INPUT","message":"limit must be between 1 and 100"}}`),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					GetAll(ctx, &dto.AccountSearchDTO{Limit: 101}).
+					Return(nil, errors.Wrap(appUsecase.ErrInvalidAccountSearchLimit, errors.CodeInvalidInput, "failed to get accounts")).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			query:          "",
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					GetAll(ctx, &dto.AccountSearchDTO{}).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to search accounts")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "GET", "/admin/accounts"+tt.query, http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			adminAccountUC := usecase.NewMockAdminAccountUsecase(ctrl)
+			tt.setMockAdminAccountUC(ctx, adminAccountUC)
+
+			hdl := handler.NewAdminAccountHandler(adminAccountUC, cache.NewSessionCache(time.Minute, 10))
+			hdl.GetAll(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAdminAccount_Get(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	accountDTO := &dto.AccountDTO{
+		ID:        uuid.New(),
+		Name:      "name",
+		Email:     "name@example.com",
+		Roles:     []string{"admin"},
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	securityDTO := &dto.AccountSecurityDTO{
+		TwoFactorEnabled: true,
+		PasskeyCount:     2,
+	}
+
+	tests := []struct {
+		name                  string
+		pathID                string
+		expectCode            int
+		expectResponse        []byte
+		setMockAdminAccountUC func(context.Context, *usecase.MockAdminAccountUsecase)
+	}{
+		{
+			name:           "successfully got",
+			pathID:         accountDTO.ID.String(),
+			expectCode:     http.StatusOK,
+			expectResponse: fmt.Appendf(nil, `{"id":"%s","name":"name","email":"name@example.com","roles":["admin"],"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-02T00:00:00Z","security":{"two_factor_enabled":true,"passkey_count":2}}`, accountDTO.ID),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					Get(ctx, accountDTO.ID).
+					Return(accountDTO, securityDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "invalid id",
+			pathID:                "invalid",
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockAdminAccountUC: func(context.Context, *usecase.MockAdminAccountUsecase) {},
+		},
+		{
+			name:           "account not found",
+			pathID:         accountDTO.ID.String(),
+			expectCode:     http.StatusNotFound,
+			expectResponse: []byte(`{"error":{"code":"NOT_FOUND","message":"not found"}}`),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					Get(ctx, accountDTO.ID).
+					Return(nil, nil, errors.Wrap(appUsecase.ErrAccountNotFound, errors.CodeNotFound, "failed to get account")).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			pathID:         accountDTO.ID.String(),
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					Get(ctx, accountDTO.ID).
+					Return(nil, nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find account by id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "GET", "/admin/accounts/"+tt.pathID, http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+			c.Params = gin.Params{{Key: "id", Value: tt.pathID}}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			adminAccountUC := usecase.NewMockAdminAccountUsecase(ctrl)
+			tt.setMockAdminAccountUC(ctx, adminAccountUC)
+
+			hdl := handler.NewAdminAccountHandler(adminAccountUC, cache.NewSessionCache(time.Minute, 10))
+			hdl.Get(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAdminAccount_ResetPassword(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	id := uuid.New()
+
+	tests := []struct {
+		name                  string
+		pathID                string
+		expectCode            int
+		expectResponse        []byte
+		setMockAdminAccountUC func(context.Context, *usecase.MockAdminAccountUsecase)
+	}{
+		{
+			name:           "successfully reset",
+			pathID:         id.String(),
+			expectCode:     http.StatusAccepted,
+			expectResponse: nil,
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					ResetPassword(ctx, id).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "invalid id",
+			pathID:                "invalid",
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockAdminAccountUC: func(context.Context, *usecase.MockAdminAccountUsecase) {},
+		},
+		{
+			name:           "email not set",
+			pathID:         id.String(),
+			expectCode:     http.StatusConflict,
+			expectResponse: []byte(`{"error":{"code":"CONSTRAINT_VIOLATION","message":"account email is not set"}}`),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					ResetPassword(ctx, id).
+					Return(errors.Wrap(appUsecase.ErrAccountEmailNotSet, errors.CodeConstraintViolation, "failed to reset account password")).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			pathID:         id.String(),
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					ResetPassword(ctx, id).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to save password reset")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/admin/accounts/"+tt.pathID+"/password-reset", http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+			c.Params = gin.Params{{Key: "id", Value: tt.pathID}}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			adminAccountUC := usecase.NewMockAdminAccountUsecase(ctrl)
+			tt.setMockAdminAccountUC(ctx, adminAccountUC)
+
+			hdl := handler.NewAdminAccountHandler(adminAccountUC, cache.NewSessionCache(time.Minute, 10))
+			hdl.ResetPassword(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAdminAccount_RevokeSessions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	id := uuid.New()
+
+	tests := []struct {
+		name                  string
+		pathID                string
+		expectCode            int
+		expectResponse        []byte
+		setMockAdminAccountUC func(context.Context, *usecase.MockAdminAccountUsecase)
+	}{
+		{
+			name:           "successfully revoked",
+			pathID:         id.String(),
+			expectCode:     http.StatusNoContent,
+			expectResponse: nil,
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					RevokeSessions(ctx, id).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "invalid id",
+			pathID:                "invalid",
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockAdminAccountUC: func(context.Context, *usecase.MockAdminAccountUsecase) {},
+		},
+		{
+			name:           "account not found",
+			pathID:         id.String(),
+			expectCode:     http.StatusNotFound,
+			expectResponse: []byte(`{"error":{"code":"NOT_FOUND","message":"not found"}}`),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					RevokeSessions(ctx, id).
+					Return(errors.Wrap(appUsecase.ErrAccountNotFound, errors.CodeNotFound, "failed to revoke account sessions")).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			pathID:         id.String(),
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					RevokeSessions(ctx, id).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete sessions by account id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "DELETE", "/admin/accounts/"+tt.pathID+"/sessions", http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+			c.Params = gin.Params{{Key: "id", Value: tt.pathID}}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			adminAccountUC := usecase.NewMockAdminAccountUsecase(ctrl)
+			tt.setMockAdminAccountUC(ctx, adminAccountUC)
+
+			hdl := handler.NewAdminAccountHandler(adminAccountUC, cache.NewSessionCache(time.Minute, 10))
+			hdl.RevokeSessions(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAdminAccount_Delete(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	id := uuid.New()
+
+	tests := []struct {
+		name                  string
+		pathID                string
+		expectCode            int
+		expectResponse        []byte
+		setMockAdminAccountUC func(context.Context, *usecase.MockAdminAccountUsecase)
+	}{
+		{
+			name:           "successfully deleted",
+			pathID:         id.String(),
+			expectCode:     http.StatusNoContent,
+			expectResponse: nil,
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					Delete(ctx, id).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "invalid id",
+			pathID:                "invalid",
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockAdminAccountUC: func(context.Context, *usecase.MockAdminAccountUsecase) {},
+		},
+		{
+			name:           "account not found",
+			pathID:         id.String(),
+			expectCode:     http.StatusNotFound,
+			expectResponse: []byte(`{"error":{"code":"NOT_FOUND","message":"not found"}}`),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					Delete(ctx, id).
+					Return(errors.Wrap(appUsecase.ErrAccountNotFound, errors.CodeNotFound, "failed to delete account")).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			pathID:         id.String(),
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					Delete(ctx, id).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete account")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "DELETE", "/admin/accounts/"+tt.pathID, http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+			c.Params = gin.Params{{Key: "id", Value: tt.pathID}}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			adminAccountUC := usecase.NewMockAdminAccountUsecase(ctrl)
+			tt.setMockAdminAccountUC(ctx, adminAccountUC)
+
+			hdl := handler.NewAdminAccountHandler(adminAccountUC, cache.NewSessionCache(time.Minute, 10))
+			hdl.Delete(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAdminAccount_Restore(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	id := uuid.New()
+
+	tests := []struct {
+		name                  string
+		pathID                string
+		expectCode            int
+		expectResponse        []byte
+		setMockAdminAccountUC func(context.Context, *usecase.MockAdminAccountUsecase)
+	}{
+		{
+			name:           "successfully restored",
+			pathID:         id.String(),
+			expectCode:     http.StatusNoContent,
+			expectResponse: nil,
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					Restore(ctx, id).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "invalid id",
+			pathID:                "invalid",
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockAdminAccountUC: func(context.Context, *usecase.MockAdminAccountUsecase) {},
+		},
+		{
+			name:           "account not found",
+			pathID:         id.String(),
+			expectCode:     http.StatusNotFound,
+			expectResponse: []byte(`{"error":{"code":"NOT_FOUND","message":"not found"}}`),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					Restore(ctx, id).
+					Return(errors.Wrap(appUsecase.ErrAccountNotDeletedOrExpired, errors.CodeNotFound, "failed to restore account")).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			pathID:         id.String(),
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockAdminAccountUC: func(ctx context.Context, adminAccountUC *usecase.MockAdminAccountUsecase) {
+				adminAccountUC.
+					EXPECT().
+					Restore(ctx, id).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to restore account")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/admin/accounts/"+tt.pathID+"/restore", http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+			c.Params = gin.Params{{Key: "id", Value: tt.pathID}}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			adminAccountUC := usecase.NewMockAdminAccountUsecase(ctrl)
+			tt.setMockAdminAccountUC(ctx, adminAccountUC)
+
+			hdl := handler.NewAdminAccountHandler(adminAccountUC, cache.NewSessionCache(time.Minute, 10))
+			hdl.Restore(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
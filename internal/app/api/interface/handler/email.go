@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/builder"
+	hdlerr "github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/errors"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/parameter"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+)
+
+type EmailHandler interface {
+	Change(*gin.Context)
+	Verify(*gin.Context)
+}
+
+type emailHandler struct {
+	emailUC usecase.EmailUsecase
+}
+
+func NewEmailHandler(emailUC usecase.EmailUsecase) EmailHandler {
+	return &emailHandler{
+		emailUC: emailUC,
+	}
+}
+
+// NOTE: メールアドレスは確認後に変更されるため, 受け付けた時点では202を返却する.
+func (h *emailHandler) Change(c *gin.Context) {
+	var req schema.ChangeEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to change email"))
+		return
+	}
+
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to change email"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if err := h.emailUC.Change(ctx, accountID, req.Password, req.Email); err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (h *emailHandler) Verify(c *gin.Context) {
+	var req schema.VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to verify email"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	account, err := h.emailUC.Verify(ctx, req.Token)
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, builder.ToAccountResponse(account))
+}
@@ -0,0 +1,258 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/service"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/handler"
+	appUsecase "github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/test/mock/usecase"
+)
+
+func TestEmail_Change(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                  string
+		requestBody           []byte
+		hasAccountIDInContext bool
+		expectCode            int
+		expectResponse        []byte
+		setMockEmailUC        func(context.Context, *usecase.MockEmailUsecase)
+	}{
+		{
+			name:                  "successfully requested",
+			requestBody:           []byte(`{"password":"password","email":"name@example.com"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusAccepted,
+			expectResponse:        nil,
+			setMockEmailUC: func(ctx context.Context, emailUC *usecase.MockEmailUsecase) {
+				emailUC.
+					EXPECT().
+					Change(ctx, gomock.Any(), "password", "name@example.com").
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "bad request",
+			requestBody:           nil,
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockEmailUC:        func(context.Context, *usecase.MockEmailUsecase) {},
+		},
+		{
+			name:                  "account id not found",
+			requestBody:           []byte(`{"password":"password","email":"name@example.com"}`),
+			hasAccountIDInContext: false,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockEmailUC:        func(context.Context, *usecase.MockEmailUsecase) {},
+		},
+		{
+			name:                  "invalid email",
+			requestBody:           []byte(`{"password":"password","email":"example.com"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusUnprocessableEntity,
+			expectResponse:        []byte(`{"error":{"code":"INVALID_INPUT","message":"email is not a valid address"}}`),
+			setMockEmailUC: func(ctx context.Context, emailUC *usecase.MockEmailUsecase) {
+				emailUC.
+					EXPECT().
+					Change(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(entity.ErrAccountEmailInvalidFormat, errors.CodeInvalidInput, "failed to initialize email verification")).
+					Times(1)
+			},
+		},
+		{
+			name:                  "email already in use",
+			requestBody:           []byte(`{"password":"password","email":"name@example.com"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusConflict,
+			expectResponse:        []byte(`{"error":{"code":"DUPLICATE","message":"email already in use"}}`),
+			setMockEmailUC: func(ctx context.Context, emailUC *usecase.MockEmailUsecase) {
+				emailUC.
+					EXPECT().
+					Change(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(service.ErrAccountEmailAlreadyInUse, errors.CodeDuplicate, "email already exists")).
+					Times(1)
+			},
+		},
+		{
+			name:                  "internal server error",
+			requestBody:           []byte(`{"password":"password","email":"name@example.com"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusInternalServerError,
+			expectResponse:        []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockEmailUC: func(ctx context.Context, emailUC *usecase.MockEmailUsecase) {
+				emailUC.
+					EXPECT().
+					Change(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to save email verification")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "PATCH", "/accounts/email", bytes.NewBuffer(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			emailUC := usecase.NewMockEmailUsecase(ctrl)
+			tt.setMockEmailUC(ctx, emailUC)
+
+			hdl := handler.NewEmailHandler(emailUC)
+			hdl.Change(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestEmail_Verify(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	accountDTO := &dto.AccountDTO{
+		ID:        uuid.New(),
+		Name:      "name",
+		Email:     "name@example.com",
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name           string
+		requestBody    []byte
+		expectCode     int
+		expectResponse []byte
+		setMockEmailUC func(context.Context, *usecase.MockEmailUsecase)
+	}{
+		{
+			name:           "successfully verified",
+			requestBody:    []byte(`{"token":"1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS"}`),
+			expectCode:     http.StatusOK,
+			expectResponse: fmt.Appendf(nil, `{"id":"%s","name":"name","email":"name@example.com","created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-02T00:00:00Z"}`, accountDTO.ID),
+			setMockEmailUC: func(ctx context.Context, emailUC *usecase.MockEmailUsecase) {
+				emailUC.
+					EXPECT().
+					Verify(ctx, "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS").
+					Return(accountDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "bad request",
+			requestBody:    nil,
+			expectCode:     http.StatusBadRequest,
+			expectResponse: []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockEmailUC: func(context.Context, *usecase.MockEmailUsecase) {},
+		},
+		{
+			name:           "verification not found",
+			requestBody:    []byte(`{"token":"1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS"}`),
+			expectCode:     http.StatusBadRequest,
+			expectResponse: []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockEmailUC: func(ctx context.Context, emailUC *usecase.MockEmailUsecase) {
+				emailUC.
+					EXPECT().
+					Verify(ctx, gomock.Any()).
+					Return(nil, errors.Wrap(appUsecase.ErrEmailVerificationNotFound, errors.CodeBadRequest, "failed to verify email")).
+					Times(1)
+			},
+		},
+		{
+			name:           "email already in use",
+			requestBody:    []byte(`{"token":"1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS"}`),
+			expectCode:     http.StatusConflict,
+			expectResponse: []byte(`{"error":{"code":"DUPLICATE","message":"email already in use"}}`),
+			setMockEmailUC: func(ctx context.Context, emailUC *usecase.MockEmailUsecase) {
+				emailUC.
+					EXPECT().
+					Verify(ctx, gomock.Any()).
+					Return(nil, errors.Wrap(service.ErrAccountEmailAlreadyInUse, errors.CodeDuplicate, "email already exists")).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			requestBody:    []byte(`{"token":"1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS"}`),
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockEmailUC: func(ctx context.Context, emailUC *usecase.MockEmailUsecase) {
+				emailUC.
+					EXPECT().
+					Verify(ctx, gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to update account")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/accounts/email/verify", bytes.NewBuffer(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			emailUC := usecase.NewMockEmailUsecase(ctrl)
+			tt.setMockEmailUC(ctx, emailUC)
+
+			hdl := handler.NewEmailHandler(emailUC)
+			hdl.Verify(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/builder"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+)
+
+type KeyHandler interface {
+	GetJWKS(*gin.Context)
+}
+
+type keyHandler struct {
+	keyUC usecase.KeyUsecase
+}
+
+func NewKeyHandler(keyUC usecase.KeyUsecase) KeyHandler {
+	return &keyHandler{
+		keyUC: keyUC,
+	}
+}
+
+func (h *keyHandler) GetJWKS(c *gin.Context) {
+	c.JSON(http.StatusOK, builder.ToJWKSResponse(h.keyUC.GetPublicKeys()))
+}
@@ -0,0 +1,82 @@
+package handler_test
+
+import (
+	"crypto/ed25519"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/handler"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/test/mock/usecase"
+)
+
+func TestKey_GetJWKS(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		expectResponse []byte
+		expectCode     int
+		setMockKeyUC   func(*usecase.MockKeyUsecase)
+	}{
+		{
+			name:           "successfully got",
+			expectResponse: []byte(`{"keys":[{"kty":"OKP","crv":"Ed25519","x":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA","kid":"kid","use":"sig","alg":"EdDSA"}]}`),
+			expectCode:     http.StatusOK,
+			setMockKeyUC: func(keyUC *usecase.MockKeyUsecase) {
+				keyUC.
+					EXPECT().
+					GetPublicKeys().
+					Return([]*dto.PublicKeyDTO{{ID: "kid", Key: make(ed25519.PublicKey, ed25519.PublicKeySize)}}).
+					Times(1)
+			},
+		},
+		{
+			name:           "no keys",
+			expectResponse: []byte(`{"keys":[]}`),
+			expectCode:     http.StatusOK,
+			setMockKeyUC: func(keyUC *usecase.MockKeyUsecase) {
+				keyUC.
+					EXPECT().
+					GetPublicKeys().
+					Return([]*dto.PublicKeyDTO{}).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "GET", "/.well-known/jwks.json", http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			keyUC := usecase.NewMockKeyUsecase(ctrl)
+			tt.setMockKeyUC(keyUC)
+
+			hdl := handler.NewKeyHandler(keyUC)
+			hdl.GetJWKS(c)
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/builder"
+	hdlerr "github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/errors"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/parameter"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+)
+
+type PasskeyHandler interface {
+	CreateRegistrationOptions(*gin.Context)
+	Register(*gin.Context)
+	GetAll(*gin.Context)
+	Rename(*gin.Context)
+	Delete(*gin.Context)
+}
+
+type passkeyHandler struct {
+	passkeyUC usecase.PasskeyUsecase
+}
+
+func NewPasskeyHandler(passkeyUC usecase.PasskeyUsecase) PasskeyHandler {
+	return &passkeyHandler{
+		passkeyUC: passkeyUC,
+	}
+}
+
+func (h *passkeyHandler) CreateRegistrationOptions(c *gin.Context) {
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to create passkey registration options"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	options, err := h.passkeyUC.CreateRegistrationOptions(ctx, accountID)
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, builder.ToPasskeyRegistrationOptionsResponse(options))
+}
+
+func (h *passkeyHandler) Register(c *gin.Context) {
+	var req schema.RegisterPasskeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to register passkey"))
+		return
+	}
+
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to register passkey"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	attestation := &webauthn.Attestation{
+		ClientDataJSON:    req.Credential.Response.ClientDataJSON,
+		AttestationObject: req.Credential.Response.AttestationObject,
+	}
+
+	passkey, err := h.passkeyUC.Register(ctx, accountID, req.Name, attestation)
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, builder.ToPasskeyResponse(passkey))
+}
+
+func (h *passkeyHandler) GetAll(c *gin.Context) {
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to get passkeys"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	passkeys, err := h.passkeyUC.GetAll(ctx, accountID)
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, builder.ToPasskeyResponses(passkeys))
+}
+
+func (h *passkeyHandler) Rename(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to rename passkey"))
+		return
+	}
+
+	var req schema.RenamePasskeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to rename passkey"))
+		return
+	}
+
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to rename passkey"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	passkey, err := h.passkeyUC.Rename(ctx, accountID, id, req.Name)
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, builder.ToPasskeyResponse(passkey))
+}
+
+func (h *passkeyHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to delete passkey"))
+		return
+	}
+
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to delete passkey"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if err := h.passkeyUC.Delete(ctx, accountID, id); err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
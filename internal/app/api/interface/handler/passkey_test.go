@@ -0,0 +1,556 @@
+package handler_test
+
+import (
+	"bytes"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/handler"
+	appUsecase "github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/test/mock/usecase"
+)
+
+func TestPasskey_CreateRegistrationOptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	optionsDTO := &dto.PasskeyRegistrationOptionsDTO{
+		Challenge:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RPID:                 "localhost",
+		RPName:               "holos",
+		UserID:               []byte("user"),
+		UserName:             "name",
+		Algorithms:           []int{webauthn.AlgorithmES256, webauthn.AlgorithmRS256},
+		ExcludeCredentialIDs: [][]byte{[]byte("credential")},
+		Timeout:              time.Minute * 5,
+	}
+
+	tests := []struct {
+		name                  string
+		hasAccountIDInContext bool
+		expectCode            int
+		expectResponse        []byte
+		setMockPasskeyUC      func(*usecase.MockPasskeyUsecase)
+	}{
+		{
+			name:                  "successfully created",
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusCreated,
+			expectResponse:        []byte(`{"challenge":"1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS","rp":{"id":"localhost","name":"holos"},"user":{"id":"dXNlcg","name":"name","displayName":"name"},"pubKeyCredParams":[{"type":"public-key","alg":-7},{"type":"public-key","alg":-257}],"timeout":300000,"excludeCredentials":[{"type":"public-key","id":"Y3JlZGVudGlhbA"}],"authenticatorSelection":{"residentKey":"required","userVerification":"required"},"attestation":"none"}`),
+			setMockPasskeyUC: func(passkeyUC *usecase.MockPasskeyUsecase) {
+				passkeyUC.
+					EXPECT().
+					CreateRegistrationOptions(gomock.Any(), gomock.Any()).
+					Return(optionsDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "account id not found",
+			hasAccountIDInContext: false,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockPasskeyUC:      func(*usecase.MockPasskeyUsecase) {},
+		},
+		{
+			name:                  "internal server error",
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusInternalServerError,
+			expectResponse:        []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockPasskeyUC: func(passkeyUC *usecase.MockPasskeyUsecase) {
+				passkeyUC.
+					EXPECT().
+					CreateRegistrationOptions(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to create passkey challenge")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/accounts/passkeys/options", http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			passkeyUC := usecase.NewMockPasskeyUsecase(ctrl)
+			tt.setMockPasskeyUC(passkeyUC)
+
+			hdl := handler.NewPasskeyHandler(passkeyUC)
+			hdl.CreateRegistrationOptions(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestPasskey_Register(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	passkeyDTO := &dto.PasskeyDTO{
+		ID:        uuid.MustParse("0196f0a4-7c1e-7d6a-9b3f-2c8e4d5a6b7c"),
+		Name:      "laptop",
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	attestation := &webauthn.Attestation{
+		ClientDataJSON:    []byte("{}"),
+		AttestationObject: []byte("attestation"),
+	}
+	requestBody := []byte(`{"name":"laptop","credential":{"id":"Y3JlZGVudGlhbA","rawId":"Y3JlZGVudGlhbA","type":"public-key","response":{"clientDataJSON":"e30","attestationObject":"YXR0ZXN0YXRpb24"}}}`)
+
+	tests := []struct {
+		name                  string
+		requestBody           []byte
+		hasAccountIDInContext bool
+		expectCode            int
+		expectResponse        []byte
+		setMockPasskeyUC      func(*usecase.MockPasskeyUsecase)
+	}{
+		{
+			name:                  "successfully registered",
+			requestBody:           requestBody,
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusCreated,
+			expectResponse:        []byte(`{"id":"0196f0a4-7c1e-7d6a-9b3f-2c8e4d5a6b7c","name":"laptop","last_used_at":null,"created_at":"2025-01-01T00:00:00Z"}`),
+			setMockPasskeyUC: func(passkeyUC *usecase.MockPasskeyUsecase) {
+				passkeyUC.
+					EXPECT().
+					Register(gomock.Any(), gomock.Any(), "laptop", attestation).
+					Return(passkeyDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "bad request",
+			requestBody:           []byte(`{"name":"laptop","credential":{"response":{"clientDataJSON":1}}}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockPasskeyUC:      func(*usecase.MockPasskeyUsecase) {},
+		},
+		{
+			name:                  "account id not found",
+			requestBody:           requestBody,
+			hasAccountIDInContext: false,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockPasskeyUC:      func(*usecase.MockPasskeyUsecase) {},
+		},
+		{
+			name:                  "verification failed",
+			requestBody:           requestBody,
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockPasskeyUC: func(passkeyUC *usecase.MockPasskeyUsecase) {
+				passkeyUC.
+					EXPECT().
+					Register(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(webauthn.ErrOriginNotAllowed, errors.CodeBadRequest, "failed to register passkey")).
+					Times(1)
+			},
+		},
+		{
+			name:                  "already registered",
+			requestBody:           requestBody,
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusConflict,
+			expectResponse:        []byte(`{"error":{"code":"DUPLICATE","message":"passkey already registered"}}`),
+			setMockPasskeyUC: func(passkeyUC *usecase.MockPasskeyUsecase) {
+				passkeyUC.
+					EXPECT().
+					Register(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(appUsecase.ErrPasskeyAlreadyRegistered, errors.CodeDuplicate, "failed to register passkey")).
+					Times(1)
+			},
+		},
+		{
+			name:                  "invalid name",
+			requestBody:           requestBody,
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusUnprocessableEntity,
+			expectResponse:        []byte(`{"error":{"code":"INVALID_INPUT","message":"passkey name must be between 1 and 64 characters"}}`),
+			setMockPasskeyUC: func(passkeyUC *usecase.MockPasskeyUsecase) {
+				passkeyUC.
+					EXPECT().
+					Register(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(entity.ErrPasskeyNameInvalidLength, errors.CodeInvalidInput, "failed to set passkey name")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/accounts/passkeys", bytes.NewBuffer(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			passkeyUC := usecase.NewMockPasskeyUsecase(ctrl)
+			tt.setMockPasskeyUC(passkeyUC)
+
+			hdl := handler.NewPasskeyHandler(passkeyUC)
+			hdl.Register(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestPasskey_GetAll(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	passkeyDTO := &dto.PasskeyDTO{
+		ID:         uuid.MustParse("0196f0a4-7c1e-7d6a-9b3f-2c8e4d5a6b7c"),
+		Name:       "laptop",
+		LastUsedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		CreatedAt:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name                  string
+		hasAccountIDInContext bool
+		expectCode            int
+		expectResponse        []byte
+		setMockPasskeyUC      func(*usecase.MockPasskeyUsecase)
+	}{
+		{
+			name:                  "successfully got",
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusOK,
+			expectResponse:        []byte(`[{"id":"0196f0a4-7c1e-7d6a-9b3f-2c8e4d5a6b7c","name":"laptop","last_used_at":"2025-01-02T00:00:00Z","created_at":"2025-01-01T00:00:00Z"}]`),
+			setMockPasskeyUC: func(passkeyUC *usecase.MockPasskeyUsecase) {
+				passkeyUC.
+					EXPECT().
+					GetAll(gomock.Any(), gomock.Any()).
+					Return([]*dto.PasskeyDTO{passkeyDTO}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "no passkeys",
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusOK,
+			expectResponse:        []byte(`[]`),
+			setMockPasskeyUC: func(passkeyUC *usecase.MockPasskeyUsecase) {
+				passkeyUC.
+					EXPECT().
+					GetAll(gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "account id not found",
+			hasAccountIDInContext: false,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockPasskeyUC:      func(*usecase.MockPasskeyUsecase) {},
+		},
+		{
+			name:                  "internal server error",
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusInternalServerError,
+			expectResponse:        []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockPasskeyUC: func(passkeyUC *usecase.MockPasskeyUsecase) {
+				passkeyUC.
+					EXPECT().
+					GetAll(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find passkeys by account id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "GET", "/accounts/passkeys", http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			passkeyUC := usecase.NewMockPasskeyUsecase(ctrl)
+			tt.setMockPasskeyUC(passkeyUC)
+
+			hdl := handler.NewPasskeyHandler(passkeyUC)
+			hdl.GetAll(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestPasskey_Rename(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	passkeyDTO := &dto.PasskeyDTO{
+		ID:        uuid.MustParse("0196f0a4-7c1e-7d6a-9b3f-2c8e4d5a6b7c"),
+		Name:      "phone",
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name                  string
+		pathID                string
+		requestBody           []byte
+		hasAccountIDInContext bool
+		expectCode            int
+		expectResponse        []byte
+		setMockPasskeyUC      func(*usecase.MockPasskeyUsecase)
+	}{
+		{
+			name:                  "successfully renamed",
+			pathID:                passkeyDTO.ID.String(),
+			requestBody:           []byte(`{"name":"phone"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusOK,
+			expectResponse:        []byte(`{"id":"0196f0a4-7c1e-7d6a-9b3f-2c8e4d5a6b7c","name":"phone","last_used_at":null,"created_at":"2025-01-01T00:00:00Z"}`),
+			setMockPasskeyUC: func(passkeyUC *usecase.MockPasskeyUsecase) {
+				passkeyUC.
+					EXPECT().
+					Rename(gomock.Any(), gomock.Any(), passkeyDTO.ID, "phone").
+					Return(passkeyDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "invalid id",
+			pathID:                "invalid",
+			requestBody:           []byte(`{"name":"phone"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockPasskeyUC:      func(*usecase.MockPasskeyUsecase) {},
+		},
+		{
+			name:                  "bad request",
+			pathID:                passkeyDTO.ID.String(),
+			requestBody:           nil,
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockPasskeyUC:      func(*usecase.MockPasskeyUsecase) {},
+		},
+		{
+			name:                  "account id not found",
+			pathID:                passkeyDTO.ID.String(),
+			requestBody:           []byte(`{"name":"phone"}`),
+			hasAccountIDInContext: false,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockPasskeyUC:      func(*usecase.MockPasskeyUsecase) {},
+		},
+		{
+			name:                  "passkey not found",
+			pathID:                passkeyDTO.ID.String(),
+			requestBody:           []byte(`{"name":"phone"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusNotFound,
+			expectResponse:        []byte(`{"error":{"code":"NOT_FOUND","message":"not found"}}`),
+			setMockPasskeyUC: func(passkeyUC *usecase.MockPasskeyUsecase) {
+				passkeyUC.
+					EXPECT().
+					Rename(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(appUsecase.ErrPasskeyNotFound, errors.CodeNotFound, "failed to rename passkey")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "PATCH", "/accounts/passkeys/"+tt.pathID, bytes.NewBuffer(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+			c.Params = gin.Params{{Key: "id", Value: tt.pathID}}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			passkeyUC := usecase.NewMockPasskeyUsecase(ctrl)
+			tt.setMockPasskeyUC(passkeyUC)
+
+			hdl := handler.NewPasskeyHandler(passkeyUC)
+			hdl.Rename(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestPasskey_Delete(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                  string
+		pathID                string
+		hasAccountIDInContext bool
+		expectCode            int
+		expectResponse        []byte
+		setMockPasskeyUC      func(*usecase.MockPasskeyUsecase)
+	}{
+		{
+			name:                  "successfully deleted",
+			pathID:                uuid.New().String(),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusNoContent,
+			expectResponse:        nil,
+			setMockPasskeyUC: func(passkeyUC *usecase.MockPasskeyUsecase) {
+				passkeyUC.
+					EXPECT().
+					Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "invalid id",
+			pathID:                "invalid",
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockPasskeyUC:      func(*usecase.MockPasskeyUsecase) {},
+		},
+		{
+			name:                  "account id not found",
+			pathID:                uuid.New().String(),
+			hasAccountIDInContext: false,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockPasskeyUC:      func(*usecase.MockPasskeyUsecase) {},
+		},
+		{
+			name:                  "internal server error",
+			pathID:                uuid.New().String(),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusInternalServerError,
+			expectResponse:        []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockPasskeyUC: func(passkeyUC *usecase.MockPasskeyUsecase) {
+				passkeyUC.
+					EXPECT().
+					Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete passkey")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "DELETE", "/accounts/passkeys/"+tt.pathID, http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+			c.Params = gin.Params{{Key: "id", Value: tt.pathID}}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			passkeyUC := usecase.NewMockPasskeyUsecase(ctrl)
+			tt.setMockPasskeyUC(passkeyUC)
+
+			hdl := handler.NewPasskeyHandler(passkeyUC)
+			hdl.Delete(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
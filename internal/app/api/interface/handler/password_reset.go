@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cache"
+	hdlerr "github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/errors"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+)
+
+type PasswordResetHandler interface {
+	Request(*gin.Context)
+	Confirm(*gin.Context)
+}
+
+type passwordResetHandler struct {
+	passwordResetUC usecase.PasswordResetUsecase
+	sessionCache    cache.SessionCache
+}
+
+func NewPasswordResetHandler(passwordResetUC usecase.PasswordResetUsecase, sessionCache cache.SessionCache) PasswordResetHandler {
+	return &passwordResetHandler{
+		passwordResetUC: passwordResetUC,
+		sessionCache:    sessionCache,
+	}
+}
+
+// NOTE: アカウントの存在を推測されないよう, アカウントの有無に関わらず202を返却する.
+func (h *passwordResetHandler) Request(c *gin.Context) {
+	var req schema.RequestPasswordResetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to request password reset"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if err := h.passwordResetUC.Request(ctx, req.Email); err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+func (h *passwordResetHandler) Confirm(c *gin.Context) {
+	var req schema.ConfirmPasswordResetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to reset password"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	account, err := h.passwordResetUC.Confirm(ctx, req.Token, req.Password, req.ConfirmPassword)
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+	h.sessionCache.DeleteByAccountID(account.ID)
+
+	c.Status(http.StatusNoContent)
+}
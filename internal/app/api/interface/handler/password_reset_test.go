@@ -0,0 +1,206 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/handler"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cache"
+	appUsecase "github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/test/mock/usecase"
+)
+
+func TestPasswordReset_Request(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                   string
+		requestBody            []byte
+		expectCode             int
+		expectResponse         []byte
+		setMockPasswordResetUC func(context.Context, *usecase.MockPasswordResetUsecase)
+	}{
+		{
+			name:           "successfully requested",
+			requestBody:    []byte(`{"email":"name@example.com"}`),
+			expectCode:     http.StatusAccepted,
+			expectResponse: nil,
+			setMockPasswordResetUC: func(ctx context.Context, passwordResetUC *usecase.MockPasswordResetUsecase) {
+				passwordResetUC.
+					EXPECT().
+					Request(ctx, "name@example.com").
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                   "bad request",
+			requestBody:            nil,
+			expectCode:             http.StatusBadRequest,
+			expectResponse:         []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockPasswordResetUC: func(context.Context, *usecase.MockPasswordResetUsecase) {},
+		},
+		{
+			name:           "internal server error",
+			requestBody:    []byte(`{"email":"name@example.com"}`),
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockPasswordResetUC: func(ctx context.Context, passwordResetUC *usecase.MockPasswordResetUsecase) {
+				passwordResetUC.
+					EXPECT().
+					Request(ctx, gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to save password reset")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/accounts/password-reset", bytes.NewBuffer(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			passwordResetUC := usecase.NewMockPasswordResetUsecase(ctrl)
+			tt.setMockPasswordResetUC(ctx, passwordResetUC)
+
+			hdl := handler.NewPasswordResetHandler(passwordResetUC, cache.NewSessionCache(time.Minute, 10))
+			hdl.Request(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestPasswordReset_Confirm(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                   string
+		requestBody            []byte
+		expectCode             int
+		expectResponse         []byte
+		setMockPasswordResetUC func(context.Context, *usecase.MockPasswordResetUsecase)
+	}{
+		{
+			name:           "successfully reset",
+			requestBody:    []byte(`{"token":"1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS","password":"password","confirm_password":"password"}`),
+			expectCode:     http.StatusNoContent,
+			expectResponse: nil,
+			setMockPasswordResetUC: func(ctx context.Context, passwordResetUC *usecase.MockPasswordResetUsecase) {
+				passwordResetUC.
+					EXPECT().
+					Confirm(ctx, "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS", "password", "password").
+					Return(&dto.AccountDTO{ID: uuid.New(), Name: "name"}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                   "bad request",
+			requestBody:            nil,
+			expectCode:             http.StatusBadRequest,
+			expectResponse:         []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockPasswordResetUC: func(context.Context, *usecase.MockPasswordResetUsecase) {},
+		},
+		{
+			name:           "reset not found",
+			requestBody:    []byte(`{"token":"1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS","password":"password","confirm_password":"password"}`),
+			expectCode:     http.StatusBadRequest,
+			expectResponse: []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockPasswordResetUC: func(ctx context.Context, passwordResetUC *usecase.MockPasswordResetUsecase) {
+				passwordResetUC.
+					EXPECT().
+					Confirm(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(appUsecase.ErrPasswordResetNotFound, errors.CodeBadRequest, "failed to reset password")).
+					Times(1)
+			},
+		},
+		{
+			name:           "password mismatch",
+			requestBody:    []byte(`{"token":"1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS","password":"password","confirm_password":"mismatch"}`),
+			expectCode:     http.StatusUnprocessableEntity,
+			expectResponse: []byte(`{"error":{"code":"INVALID_INPUT","message":"passwords do not match"}}`),
+			setMockPasswordResetUC: func(ctx context.Context, passwordResetUC *usecase.MockPasswordResetUsecase) {
+				passwordResetUC.
+					EXPECT().
+					Confirm(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(entity.ErrAccountPasswordMismatch, errors.CodeInvalidInput, "failed to set account password")).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			requestBody:    []byte(`{"token":"1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS","password":"password","confirm_password":"password"}`),
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockPasswordResetUC: func(ctx context.Context, passwordResetUC *usecase.MockPasswordResetUsecase) {
+				passwordResetUC.
+					EXPECT().
+					Confirm(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to update account")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/accounts/password-reset/confirm", bytes.NewBuffer(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			passwordResetUC := usecase.NewMockPasswordResetUsecase(ctrl)
+			tt.setMockPasswordResetUC(ctx, passwordResetUC)
+
+			hdl := handler.NewPasswordResetHandler(passwordResetUC, cache.NewSessionCache(time.Minute, 10))
+			hdl.Confirm(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
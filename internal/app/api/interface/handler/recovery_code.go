@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/builder"
+	hdlerr "github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/errors"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/parameter"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+)
+
+type RecoveryCodeHandler interface {
+	Regenerate(*gin.Context)
+	Count(*gin.Context)
+}
+
+type recoveryCodeHandler struct {
+	recoveryCodeUC usecase.RecoveryCodeUsecase
+}
+
+func NewRecoveryCodeHandler(recoveryCodeUC usecase.RecoveryCodeUsecase) RecoveryCodeHandler {
+	return &recoveryCodeHandler{
+		recoveryCodeUC: recoveryCodeUC,
+	}
+}
+
+func (h *recoveryCodeHandler) Regenerate(c *gin.Context) {
+	var req schema.RegenerateRecoveryCodesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to regenerate recovery codes"))
+		return
+	}
+
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to regenerate recovery codes"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	codes, err := h.recoveryCodeUC.Regenerate(ctx, accountID, req.Password)
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, builder.ToRecoveryCodesResponse(codes))
+}
+
+func (h *recoveryCodeHandler) Count(c *gin.Context) {
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to count recovery codes"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	count, err := h.recoveryCodeUC.Count(ctx, accountID)
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, builder.ToRecoveryCodeCountResponse(count))
+}
@@ -0,0 +1,228 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/handler"
+	appUsecase "github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/test/mock/usecase"
+)
+
+func TestRecoveryCode_Regenerate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	codesDTO := &dto.RecoveryCodesDTO{
+		Codes: []string{"abcde-23456", "fghij-34567"},
+	}
+
+	tests := []struct {
+		name                  string
+		requestBody           []byte
+		hasAccountIDInContext bool
+		expectCode            int
+		expectResponse        []byte
+		setMockRecoveryCodeUC func(context.Context, *usecase.MockRecoveryCodeUsecase)
+	}{
+		{
+			name:                  "successfully regenerated",
+			requestBody:           []byte(`{"password":"password"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusCreated,
+			expectResponse:        []byte(`{"codes":["abcde-23456","fghij-34567"]}`),
+			setMockRecoveryCodeUC: func(ctx context.Context, recoveryCodeUC *usecase.MockRecoveryCodeUsecase) {
+				recoveryCodeUC.
+					EXPECT().
+					Regenerate(ctx, gomock.Any(), gomock.Any()).
+					Return(codesDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "bad request",
+			requestBody:           nil,
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockRecoveryCodeUC: func(context.Context, *usecase.MockRecoveryCodeUsecase) {},
+		},
+		{
+			name:                  "account id not found",
+			requestBody:           []byte(`{"password":"password"}`),
+			hasAccountIDInContext: false,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockRecoveryCodeUC: func(context.Context, *usecase.MockRecoveryCodeUsecase) {},
+		},
+		{
+			name:                  "authentication failed",
+			requestBody:           []byte(`{"password":"PASSWORD"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockRecoveryCodeUC: func(ctx context.Context, recoveryCodeUC *usecase.MockRecoveryCodeUsecase) {
+				recoveryCodeUC.
+					EXPECT().
+					Regenerate(ctx, gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(entity.ErrAccountPasswordIncorrect, errors.CodeUnauthenticated, "failed to verify password")).
+					Times(1)
+			},
+		},
+		{
+			name:                  "totp not enabled",
+			requestBody:           []byte(`{"password":"password"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusConflict,
+			expectResponse:        []byte(`{"error":{"code":"CONSTRAINT_VIOLATION","message":"totp is not enrolled"}}`),
+			setMockRecoveryCodeUC: func(ctx context.Context, recoveryCodeUC *usecase.MockRecoveryCodeUsecase) {
+				recoveryCodeUC.
+					EXPECT().
+					Regenerate(ctx, gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(appUsecase.ErrTOTPNotEnrolled, errors.CodeConstraintViolation, "failed to regenerate recovery codes")).
+					Times(1)
+			},
+		},
+		{
+			name:                  "internal server error",
+			requestBody:           []byte(`{"password":"password"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusInternalServerError,
+			expectResponse:        []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockRecoveryCodeUC: func(ctx context.Context, recoveryCodeUC *usecase.MockRecoveryCodeUsecase) {
+				recoveryCodeUC.
+					EXPECT().
+					Regenerate(ctx, gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to create recovery code")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/accounts/recovery-codes", bytes.NewBuffer(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			recoveryCodeUC := usecase.NewMockRecoveryCodeUsecase(ctrl)
+			tt.setMockRecoveryCodeUC(ctx, recoveryCodeUC)
+
+			hdl := handler.NewRecoveryCodeHandler(recoveryCodeUC)
+			hdl.Regenerate(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestRecoveryCode_Count(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                  string
+		hasAccountIDInContext bool
+		expectCode            int
+		expectResponse        []byte
+		setMockRecoveryCodeUC func(context.Context, *usecase.MockRecoveryCodeUsecase)
+	}{
+		{
+			name:                  "successfully counted",
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusOK,
+			expectResponse:        []byte(`{"remaining":8}`),
+			setMockRecoveryCodeUC: func(ctx context.Context, recoveryCodeUC *usecase.MockRecoveryCodeUsecase) {
+				recoveryCodeUC.
+					EXPECT().
+					Count(ctx, gomock.Any()).
+					Return(&dto.RecoveryCodeCountDTO{Remaining: 8}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "account id not found",
+			hasAccountIDInContext: false,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockRecoveryCodeUC: func(context.Context, *usecase.MockRecoveryCodeUsecase) {},
+		},
+		{
+			name:                  "internal server error",
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusInternalServerError,
+			expectResponse:        []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockRecoveryCodeUC: func(ctx context.Context, recoveryCodeUC *usecase.MockRecoveryCodeUsecase) {
+				recoveryCodeUC.
+					EXPECT().
+					Count(ctx, gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to count recovery codes by account id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "GET", "/accounts/recovery-codes", nil)
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			recoveryCodeUC := usecase.NewMockRecoveryCodeUsecase(ctrl)
+			tt.setMockRecoveryCodeUC(ctx, recoveryCodeUC)
+
+			hdl := handler.NewRecoveryCodeHandler(recoveryCodeUC)
+			hdl.Count(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -2,35 +2,54 @@ package handler
 
 import (
 	stderr "errors"
+	"io"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/atsumarukun/holos-api-pkg/errors"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/builder"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cache"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cookie"
 	hdlerr "github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/errors"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/parameter"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
 )
 
 var ErrInvalidToken = stderr.New("invalid token")
 
 type SessionHandler interface {
 	Create(*gin.Context)
+	Challenge(*gin.Context)
+	CreatePasskeyOptions(*gin.Context)
+	CreateWithPasskey(*gin.Context)
 	Delete(*gin.Context)
+	DeleteByID(*gin.Context)
+	GetAll(*gin.Context)
+	Introspect(*gin.Context)
+	Refresh(*gin.Context)
 	Verify(*gin.Context)
 }
 
 type sessionHandler struct {
-	sessionUC usecase.SessionUsecase
+	sessionUC     usecase.SessionUsecase
+	sessionCache  cache.SessionCache
+	sessionCookie cookie.SessionCookie
 }
 
-func NewSessionHandler(sessionUC usecase.SessionUsecase) SessionHandler {
+func NewSessionHandler(sessionUC usecase.SessionUsecase, sessionCache cache.SessionCache, sessionCookie cookie.SessionCookie) SessionHandler {
 	return &sessionHandler{
-		sessionUC: sessionUC,
+		sessionUC:     sessionUC,
+		sessionCache:  sessionCache,
+		sessionCookie: sessionCookie,
 	}
 }
 
@@ -43,13 +62,81 @@ func (h *sessionHandler) Create(c *gin.Context) {
 
 	ctx := c.Request.Context()
 
-	session, err := h.sessionUC.Create(ctx, req.AccountName, req.Password)
+	session, challenge, err := h.sessionUC.Create(ctx, req.AccountName, req.Password, req.RememberMe, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		handleLoginError(c, err)
+		return
+	}
+
+	if challenge != nil {
+		c.JSON(http.StatusAccepted, builder.ToLoginChallengeResponse(challenge))
+		return
+	}
+
+	h.respondCreatedSession(c, session, req.UseCookie)
+}
+
+func (h *sessionHandler) Challenge(c *gin.Context) {
+	var req schema.ChallengeSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to complete login challenge"))
+		return
+	}
+
+	// NOTE: 認証手段が指定されていない場合はTOTPとして扱う.
+	method := req.Method
+	if method == "" {
+		method = entity.LoginChallengeMethodTOTP
+	}
+
+	ctx := c.Request.Context()
+
+	session, err := h.sessionUC.Challenge(ctx, req.ChallengeToken, method, req.Code, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		handleLoginError(c, err)
+		return
+	}
+
+	h.respondCreatedSession(c, session, req.UseCookie)
+}
+
+func (h *sessionHandler) CreatePasskeyOptions(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	options, err := h.sessionUC.CreatePasskeyOptions(ctx)
 	if err != nil {
 		hdlerr.Handle(c, err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, builder.ToSessionResponse(session))
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, builder.ToPasskeyAuthenticationOptionsResponse(options))
+}
+
+func (h *sessionHandler) CreateWithPasskey(c *gin.Context) {
+	var req schema.CreatePasskeySessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to create session with passkey"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	assertion := &webauthn.Assertion{
+		CredentialID:      req.Credential.RawID,
+		ClientDataJSON:    req.Credential.Response.ClientDataJSON,
+		AuthenticatorData: req.Credential.Response.AuthenticatorData,
+		Signature:         req.Credential.Response.Signature,
+		UserHandle:        req.Credential.Response.UserHandle,
+	}
+
+	session, err := h.sessionUC.CreateWithPasskey(ctx, assertion, req.RememberMe, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	h.respondCreatedSession(c, session, req.UseCookie)
 }
 
 func (h *sessionHandler) Delete(c *gin.Context) {
@@ -59,16 +146,127 @@ func (h *sessionHandler) Delete(c *gin.Context) {
 		return
 	}
 
+	sessionID, err := parameter.GetContextParameter[uuid.UUID](c, "sessionID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to delete session"))
+		return
+	}
+
 	ctx := c.Request.Context()
 
-	if err := h.sessionUC.Delete(ctx, accountID); err != nil {
+	if err := h.sessionUC.Delete(ctx, accountID, sessionID); err != nil {
 		hdlerr.Handle(c, err)
 		return
 	}
+	h.sessionCache.DeleteBySessionID(sessionID)
+	h.sessionCookie.Clear(c)
 
 	c.Status(http.StatusNoContent)
 }
 
+func (h *sessionHandler) DeleteByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to delete session"))
+		return
+	}
+
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to delete session"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if err := h.sessionUC.Delete(ctx, accountID, id); err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+	h.sessionCache.DeleteBySessionID(id)
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *sessionHandler) GetAll(c *gin.Context) {
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to get sessions"))
+		return
+	}
+
+	sessionID, err := parameter.GetContextParameter[uuid.UUID](c, "sessionID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to get sessions"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	sessions, err := h.sessionUC.GetAll(ctx, accountID)
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, builder.ToSessionDetailResponses(sessions, sessionID))
+}
+
+// NOTE: RFC 7662に準拠し, 無効なトークンはエラーではなく`active`がfalseのレスポンスとして返却する.
+func (h *sessionHandler) Introspect(c *gin.Context) {
+	var req schema.IntrospectSessionRequest
+	if err := c.ShouldBind(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to introspect session"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	session, err := h.sessionUC.Verify(ctx, req.Token)
+	if err != nil && !stderr.Is(err, usecase.ErrSessionNotFound) && !stderr.Is(err, usecase.ErrAccountNotFound) {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, builder.ToIntrospectSessionResponse(session))
+}
+
+// NOTE: Cookieで認証するクライアントはリクエストボディを送信せず, リフレッシュトークンのCookieを使用する.
+func (h *sessionHandler) Refresh(c *gin.Context) {
+	var req schema.RefreshSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !stderr.Is(err, io.EOF) {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to refresh session"))
+		return
+	}
+
+	refreshToken, useCookie := req.RefreshToken, false
+	if refreshToken == "" {
+		refreshToken, useCookie = h.sessionCookie.GetRefreshToken(c)
+	}
+
+	ctx := c.Request.Context()
+
+	session, err := h.sessionUC.Refresh(ctx, refreshToken)
+	if err != nil {
+		var reusedErr *usecase.RefreshTokenReusedError
+		if stderr.As(err, &reusedErr) {
+			h.sessionCache.DeleteBySessionID(reusedErr.SessionID)
+		}
+		hdlerr.Handle(c, err)
+		return
+	}
+	h.sessionCache.DeleteBySessionID(session.ID)
+
+	if useCookie {
+		csrfToken := h.sessionCookie.Set(c, session)
+		c.JSON(http.StatusOK, builder.ToCookieSessionResponse(session, csrfToken))
+		return
+	}
+
+	c.JSON(http.StatusOK, builder.ToSessionResponse(session))
+}
+
 func (h *sessionHandler) Verify(c *gin.Context) {
 	sessionToken := strings.Split(c.Request.Header.Get("Authorization"), " ")
 	if len(sessionToken) != 2 || sessionToken[0] != "Session" {
@@ -80,11 +278,31 @@ func (h *sessionHandler) Verify(c *gin.Context) {
 
 	ctx := c.Request.Context()
 
-	account, err := h.sessionUC.Verify(ctx, sessionToken[1])
+	session, err := h.sessionUC.Verify(ctx, sessionToken[1])
 	if err != nil {
 		hdlerr.Handle(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, builder.ToVerifiedSessionResponse(account))
+	c.JSON(http.StatusOK, builder.ToVerifiedSessionResponse(session))
+}
+
+func handleLoginError(c *gin.Context, err error) {
+	var lockedErr *entity.LoginLockedError
+	if stderr.As(err, &lockedErr) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(lockedErr.RetryAfter.Seconds()))))
+		hdlerr.Handle(c, errors.Wrap(lockedErr, hdlerr.CodeLoginLocked, "failed to authenticate"))
+		return
+	}
+	hdlerr.Handle(c, err)
+}
+
+func (h *sessionHandler) respondCreatedSession(c *gin.Context, session *dto.SessionDTO, useCookie bool) {
+	if useCookie {
+		csrfToken := h.sessionCookie.Set(c, session)
+		c.JSON(http.StatusCreated, builder.ToCookieSessionResponse(session, csrfToken))
+		return
+	}
+
+	c.JSON(http.StatusCreated, builder.ToSessionResponse(session))
 }
@@ -2,10 +2,14 @@ package handler_test
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,18 +20,44 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/handler"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cache"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cookie"
+	appUsecase "github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
 	"github.com/atsumarukun/holos-account-api/test/mock/usecase"
 )
 
+var sessionCookie = cookie.NewSessionCookie("", true, http.SameSiteLaxMode, []byte("secret"))
+
+func csrfToken(token string) string {
+	key := hmac.New(sha256.New, []byte("secret"))
+	key.Write([]byte("csrf"))
+
+	mac := hmac.New(sha256.New, key.Sum(nil))
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
 func TestSession_Create(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	sessionDTO := &dto.SessionDTO{
-		AccountID: uuid.New(),
-		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
-		ExpiresAt: time.Now().Add(time.Hour * 24 * 7),
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		ExpiresAt:        time.Now().Add(time.Minute * 15),
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24 * 7),
+		CreatedAt:        time.Now(),
+	}
+	challengeDTO := &dto.LoginChallengeDTO{
+		Token:     "3pZ0kBqvTn1cR7yHw9XeLd2MfJ8aUs4G",
+		Methods:   []string{entity.LoginChallengeMethodTOTP},
+		ExpiresAt: time.Now().Add(time.Minute * 5),
 	}
 
 	tests := []struct {
@@ -35,18 +65,47 @@ func TestSession_Create(t *testing.T) {
 		requestBody      []byte
 		expectCode       int
 		expectResponse   []byte
+		expectCookies    int
+		expectRetryAfter string
 		setMockSessionUC func(*usecase.MockSessionUsecase)
 	}{
 		{
 			name:           "successfully created",
 			requestBody:    []byte(`{"account_name":"name","password":"password"}`),
 			expectCode:     http.StatusCreated,
-			expectResponse: fmt.Appendf(nil, `{"token":"%s"}`, sessionDTO.Token),
+			expectResponse: fmt.Appendf(nil, `{"token":"%s","refresh_token":"%s"}`, sessionDTO.Token, sessionDTO.RefreshToken),
 			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
 				sessionUC.
 					EXPECT().
-					Create(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(sessionDTO, nil).
+					Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(sessionDTO, nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "successfully created with cookie",
+			requestBody:    []byte(`{"account_name":"name","password":"password","use_cookie":true}`),
+			expectCode:     http.StatusCreated,
+			expectResponse: fmt.Appendf(nil, `{"csrf_token":"%s"}`, csrfToken(sessionDTO.Token)),
+			expectCookies:  3,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(sessionDTO, nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "challenge required",
+			requestBody:    []byte(`{"account_name":"name","password":"password","use_cookie":true}`),
+			expectCode:     http.StatusAccepted,
+			expectResponse: fmt.Appendf(nil, `{"challenge_token":"%s","methods":["totp"],"expires_at":"%s"}`, challengeDTO.Token, challengeDTO.ExpiresAt.Format(time.RFC3339Nano)),
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, challengeDTO, nil).
 					Times(1)
 			},
 		},
@@ -65,8 +124,22 @@ func TestSession_Create(t *testing.T) {
 			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
 				sessionUC.
 					EXPECT().
-					Create(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil, errors.Wrap(entity.ErrAccountPasswordIncorrect, errors.CodeUnauthenticated, "failed to verify account password")).
+					Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.Wrap(entity.ErrAccountPasswordIncorrect, errors.CodeUnauthenticated, "failed to verify account password")).
+					Times(1)
+			},
+		},
+		{
+			name:             "login locked",
+			requestBody:      []byte(`{"account_name":"name","password":"password"}`),
+			expectCode:       http.StatusTooManyRequests,
+			expectResponse:   []byte(`{"error":{"code":"LOGIN_LOCKED","message":"login locked"}}`),
+			expectRetryAfter: "91",
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.Wrap(&entity.LoginLockedError{RetryAfter: time.Second*90 + time.Millisecond}, errors.CodeUnauthenticated, "failed to create session")).
 					Times(1)
 			},
 		},
@@ -78,8 +151,8 @@ func TestSession_Create(t *testing.T) {
 			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
 				sessionUC.
 					EXPECT().
-					Create(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to find account by name")).
+					Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to find account by name")).
 					Times(1)
 			},
 		},
@@ -102,7 +175,7 @@ func TestSession_Create(t *testing.T) {
 			sessionUC := usecase.NewMockSessionUsecase(ctrl)
 			tt.setMockSessionUC(sessionUC)
 
-			hdl := handler.NewSessionHandler(sessionUC)
+			hdl := handler.NewSessionHandler(sessionUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
 			hdl.Create(c)
 
 			c.Writer.WriteHeaderNow()
@@ -114,50 +187,126 @@ func TestSession_Create(t *testing.T) {
 			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
 				t.Error(diff)
 			}
+
+			if cookies := len(w.Result().Cookies()); cookies != tt.expectCookies {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCookies, cookies)
+			}
+
+			if retryAfter := w.Header().Get("Retry-After"); retryAfter != tt.expectRetryAfter {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectRetryAfter, retryAfter)
+			}
 		})
 	}
 }
 
-func TestSession_Delete(t *testing.T) {
+func TestSession_Challenge(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
+	sessionDTO := &dto.SessionDTO{
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		ExpiresAt:        time.Now().Add(time.Minute * 15),
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24 * 7),
+		CreatedAt:        time.Now(),
+	}
+
 	tests := []struct {
-		name                  string
-		hasAccountIDInContext bool
-		expectResponse        []byte
-		expectCode            int
-		setMockSessionUC      func(*usecase.MockSessionUsecase)
+		name             string
+		requestBody      []byte
+		expectCode       int
+		expectResponse   []byte
+		expectCookies    int
+		expectRetryAfter string
+		setMockSessionUC func(*usecase.MockSessionUsecase)
 	}{
 		{
-			name:                  "successfully deleted",
-			hasAccountIDInContext: true,
-			expectResponse:        nil,
-			expectCode:            http.StatusNoContent,
+			name:           "successfully created",
+			requestBody:    []byte(`{"challenge_token":"3pZ0kBqvTn1cR7yHw9XeLd2MfJ8aUs4G","code":"123456"}`),
+			expectCode:     http.StatusCreated,
+			expectResponse: fmt.Appendf(nil, `{"token":"%s","refresh_token":"%s"}`, sessionDTO.Token, sessionDTO.RefreshToken),
 			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
 				sessionUC.
 					EXPECT().
-					Delete(gomock.Any(), gomock.Any()).
-					Return(nil).
+					Challenge(gomock.Any(), gomock.Any(), entity.LoginChallengeMethodTOTP, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(sessionDTO, nil).
 					Times(1)
 			},
 		},
 		{
-			name:                  "account id not set",
-			hasAccountIDInContext: false,
-			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
-			expectCode:            http.StatusUnauthorized,
-			setMockSessionUC:      func(*usecase.MockSessionUsecase) {},
+			name:           "successfully created with recovery code",
+			requestBody:    []byte(`{"challenge_token":"3pZ0kBqvTn1cR7yHw9XeLd2MfJ8aUs4G","method":"recovery_code","code":"abcde-23456"}`),
+			expectCode:     http.StatusCreated,
+			expectResponse: fmt.Appendf(nil, `{"token":"%s","refresh_token":"%s"}`, sessionDTO.Token, sessionDTO.RefreshToken),
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Challenge(gomock.Any(), gomock.Any(), entity.LoginChallengeMethodRecoveryCode, "abcde-23456", gomock.Any(), gomock.Any()).
+					Return(sessionDTO, nil).
+					Times(1)
+			},
 		},
 		{
-			name:                  "internal server error",
-			hasAccountIDInContext: true,
-			expectResponse:        []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
-			expectCode:            http.StatusInternalServerError,
+			name:           "successfully created with cookie",
+			requestBody:    []byte(`{"challenge_token":"3pZ0kBqvTn1cR7yHw9XeLd2MfJ8aUs4G","code":"123456","use_cookie":true}`),
+			expectCode:     http.StatusCreated,
+			expectResponse: fmt.Appendf(nil, `{"csrf_token":"%s"}`, csrfToken(sessionDTO.Token)),
+			expectCookies:  3,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Challenge(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(sessionDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:             "bad request",
+			requestBody:      nil,
+			expectCode:       http.StatusBadRequest,
+			expectResponse:   []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockSessionUC: func(*usecase.MockSessionUsecase) {},
+		},
+		{
+			name:           "unauthenticated",
+			requestBody:    []byte(`{"challenge_token":"3pZ0kBqvTn1cR7yHw9XeLd2MfJ8aUs4G","code":"000000"}`),
+			expectCode:     http.StatusUnauthorized,
+			expectResponse: []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Challenge(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(entity.ErrTOTPCodeInvalid, errors.CodeUnauthenticated, "failed to verify totp")).
+					Times(1)
+			},
+		},
+		{
+			name:             "login locked",
+			requestBody:      []byte(`{"challenge_token":"3pZ0kBqvTn1cR7yHw9XeLd2MfJ8aUs4G","code":"123456"}`),
+			expectCode:       http.StatusTooManyRequests,
+			expectResponse:   []byte(`{"error":{"code":"LOGIN_LOCKED","message":"login locked"}}`),
+			expectRetryAfter: "91",
 			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
 				sessionUC.
 					EXPECT().
-					Delete(gomock.Any(), gomock.Any()).
-					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to find session by account_id")).
+					Challenge(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(&entity.LoginLockedError{RetryAfter: time.Second*90 + time.Millisecond}, errors.CodeUnauthenticated, "failed to create session")).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			requestBody:    []byte(`{"challenge_token":"3pZ0kBqvTn1cR7yHw9XeLd2MfJ8aUs4G","code":"123456"}`),
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Challenge(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find login challenge by token and not expired")).
 					Times(1)
 			},
 		},
@@ -169,12 +318,91 @@ func TestSession_Delete(t *testing.T) {
 
 			c, _ := gin.CreateTestContext(w)
 			var err error
-			c.Request, err = http.NewRequestWithContext(ctx, "DELETE", "/sessions", http.NoBody)
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/sessions/challenge", bytes.NewBuffer(tt.requestBody))
 			if err != nil {
 				t.Error(err)
 			}
-			if tt.hasAccountIDInContext {
-				c.Set("accountID", uuid.New())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionUC := usecase.NewMockSessionUsecase(ctrl)
+			tt.setMockSessionUC(sessionUC)
+
+			hdl := handler.NewSessionHandler(sessionUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
+			hdl.Challenge(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+
+			if cookies := len(w.Result().Cookies()); cookies != tt.expectCookies {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCookies, cookies)
+			}
+
+			if retryAfter := w.Header().Get("Retry-After"); retryAfter != tt.expectRetryAfter {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectRetryAfter, retryAfter)
+			}
+		})
+	}
+}
+
+func TestSession_CreatePasskeyOptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	optionsDTO := &dto.PasskeyAuthenticationOptionsDTO{
+		Challenge: "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RPID:      "localhost",
+		Timeout:   time.Minute * 5,
+	}
+
+	tests := []struct {
+		name             string
+		expectCode       int
+		expectResponse   []byte
+		setMockSessionUC func(*usecase.MockSessionUsecase)
+	}{
+		{
+			name:           "successfully created",
+			expectCode:     http.StatusCreated,
+			expectResponse: []byte(`{"challenge":"1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS","rpId":"localhost","timeout":300000,"userVerification":"required"}`),
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					CreatePasskeyOptions(gomock.Any()).
+					Return(optionsDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					CreatePasskeyOptions(gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to create passkey challenge")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/sessions/passkey/options", http.NoBody)
+			if err != nil {
+				t.Error(err)
 			}
 
 			ctrl := gomock.NewController(t)
@@ -183,8 +411,8 @@ func TestSession_Delete(t *testing.T) {
 			sessionUC := usecase.NewMockSessionUsecase(ctrl)
 			tt.setMockSessionUC(sessionUC)
 
-			hdl := handler.NewSessionHandler(sessionUC)
-			hdl.Delete(c)
+			hdl := handler.NewSessionHandler(sessionUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
+			hdl.CreatePasskeyOptions(c)
 
 			c.Writer.WriteHeaderNow()
 
@@ -199,13 +427,724 @@ func TestSession_Delete(t *testing.T) {
 	}
 }
 
-func TestSession_Verify(t *testing.T) {
+func TestSession_CreateWithPasskey(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
-	accountDTO := &dto.AccountDTO{
-		ID:       uuid.New(),
-		Name:     "name",
-		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	sessionDTO := &dto.SessionDTO{
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		ExpiresAt:        time.Now().Add(time.Minute * 15),
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24 * 7),
+		CreatedAt:        time.Now(),
+	}
+	assertion := &webauthn.Assertion{
+		CredentialID:      []byte("credential"),
+		ClientDataJSON:    []byte("{}"),
+		AuthenticatorData: []byte("authdata"),
+		Signature:         []byte("signature"),
+		UserHandle:        []byte("user"),
+	}
+	credential := `{"id":"Y3JlZGVudGlhbA","rawId":"Y3JlZGVudGlhbA","type":"public-key","response":{"clientDataJSON":"e30","authenticatorData":"YXV0aGRhdGE","signature":"c2lnbmF0dXJl","userHandle":"dXNlcg"}}`
+
+	tests := []struct {
+		name             string
+		requestBody      []byte
+		expectCode       int
+		expectResponse   []byte
+		expectCookies    int
+		setMockSessionUC func(*usecase.MockSessionUsecase)
+	}{
+		{
+			name:           "successfully created",
+			requestBody:    fmt.Appendf(nil, `{"credential":%s,"remember_me":true}`, credential),
+			expectCode:     http.StatusCreated,
+			expectResponse: fmt.Appendf(nil, `{"token":"%s","refresh_token":"%s"}`, sessionDTO.Token, sessionDTO.RefreshToken),
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					CreateWithPasskey(gomock.Any(), assertion, true, gomock.Any(), gomock.Any()).
+					Return(sessionDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "successfully created with cookie",
+			requestBody:    fmt.Appendf(nil, `{"credential":%s,"use_cookie":true}`, credential),
+			expectCode:     http.StatusCreated,
+			expectResponse: fmt.Appendf(nil, `{"csrf_token":"%s"}`, csrfToken(sessionDTO.Token)),
+			expectCookies:  3,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					CreateWithPasskey(gomock.Any(), assertion, false, gomock.Any(), gomock.Any()).
+					Return(sessionDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:             "bad request",
+			requestBody:      []byte(`{"credential":{"rawId":"not base64url!"}}`),
+			expectCode:       http.StatusBadRequest,
+			expectResponse:   []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockSessionUC: func(*usecase.MockSessionUsecase) {},
+		},
+		{
+			name:           "unauthenticated",
+			requestBody:    fmt.Appendf(nil, `{"credential":%s}`, credential),
+			expectCode:     http.StatusUnauthorized,
+			expectResponse: []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					CreateWithPasskey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(entity.ErrPasskeySignCountInvalid, errors.CodeUnauthenticated, "failed to use passkey")).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			requestBody:    fmt.Appendf(nil, `{"credential":%s}`, credential),
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					CreateWithPasskey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find passkey by credential id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/sessions/passkey", bytes.NewBuffer(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionUC := usecase.NewMockSessionUsecase(ctrl)
+			tt.setMockSessionUC(sessionUC)
+
+			hdl := handler.NewSessionHandler(sessionUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
+			hdl.CreateWithPasskey(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+
+			if cookies := len(w.Result().Cookies()); cookies != tt.expectCookies {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCookies, cookies)
+			}
+		})
+	}
+}
+
+func TestSession_Delete(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                  string
+		hasAccountIDInContext bool
+		hasSessionIDInContext bool
+		expectResponse        []byte
+		expectCode            int
+		setMockSessionUC      func(*usecase.MockSessionUsecase)
+	}{
+		{
+			name:                  "successfully deleted",
+			hasAccountIDInContext: true,
+			hasSessionIDInContext: true,
+			expectResponse:        nil,
+			expectCode:            http.StatusNoContent,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "account id not set",
+			hasAccountIDInContext: false,
+			hasSessionIDInContext: true,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			expectCode:            http.StatusUnauthorized,
+			setMockSessionUC:      func(*usecase.MockSessionUsecase) {},
+		},
+		{
+			name:                  "session id not set",
+			hasAccountIDInContext: true,
+			hasSessionIDInContext: false,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			expectCode:            http.StatusUnauthorized,
+			setMockSessionUC:      func(*usecase.MockSessionUsecase) {},
+		},
+		{
+			name:                  "internal server error",
+			hasAccountIDInContext: true,
+			hasSessionIDInContext: true,
+			expectResponse:        []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			expectCode:            http.StatusInternalServerError,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to find session by id and account_id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "DELETE", "/sessions", http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+			if tt.hasSessionIDInContext {
+				c.Set("sessionID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionUC := usecase.NewMockSessionUsecase(ctrl)
+			tt.setMockSessionUC(sessionUC)
+
+			hdl := handler.NewSessionHandler(sessionUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
+			hdl.Delete(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSession_DeleteByID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                  string
+		pathID                string
+		hasAccountIDInContext bool
+		expectResponse        []byte
+		expectCode            int
+		setMockSessionUC      func(*usecase.MockSessionUsecase)
+	}{
+		{
+			name:                  "successfully deleted",
+			pathID:                uuid.New().String(),
+			hasAccountIDInContext: true,
+			expectResponse:        nil,
+			expectCode:            http.StatusNoContent,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "invalid id",
+			pathID:                "invalid",
+			hasAccountIDInContext: true,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			expectCode:            http.StatusBadRequest,
+			setMockSessionUC:      func(*usecase.MockSessionUsecase) {},
+		},
+		{
+			name:                  "account id not set",
+			pathID:                uuid.New().String(),
+			hasAccountIDInContext: false,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			expectCode:            http.StatusUnauthorized,
+			setMockSessionUC:      func(*usecase.MockSessionUsecase) {},
+		},
+		{
+			name:                  "internal server error",
+			pathID:                uuid.New().String(),
+			hasAccountIDInContext: true,
+			expectResponse:        []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			expectCode:            http.StatusInternalServerError,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to find session by id and account_id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "DELETE", "/sessions/"+tt.pathID, http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+			c.Params = gin.Params{{Key: "id", Value: tt.pathID}}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionUC := usecase.NewMockSessionUsecase(ctrl)
+			tt.setMockSessionUC(sessionUC)
+
+			hdl := handler.NewSessionHandler(sessionUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
+			hdl.DeleteByID(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSession_GetAll(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	sessionDTO := &dto.SessionDTO{
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		ExpiresAt:        time.Date(2025, 3, 20, 0, 15, 0, 0, time.UTC),
+		RefreshExpiresAt: time.Date(2025, 3, 27, 0, 0, 0, 0, time.UTC),
+		LastUsedAt:       time.Date(2025, 3, 20, 0, 5, 0, 0, time.UTC),
+		CreatedAt:        time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name                  string
+		hasAccountIDInContext bool
+		hasSessionIDInContext bool
+		expectResponse        []byte
+		expectCode            int
+		setMockSessionUC      func(*usecase.MockSessionUsecase)
+	}{
+		{
+			name:                  "successfully got",
+			hasAccountIDInContext: true,
+			hasSessionIDInContext: true,
+			expectResponse:        fmt.Appendf(nil, `[{"id":"%s","user_agent":"Mozilla/5.0","ip_address":"192.0.2.1","current":true,"expires_at":"2025-03-27T00:00:00Z","last_used_at":"2025-03-20T00:05:00Z","created_at":"2025-03-20T00:00:00Z"}]`, sessionDTO.ID),
+			expectCode:            http.StatusOK,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					GetAll(gomock.Any(), gomock.Any()).
+					Return([]*dto.SessionDTO{sessionDTO}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "no sessions",
+			hasAccountIDInContext: true,
+			hasSessionIDInContext: true,
+			expectResponse:        []byte(`[]`),
+			expectCode:            http.StatusOK,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					GetAll(gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "account id not set",
+			hasAccountIDInContext: false,
+			hasSessionIDInContext: true,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			expectCode:            http.StatusUnauthorized,
+			setMockSessionUC:      func(*usecase.MockSessionUsecase) {},
+		},
+		{
+			name:                  "session id not set",
+			hasAccountIDInContext: true,
+			hasSessionIDInContext: false,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			expectCode:            http.StatusUnauthorized,
+			setMockSessionUC:      func(*usecase.MockSessionUsecase) {},
+		},
+		{
+			name:                  "internal server error",
+			hasAccountIDInContext: true,
+			hasSessionIDInContext: true,
+			expectResponse:        []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			expectCode:            http.StatusInternalServerError,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					GetAll(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to find sessions by account_id and not expired")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "GET", "/sessions", http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", sessionDTO.AccountID)
+			}
+			if tt.hasSessionIDInContext {
+				c.Set("sessionID", sessionDTO.ID)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionUC := usecase.NewMockSessionUsecase(ctrl)
+			tt.setMockSessionUC(sessionUC)
+
+			hdl := handler.NewSessionHandler(sessionUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
+			hdl.GetAll(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSession_Introspect(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	verifiedSessionDTO := &dto.VerifiedSessionDTO{
+		Session: &dto.SessionDTO{
+			ID:        uuid.New(),
+			Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+			UserAgent: "Mozilla/5.0",
+			IPAddress: "192.0.2.1",
+			ExpiresAt: time.Now().Add(time.Minute * 15),
+			IssuedAt:  time.Now(),
+			CreatedAt: time.Now().Add(-time.Hour),
+		},
+		Account: &dto.AccountDTO{
+			ID:          uuid.New(),
+			Name:        "name",
+			Roles:       []string{"admin"},
+			Permissions: []string{"accounts:read", "accounts:write"},
+		},
+	}
+	verifiedSessionDTO.Session.AccountID = verifiedSessionDTO.Account.ID
+
+	tests := []struct {
+		name             string
+		requestBody      string
+		expectCode       int
+		expectResponse   []byte
+		setMockSessionUC func(*usecase.MockSessionUsecase)
+	}{
+		{
+			name:        "active",
+			requestBody: "token=1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+			expectCode:  http.StatusOK,
+			expectResponse: fmt.Appendf(
+				nil,
+				`{"active":true,"scope":"accounts:read accounts:write","username":"name","token_type":"Session","exp":%d,"iat":%d,"sub":"%s"}`,
+				verifiedSessionDTO.Session.ExpiresAt.Unix(),
+				verifiedSessionDTO.Session.IssuedAt.Unix(),
+				verifiedSessionDTO.Account.ID,
+			),
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Verify(gomock.Any(), "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS").
+					Return(verifiedSessionDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "session not found",
+			requestBody:    "token=1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+			expectCode:     http.StatusOK,
+			expectResponse: []byte(`{"active":false}`),
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Verify(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(appUsecase.ErrSessionNotFound, errors.CodeUnauthenticated, "failed to verify")).
+					Times(1)
+			},
+		},
+		{
+			name:           "account not found",
+			requestBody:    "token=1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+			expectCode:     http.StatusOK,
+			expectResponse: []byte(`{"active":false}`),
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Verify(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(appUsecase.ErrAccountNotFound, errors.CodeUnauthenticated, "failed to verify")).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			requestBody:    "token=1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Verify(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to find session by token and not expired")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/sessions/introspect", strings.NewReader(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+			c.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionUC := usecase.NewMockSessionUsecase(ctrl)
+			tt.setMockSessionUC(sessionUC)
+
+			hdl := handler.NewSessionHandler(sessionUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
+			hdl.Introspect(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSession_Refresh(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	sessionDTO := &dto.SessionDTO{
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		Token:            "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		RefreshToken:     "0pbQfM0cA2Nn3ZyE9o3w0z5Ll8xUYh2K",
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		ExpiresAt:        time.Now().Add(time.Minute * 15),
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24 * 7),
+		CreatedAt:        time.Now(),
+	}
+
+	tests := []struct {
+		name             string
+		requestBody      []byte
+		refreshCookie    string
+		expectCode       int
+		expectResponse   []byte
+		expectCached     bool
+		setMockSessionUC func(*usecase.MockSessionUsecase)
+	}{
+		{
+			name:           "successfully refreshed",
+			requestBody:    []byte(`{"refresh_token":"KNq0cC3q7u6m1oTzXb8yVdR5sWlFj2Ae"}`),
+			expectCode:     http.StatusOK,
+			expectResponse: fmt.Appendf(nil, `{"token":"%s","refresh_token":"%s"}`, sessionDTO.Token, sessionDTO.RefreshToken),
+			expectCached:   false,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Refresh(gomock.Any(), gomock.Any()).
+					Return(sessionDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "successfully refreshed with cookie",
+			requestBody:    nil,
+			refreshCookie:  "KNq0cC3q7u6m1oTzXb8yVdR5sWlFj2Ae",
+			expectCode:     http.StatusOK,
+			expectResponse: fmt.Appendf(nil, `{"csrf_token":"%s"}`, csrfToken(sessionDTO.Token)),
+			expectCached:   false,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Refresh(gomock.Any(), "KNq0cC3q7u6m1oTzXb8yVdR5sWlFj2Ae").
+					Return(sessionDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:             "bad request",
+			requestBody:      []byte(`{"refresh_token":`),
+			expectCode:       http.StatusBadRequest,
+			expectResponse:   []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			expectCached:     true,
+			setMockSessionUC: func(*usecase.MockSessionUsecase) {},
+		},
+		{
+			name:           "refresh token reused",
+			requestBody:    []byte(`{"refresh_token":"KNq0cC3q7u6m1oTzXb8yVdR5sWlFj2Ae"}`),
+			expectCode:     http.StatusUnauthorized,
+			expectResponse: []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			expectCached:   false,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Refresh(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(&appUsecase.RefreshTokenReusedError{SessionID: sessionDTO.ID}, errors.CodeUnauthenticated, "failed to refresh session")).
+					Times(1)
+			},
+		},
+		{
+			name:           "internal server error",
+			requestBody:    []byte(`{"refresh_token":"KNq0cC3q7u6m1oTzXb8yVdR5sWlFj2Ae"}`),
+			expectCode:     http.StatusInternalServerError,
+			expectResponse: []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			expectCached:   true,
+			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
+				sessionUC.
+					EXPECT().
+					Refresh(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to update session")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/sessions/refresh", bytes.NewBuffer(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.refreshCookie != "" {
+				c.Request.AddCookie(&http.Cookie{Name: cookie.RefreshTokenName, Value: tt.refreshCookie})
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionUC := usecase.NewMockSessionUsecase(ctrl)
+			tt.setMockSessionUC(sessionUC)
+
+			sessionCache := cache.NewSessionCache(time.Minute, 10)
+			sessionCache.Set(sessionDTO.Token, &dto.VerifiedSessionDTO{Session: sessionDTO, Account: &dto.AccountDTO{ID: sessionDTO.AccountID}})
+
+			hdl := handler.NewSessionHandler(sessionUC, sessionCache, sessionCookie)
+			hdl.Refresh(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+
+			if _, cached := sessionCache.Get(sessionDTO.Token); cached != tt.expectCached {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCached, cached)
+			}
+		})
+	}
+}
+
+func TestSession_Verify(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	verifiedSessionDTO := &dto.VerifiedSessionDTO{
+		Session: &dto.SessionDTO{
+			ID:        uuid.New(),
+			Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+			UserAgent: "Mozilla/5.0",
+			IPAddress: "192.0.2.1",
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 7),
+			CreatedAt: time.Now(),
+		},
+		Account: &dto.AccountDTO{
+			ID:          uuid.New(),
+			Name:        "name",
+			Roles:       []string{"admin"},
+			Permissions: []string{"accounts:read", "accounts:write"},
+		},
 	}
 
 	tests := []struct {
@@ -218,13 +1157,13 @@ func TestSession_Verify(t *testing.T) {
 		{
 			name:                "successfully verified",
 			authorizationHeader: "Session 1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
-			expectResponse:      fmt.Appendf(nil, `{"id":"%s","name":"%s"}`, accountDTO.ID, accountDTO.Name),
+			expectResponse:      fmt.Appendf(nil, `{"id":"%s","name":"%s","roles":["admin"],"permissions":["accounts:read","accounts:write"]}`, verifiedSessionDTO.Account.ID, verifiedSessionDTO.Account.Name),
 			expectCode:          http.StatusOK,
 			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
 				sessionUC.
 					EXPECT().
 					Verify(gomock.Any(), gomock.Any()).
-					Return(accountDTO, nil).
+					Return(verifiedSessionDTO, nil).
 					Times(1)
 			},
 		},
@@ -268,7 +1207,7 @@ func TestSession_Verify(t *testing.T) {
 			sessionUC := usecase.NewMockSessionUsecase(ctrl)
 			tt.setMockSessionUC(sessionUC)
 
-			hdl := handler.NewSessionHandler(sessionUC)
+			hdl := handler.NewSessionHandler(sessionUC, cache.NewSessionCache(time.Minute, 10), sessionCookie)
 			hdl.Verify(c)
 
 			c.Writer.WriteHeaderNow()
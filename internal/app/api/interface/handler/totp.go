@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/builder"
+	hdlerr "github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/errors"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/parameter"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/schema"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+)
+
+type TOTPHandler interface {
+	Enroll(*gin.Context)
+	Confirm(*gin.Context)
+	Disable(*gin.Context)
+}
+
+type totpHandler struct {
+	totpUC usecase.TOTPUsecase
+}
+
+func NewTOTPHandler(totpUC usecase.TOTPUsecase) TOTPHandler {
+	return &totpHandler{
+		totpUC: totpUC,
+	}
+}
+
+func (h *totpHandler) Enroll(c *gin.Context) {
+	var req schema.EnrollTOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to enroll totp"))
+		return
+	}
+
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to enroll totp"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	enrollment, err := h.totpUC.Enroll(ctx, accountID, req.Password)
+	if err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, builder.ToTOTPEnrollmentResponse(enrollment))
+}
+
+func (h *totpHandler) Confirm(c *gin.Context) {
+	var req schema.ConfirmTOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to confirm totp"))
+		return
+	}
+
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to confirm totp"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if err := h.totpUC.Confirm(ctx, accountID, req.Code); err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *totpHandler) Disable(c *gin.Context) {
+	var req schema.DisableTOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeBadRequest, "failed to disable totp"))
+		return
+	}
+
+	accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID")
+	if err != nil {
+		hdlerr.Handle(c, errors.Wrap(err, errors.CodeUnauthenticated, "failed to disable totp"))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if err := h.totpUC.Disable(ctx, accountID, req.Password); err != nil {
+		hdlerr.Handle(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,319 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/handler"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/test/mock/usecase"
+)
+
+func TestTOTP_Enroll(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	enrollmentDTO := &dto.TOTPEnrollmentDTO{
+		Secret: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ",
+		URI:    "otpauth://totp/holos:name?algorithm=SHA1&digits=6&issuer=holos&period=30&secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ",
+	}
+
+	tests := []struct {
+		name                  string
+		requestBody           []byte
+		hasAccountIDInContext bool
+		expectCode            int
+		expectResponse        []byte
+		setMockTOTPUC         func(context.Context, *usecase.MockTOTPUsecase)
+	}{
+		{
+			name:                  "successfully enrolled",
+			requestBody:           []byte(`{"password":"password"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusCreated,
+			expectResponse:        []byte(`{"secret":"GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ","uri":"otpauth://totp/holos:name?algorithm=SHA1\u0026digits=6\u0026issuer=holos\u0026period=30\u0026secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"}`),
+			setMockTOTPUC: func(ctx context.Context, totpUC *usecase.MockTOTPUsecase) {
+				totpUC.
+					EXPECT().
+					Enroll(ctx, gomock.Any(), gomock.Any()).
+					Return(enrollmentDTO, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "bad request",
+			requestBody:           nil,
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockTOTPUC:         func(context.Context, *usecase.MockTOTPUsecase) {},
+		},
+		{
+			name:                  "account id not found",
+			requestBody:           []byte(`{"password":"password"}`),
+			hasAccountIDInContext: false,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockTOTPUC:         func(context.Context, *usecase.MockTOTPUsecase) {},
+		},
+		{
+			name:                  "already enabled",
+			requestBody:           []byte(`{"password":"password"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusConflict,
+			expectResponse:        []byte(`{"error":{"code":"CONSTRAINT_VIOLATION","message":"totp is already enabled"}}`),
+			setMockTOTPUC: func(ctx context.Context, totpUC *usecase.MockTOTPUsecase) {
+				totpUC.
+					EXPECT().
+					Enroll(ctx, gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(entity.ErrTOTPAlreadyEnabled, errors.CodeConstraintViolation, "failed to enroll totp")).
+					Times(1)
+			},
+		},
+		{
+			name:                  "internal server error",
+			requestBody:           []byte(`{"password":"password"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusInternalServerError,
+			expectResponse:        []byte(`{"error":{"code":"INTERNAL_SERVER_ERROR","message":"internal server error"}}`),
+			setMockTOTPUC: func(ctx context.Context, totpUC *usecase.MockTOTPUsecase) {
+				totpUC.
+					EXPECT().
+					Enroll(ctx, gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to save totp")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/accounts/totp", bytes.NewBuffer(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			totpUC := usecase.NewMockTOTPUsecase(ctrl)
+			tt.setMockTOTPUC(ctx, totpUC)
+
+			hdl := handler.NewTOTPHandler(totpUC)
+			hdl.Enroll(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTOTP_Confirm(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                  string
+		requestBody           []byte
+		hasAccountIDInContext bool
+		expectCode            int
+		expectResponse        []byte
+		setMockTOTPUC         func(context.Context, *usecase.MockTOTPUsecase)
+	}{
+		{
+			name:                  "successfully confirmed",
+			requestBody:           []byte(`{"code":"123456"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusNoContent,
+			expectResponse:        nil,
+			setMockTOTPUC: func(ctx context.Context, totpUC *usecase.MockTOTPUsecase) {
+				totpUC.
+					EXPECT().
+					Confirm(ctx, gomock.Any(), "123456").
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "bad request",
+			requestBody:           nil,
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockTOTPUC:         func(context.Context, *usecase.MockTOTPUsecase) {},
+		},
+		{
+			name:                  "account id not found",
+			requestBody:           []byte(`{"code":"123456"}`),
+			hasAccountIDInContext: false,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockTOTPUC:         func(context.Context, *usecase.MockTOTPUsecase) {},
+		},
+		{
+			name:                  "invalid code",
+			requestBody:           []byte(`{"code":"000000"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusUnprocessableEntity,
+			expectResponse:        []byte(`{"error":{"code":"INVALID_INPUT","message":"totp code is invalid"}}`),
+			setMockTOTPUC: func(ctx context.Context, totpUC *usecase.MockTOTPUsecase) {
+				totpUC.
+					EXPECT().
+					Confirm(ctx, gomock.Any(), "000000").
+					Return(errors.Wrap(entity.ErrTOTPCodeInvalid, errors.CodeInvalidInput, "failed to enable totp")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/accounts/totp/confirm", bytes.NewBuffer(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			totpUC := usecase.NewMockTOTPUsecase(ctrl)
+			tt.setMockTOTPUC(ctx, totpUC)
+
+			hdl := handler.NewTOTPHandler(totpUC)
+			hdl.Confirm(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTOTP_Disable(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                  string
+		requestBody           []byte
+		hasAccountIDInContext bool
+		expectCode            int
+		expectResponse        []byte
+		setMockTOTPUC         func(context.Context, *usecase.MockTOTPUsecase)
+	}{
+		{
+			name:                  "successfully disabled",
+			requestBody:           []byte(`{"password":"password"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusNoContent,
+			expectResponse:        nil,
+			setMockTOTPUC: func(ctx context.Context, totpUC *usecase.MockTOTPUsecase) {
+				totpUC.
+					EXPECT().
+					Disable(ctx, gomock.Any(), "password").
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "bad request",
+			requestBody:           nil,
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusBadRequest,
+			expectResponse:        []byte(`{"error":{"code":"BAD_REQUEST","message":"bad request"}}`),
+			setMockTOTPUC:         func(context.Context, *usecase.MockTOTPUsecase) {},
+		},
+		{
+			name:                  "account id not found",
+			requestBody:           []byte(`{"password":"password"}`),
+			hasAccountIDInContext: false,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockTOTPUC:         func(context.Context, *usecase.MockTOTPUsecase) {},
+		},
+		{
+			name:                  "unauthenticated",
+			requestBody:           []byte(`{"password":"PASSWORD"}`),
+			hasAccountIDInContext: true,
+			expectCode:            http.StatusUnauthorized,
+			expectResponse:        []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+			setMockTOTPUC: func(ctx context.Context, totpUC *usecase.MockTOTPUsecase) {
+				totpUC.
+					EXPECT().
+					Disable(ctx, gomock.Any(), "PASSWORD").
+					Return(errors.Wrap(entity.ErrAccountPasswordIncorrect, errors.CodeUnauthenticated, "failed to verify account password")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "DELETE", "/accounts/totp", bytes.NewBuffer(tt.requestBody))
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.hasAccountIDInContext {
+				c.Set("accountID", uuid.New())
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			totpUC := usecase.NewMockTOTPUsecase(ctrl)
+			tt.setMockTOTPUC(ctx, totpUC)
+
+			hdl := handler.NewTOTPHandler(totpUC)
+			hdl.Disable(c)
+
+			c.Writer.WriteHeaderNow()
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package job
+
+import (
+	"context"
+	"log"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+)
+
+type BootstrapJob interface {
+	BootstrapAdmin(context.Context)
+}
+
+type bootstrapJob struct {
+	roleUC       usecase.RoleUsecase
+	adminAccount string
+}
+
+func NewBootstrapJob(roleUC usecase.RoleUsecase, adminAccount string) BootstrapJob {
+	return &bootstrapJob{
+		roleUC:       roleUC,
+		adminAccount: adminAccount,
+	}
+}
+
+func (j *bootstrapJob) BootstrapAdmin(ctx context.Context) {
+	if err := j.roleUC.BootstrapAdmin(ctx, j.adminAccount); err != nil {
+		log.Println(err.Error())
+	}
+}
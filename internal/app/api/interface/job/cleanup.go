@@ -0,0 +1,42 @@
+package job
+
+import (
+	"context"
+	"log"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+)
+
+type CleanupJob interface {
+	PurgeExpiredSessions(context.Context)
+	PurgeDeletedAccounts(context.Context)
+	PurgeLoginFailures(context.Context)
+}
+
+type cleanupJob struct {
+	cleanupUC usecase.CleanupUsecase
+}
+
+func NewCleanupJob(cleanupUC usecase.CleanupUsecase) CleanupJob {
+	return &cleanupJob{
+		cleanupUC: cleanupUC,
+	}
+}
+
+func (j *cleanupJob) PurgeExpiredSessions(ctx context.Context) {
+	if err := j.cleanupUC.PurgeExpiredSessions(ctx); err != nil {
+		log.Println(err.Error())
+	}
+}
+
+func (j *cleanupJob) PurgeDeletedAccounts(ctx context.Context) {
+	if err := j.cleanupUC.PurgeDeletedAccounts(ctx); err != nil {
+		log.Println(err.Error())
+	}
+}
+
+func (j *cleanupJob) PurgeLoginFailures(ctx context.Context) {
+	if err := j.cleanupUC.PurgeLoginFailures(ctx); err != nil {
+		log.Println(err.Error())
+	}
+}
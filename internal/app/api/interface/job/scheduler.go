@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type Scheduler interface {
+	Register(time.Duration, func(context.Context))
+	Start(context.Context)
+	Wait()
+}
+
+type entry struct {
+	interval time.Duration
+	fn       func(context.Context)
+}
+
+type scheduler struct {
+	entries []entry
+	wg      sync.WaitGroup
+}
+
+func NewScheduler() Scheduler {
+	return &scheduler{}
+}
+
+func (s *scheduler) Register(interval time.Duration, fn func(context.Context)) {
+	s.entries = append(s.entries, entry{interval: interval, fn: fn})
+}
+
+func (s *scheduler) Start(ctx context.Context) {
+	for _, e := range s.entries {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.run(ctx, e)
+		}()
+	}
+}
+
+func (s *scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *scheduler) run(ctx context.Context, e entry) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.fn(ctx)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package job_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/job"
+)
+
+func TestScheduler_Start(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputInterval time.Duration
+		waitDuration  time.Duration
+		expectRun     bool
+	}{
+		{name: "run registered job", inputInterval: time.Millisecond, waitDuration: time.Millisecond * 50, expectRun: true},
+		{name: "stop before interval", inputInterval: time.Hour, waitDuration: 0, expectRun: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var count atomic.Int64
+
+			s := job.NewScheduler()
+			s.Register(tt.inputInterval, func(context.Context) {
+				count.Add(1)
+			})
+
+			ctx, cancel := context.WithCancel(t.Context())
+			s.Start(ctx)
+
+			time.Sleep(tt.waitDuration)
+			cancel()
+			s.Wait()
+
+			if ran := count.Load() > 0; ran != tt.expectRun {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectRun, ran)
+			}
+
+			after := count.Load()
+			time.Sleep(time.Millisecond * 10)
+			if count.Load() != after {
+				t.Error("job is running after stop")
+			}
+		})
+	}
+}
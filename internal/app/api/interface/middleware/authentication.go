@@ -2,18 +2,23 @@ package middleware
 
 import (
 	stderr "errors"
+	"net/http"
 	"strings"
 
 	"github.com/atsumarukun/holos-api-pkg/errors"
 	"github.com/gin-gonic/gin"
 
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cache"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cookie"
 	hdlerr "github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/errors"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
 )
 
 var (
-	ErrInvalidToken    = stderr.New("invalid token")
-	ErrAccountNotFound = stderr.New("account not found")
+	ErrInvalidToken     = stderr.New("invalid token")
+	ErrInvalidCSRFToken = stderr.New("invalid csrf token")
+	ErrAccountNotFound  = stderr.New("account not found")
 )
 
 type AuthenticationMiddleware interface {
@@ -21,39 +26,84 @@ type AuthenticationMiddleware interface {
 }
 
 type authenticationMiddleware struct {
-	sessionUC usecase.SessionUsecase
+	sessionUC     usecase.SessionUsecase
+	sessionCache  cache.SessionCache
+	sessionCookie cookie.SessionCookie
 }
 
-func NewAuthenticationMiddleware(sessionUC usecase.SessionUsecase) AuthenticationMiddleware {
+func NewAuthenticationMiddleware(sessionUC usecase.SessionUsecase, sessionCache cache.SessionCache, sessionCookie cookie.SessionCookie) AuthenticationMiddleware {
 	return &authenticationMiddleware{
-		sessionUC: sessionUC,
+		sessionUC:     sessionUC,
+		sessionCache:  sessionCache,
+		sessionCookie: sessionCookie,
 	}
 }
 
 func (m *authenticationMiddleware) Authenticate(c *gin.Context) {
-	sessionToken := strings.Split(c.Request.Header.Get("Authorization"), " ")
-	if len(sessionToken) != 2 || sessionToken[0] != "Session" {
-		err := errors.Wrap(ErrInvalidToken, errors.CodeUnauthenticated, "failed to authenticate")
+	token, fromCookie, err := m.extractToken(c)
+	if err != nil {
 		hdlerr.Handle(c, err)
 		c.Abort()
 		return
 	}
 
-	ctx := c.Request.Context()
-
-	account, err := m.sessionUC.Verify(ctx, sessionToken[1])
+	session, err := m.verify(c, token)
 	if err != nil {
 		hdlerr.Handle(c, err)
 		c.Abort()
 		return
 	}
-	if account == nil {
+	if session == nil {
 		err := errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, "failed to authenticate")
 		hdlerr.Handle(c, err)
 		c.Abort()
 		return
 	}
 
-	c.Set("accountID", account.ID)
+	c.Set("accountID", session.Account.ID)
+	c.Set("sessionID", session.Session.ID)
+	c.Set("permissions", session.Account.Permissions)
+	c.Set("cookieAuthenticated", fromCookie)
 	c.Next()
 }
+
+// NOTE: Authorizationヘッダを優先し, 存在しない場合はCookieのセッショントークンを使用する.
+// Cookieはブラウザから自動で送信されるため, 状態を変更するリクエストではCSRFトークンを検証する.
+func (m *authenticationMiddleware) extractToken(c *gin.Context) (string, bool, error) {
+	if header := c.GetHeader("Authorization"); header != "" {
+		sessionToken := strings.Split(header, " ")
+		if len(sessionToken) != 2 || sessionToken[0] != "Session" {
+			return "", false, errors.Wrap(ErrInvalidToken, errors.CodeUnauthenticated, "failed to authenticate")
+		}
+		return sessionToken[1], false, nil
+	}
+
+	token, ok := m.sessionCookie.GetToken(c)
+	if !ok {
+		return "", false, errors.Wrap(ErrInvalidToken, errors.CodeUnauthenticated, "failed to authenticate")
+	}
+	if !isSafeMethod(c.Request.Method) && !m.sessionCookie.VerifyCSRFToken(c, token) {
+		return "", false, errors.Wrap(ErrInvalidCSRFToken, errors.CodeUnauthorized, "failed to authenticate")
+	}
+
+	return token, true, nil
+}
+
+// NOTE: キャッシュが有効な間は最終利用日時が更新されないため, キャッシュの有効期限はアイドルタイムアウトより十分短く設定する.
+func (m *authenticationMiddleware) verify(c *gin.Context, token string) (*dto.VerifiedSessionDTO, error) {
+	if session, ok := m.sessionCache.Get(token); ok {
+		return session, nil
+	}
+
+	session, err := m.sessionUC.Verify(c.Request.Context(), token)
+	if err != nil {
+		return nil, err
+	}
+
+	m.sessionCache.Set(token, session)
+	return session, nil
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
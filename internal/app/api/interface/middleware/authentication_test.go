@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/atsumarukun/holos-api-pkg/errors"
 	"github.com/gin-gonic/gin"
@@ -13,6 +14,8 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/middleware"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cache"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cookie"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
 	"github.com/atsumarukun/holos-account-api/test/mock/usecase"
 )
@@ -20,15 +23,42 @@ import (
 func TestAuthentication_Authenticate(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
-	accountDTO := &dto.AccountDTO{
-		ID:       uuid.New(),
-		Name:     "name",
-		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	verifiedSessionDTO := &dto.VerifiedSessionDTO{
+		Session: &dto.SessionDTO{
+			ID:        uuid.New(),
+			Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+			UserAgent: "Mozilla/5.0",
+			IPAddress: "192.0.2.1",
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 7),
+			CreatedAt: time.Now(),
+		},
+		Account: &dto.AccountDTO{
+			ID:          uuid.New(),
+			Name:        "name",
+			Roles:       []string{"admin"},
+			Permissions: []string{"accounts:read", "accounts:write"},
+		},
+	}
+	verifiedSessionDTO.Session.AccountID = verifiedSessionDTO.Account.ID
+
+	sessionCookie := cookie.NewSessionCookie("", true, http.SameSiteLaxMode, []byte("secret"))
+	cookieCtx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	csrfToken := sessionCookie.Set(cookieCtx, verifiedSessionDTO.Session)
+	verify := func(sessionUC *usecase.MockSessionUsecase) {
+		sessionUC.
+			EXPECT().
+			Verify(gomock.Any(), gomock.Any()).
+			Return(verifiedSessionDTO, nil).
+			Times(1)
 	}
 
 	tests := []struct {
 		name                string
+		method              string
 		authorizationHeader string
+		sessionCookie       string
+		csrfHeader          string
+		cachedSession       *dto.VerifiedSessionDTO
 		expectResult        uuid.UUID
 		expectError         []byte
 		setMockSessionUC    func(*usecase.MockSessionUsecase)
@@ -36,16 +66,58 @@ func TestAuthentication_Authenticate(t *testing.T) {
 		{
 			name:                "session token is set",
 			authorizationHeader: "Session 1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
-			expectResult:        accountDTO.ID,
+			expectResult:        verifiedSessionDTO.Account.ID,
 			expectError:         nil,
 			setMockSessionUC: func(sessionUC *usecase.MockSessionUsecase) {
 				sessionUC.
 					EXPECT().
 					Verify(gomock.Any(), gomock.Any()).
-					Return(accountDTO, nil).
+					Return(verifiedSessionDTO, nil).
 					Times(1)
 			},
 		},
+		{
+			name:                "session is cached",
+			authorizationHeader: "Session 1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+			cachedSession:       verifiedSessionDTO,
+			expectResult:        verifiedSessionDTO.Account.ID,
+			expectError:         nil,
+			setMockSessionUC:    func(*usecase.MockSessionUsecase) {},
+		},
+		{
+			name:             "session cookie is set",
+			method:           "GET",
+			sessionCookie:    "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+			expectResult:     verifiedSessionDTO.Account.ID,
+			expectError:      nil,
+			setMockSessionUC: verify,
+		},
+		{
+			name:             "session cookie with csrf token",
+			method:           "DELETE",
+			sessionCookie:    "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+			csrfHeader:       csrfToken,
+			expectResult:     verifiedSessionDTO.Account.ID,
+			expectError:      nil,
+			setMockSessionUC: verify,
+		},
+		{
+			name:             "session cookie without csrf token",
+			method:           "DELETE",
+			sessionCookie:    "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+			expectResult:     uuid.Nil,
+			expectError:      []byte(`{"error":{"code":"UNAUTHORIZED","message":"unauthorized"}}`),
+			setMockSessionUC: func(*usecase.MockSessionUsecase) {},
+		},
+		{
+			name:             "session cookie with invalid csrf token",
+			method:           "DELETE",
+			sessionCookie:    "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+			csrfHeader:       "invalid",
+			expectResult:     uuid.Nil,
+			expectError:      []byte(`{"error":{"code":"UNAUTHORIZED","message":"unauthorized"}}`),
+			setMockSessionUC: func(*usecase.MockSessionUsecase) {},
+		},
 		{
 			name:                "session token not set",
 			authorizationHeader: "",
@@ -81,11 +153,21 @@ func TestAuthentication_Authenticate(t *testing.T) {
 
 			c, _ := gin.CreateTestContext(w)
 			var err error
-			c.Request, err = http.NewRequestWithContext(ctx, "DELETE", "/session", http.NoBody)
+			method := tt.method
+			if method == "" {
+				method = "DELETE"
+			}
+			c.Request, err = http.NewRequestWithContext(ctx, method, "/session", http.NoBody)
 			if err != nil {
 				t.Error(err)
 			}
 			c.Request.Header.Add("Authorization", tt.authorizationHeader)
+			if tt.sessionCookie != "" {
+				c.Request.AddCookie(&http.Cookie{Name: cookie.SessionTokenName, Value: tt.sessionCookie})
+			}
+			if tt.csrfHeader != "" {
+				c.Request.Header.Add(cookie.CSRFHeaderName, tt.csrfHeader)
+			}
 
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
@@ -93,7 +175,12 @@ func TestAuthentication_Authenticate(t *testing.T) {
 			sessionUC := usecase.NewMockSessionUsecase(ctrl)
 			tt.setMockSessionUC(sessionUC)
 
-			mw := middleware.NewAuthenticationMiddleware(sessionUC)
+			sessionCache := cache.NewSessionCache(time.Minute, 10)
+			if tt.cachedSession != nil {
+				sessionCache.Set("1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS", tt.cachedSession)
+			}
+
+			mw := middleware.NewAuthenticationMiddleware(sessionUC, sessionCache, sessionCookie)
 			mw.Authenticate(c)
 
 			accountID, _ := c.Get("accountID")
@@ -102,6 +189,12 @@ func TestAuthentication_Authenticate(t *testing.T) {
 				t.Error(diff)
 			}
 
+			if tt.expectError == nil {
+				if diff := cmp.Diff(verifiedSessionDTO.Account.Permissions, c.GetStringSlice("permissions")); diff != "" {
+					t.Error(diff)
+				}
+			}
+
 			if diff := cmp.Diff(tt.expectError, w.Body.Bytes()); diff != "" {
 				t.Error(diff)
 			}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	stderr "errors"
+	"slices"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	hdlerr "github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/errors"
+)
+
+var ErrPermissionDenied = stderr.New("permission denied")
+
+type AuthorizationMiddleware interface {
+	Authorize(entity.Permission) gin.HandlerFunc
+}
+
+type authorizationMiddleware struct{}
+
+func NewAuthorizationMiddleware() AuthorizationMiddleware {
+	return &authorizationMiddleware{}
+}
+
+// NOTE: 認証ミドルウェアが設定した権限を参照するため, 認証ミドルウェアの後に設定する.
+// 権限はセッションと共にキャッシュされるため, ロールの変更はキャッシュの有効期限が切れるまで反映されない.
+func (m *authorizationMiddleware) Authorize(permission entity.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !slices.Contains(c.GetStringSlice("permissions"), string(permission)) {
+			hdlerr.Handle(c, errors.Wrap(ErrPermissionDenied, errors.CodeUnauthorized, "failed to authorize"))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,82 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/middleware"
+)
+
+func TestAuthorization_Authorize(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name             string
+		permissions      []string
+		inputPermission  entity.Permission
+		expectAborted    bool
+		expectStatusCode int
+		expectError      []byte
+	}{
+		{
+			name:             "successfully authorized",
+			permissions:      []string{"accounts:read", "accounts:write"},
+			inputPermission:  entity.PermissionAccountsWrite,
+			expectAborted:    false,
+			expectStatusCode: http.StatusOK,
+			expectError:      nil,
+		},
+		{
+			name:             "permission not granted",
+			permissions:      []string{"accounts:read"},
+			inputPermission:  entity.PermissionAccountsWrite,
+			expectAborted:    true,
+			expectStatusCode: http.StatusForbidden,
+			expectError:      []byte(`{"error":{"code":"UNAUTHORIZED","message":"unauthorized"}}`),
+		},
+		{
+			name:             "permissions not set",
+			permissions:      nil,
+			inputPermission:  entity.PermissionAccountsRead,
+			expectAborted:    true,
+			expectStatusCode: http.StatusForbidden,
+			expectError:      []byte(`{"error":{"code":"UNAUTHORIZED","message":"unauthorized"}}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "GET", "/admin/accounts", http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.permissions != nil {
+				c.Set("permissions", tt.permissions)
+			}
+
+			mw := middleware.NewAuthorizationMiddleware()
+			mw.Authorize(tt.inputPermission)(c)
+
+			if c.IsAborted() != tt.expectAborted {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectAborted, c.IsAborted())
+			}
+
+			if w.Code != tt.expectStatusCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectStatusCode, w.Code)
+			}
+
+			if diff := cmp.Diff(tt.expectError, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	stderr "errors"
+	"log/slog"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	hdlerr "github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/errors"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/parameter"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/ratelimit"
+)
+
+var ErrRateLimitExceeded = stderr.New("rate limit exceeded")
+
+type RateLimitMiddleware interface {
+	Limit(*gin.Context)
+}
+
+type rateLimitMiddleware struct {
+	store ratelimit.Store
+	name  string
+	rate  ratelimit.Rate
+}
+
+// NOTE: nameはルートグループ毎にバケットを分けるためのキーとし, rateのLimitが0以下の場合は制限しない.
+func NewRateLimitMiddleware(store ratelimit.Store, name string, rate ratelimit.Rate) RateLimitMiddleware {
+	return &rateLimitMiddleware{
+		store: store,
+		name:  name,
+		rate:  rate,
+	}
+}
+
+// NOTE: 認証ミドルウェアの後に設定した場合はアカウントID毎, それ以外は送信元IPアドレス毎に制限する.
+// ストアの障害でサービス全体が停止しないよう, ストアのエラーは記録した上で制限せずに通過させる.
+func (m *rateLimitMiddleware) Limit(c *gin.Context) {
+	if m.rate.Limit <= 0 {
+		c.Next()
+		return
+	}
+
+	result, err := m.store.Take(c.Request.Context(), m.key(c), m.rate)
+	if err != nil {
+		slog.ErrorContext(c.Request.Context(), err.Error())
+		c.Next()
+		return
+	}
+
+	c.Header("RateLimit-Limit", strconv.Itoa(m.rate.Limit))
+	c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	c.Header("RateLimit-Reset", seconds(result.ResetAfter))
+	c.Header("RateLimit-Policy", strconv.Itoa(m.rate.Limit)+";w="+seconds(m.rate.Period))
+
+	if !result.Allowed {
+		c.Header("Retry-After", seconds(result.RetryAfter))
+		hdlerr.Handle(c, errors.Wrap(ErrRateLimitExceeded, hdlerr.CodeTooManyRequests, "failed to limit rate"))
+		c.Abort()
+		return
+	}
+
+	c.Next()
+}
+
+func (m *rateLimitMiddleware) key(c *gin.Context) string {
+	if accountID, err := parameter.GetContextParameter[uuid.UUID](c, "accountID"); err == nil {
+		return m.name + ":account:" + accountID.String()
+	}
+	return m.name + ":ip:" + c.ClientIP()
+}
+
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
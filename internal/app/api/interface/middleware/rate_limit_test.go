@@ -0,0 +1,138 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/middleware"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/ratelimit"
+)
+
+func TestRateLimit_Limit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	rate := ratelimit.Rate{Limit: 2, Period: time.Minute}
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+	mr.Close()
+
+	tests := []struct {
+		name            string
+		store           ratelimit.Store
+		rate            ratelimit.Rate
+		accountID       uuid.UUID
+		requests        int
+		expectCode      int
+		expectHeaders   map[string]string
+		expectResponse  []byte
+		expectNextCalls int
+	}{
+		{
+			name:            "allowed",
+			store:           ratelimit.NewMemoryStore(),
+			rate:            rate,
+			requests:        1,
+			expectCode:      http.StatusOK,
+			expectHeaders:   map[string]string{"RateLimit-Limit": "2", "RateLimit-Remaining": "1", "RateLimit-Reset": "30", "RateLimit-Policy": "2;w=60", "Retry-After": ""},
+			expectResponse:  nil,
+			expectNextCalls: 1,
+		},
+		{
+			name:            "allowed by account",
+			store:           ratelimit.NewMemoryStore(),
+			rate:            rate,
+			accountID:       uuid.New(),
+			requests:        1,
+			expectCode:      http.StatusOK,
+			expectHeaders:   map[string]string{"RateLimit-Limit": "2", "RateLimit-Remaining": "1", "RateLimit-Reset": "30", "RateLimit-Policy": "2;w=60", "Retry-After": ""},
+			expectResponse:  nil,
+			expectNextCalls: 1,
+		},
+		{
+			name:            "exceeded",
+			store:           ratelimit.NewMemoryStore(),
+			rate:            rate,
+			requests:        3,
+			expectCode:      http.StatusTooManyRequests,
+			expectHeaders:   map[string]string{"RateLimit-Limit": "2", "RateLimit-Remaining": "0", "RateLimit-Reset": "60", "RateLimit-Policy": "2;w=60", "Retry-After": "30"},
+			expectResponse:  []byte(`{"error":{"code":"TOO_MANY_REQUESTS","message":"too many requests"}}`),
+			expectNextCalls: 2,
+		},
+		{
+			name:            "disabled",
+			store:           ratelimit.NewMemoryStore(),
+			rate:            ratelimit.Rate{},
+			requests:        3,
+			expectCode:      http.StatusOK,
+			expectHeaders:   map[string]string{"RateLimit-Limit": "", "RateLimit-Remaining": "", "RateLimit-Reset": "", "RateLimit-Policy": "", "Retry-After": ""},
+			expectResponse:  nil,
+			expectNextCalls: 3,
+		},
+		{
+			name:            "store error",
+			store:           ratelimit.NewRedisStore(client, "test:"),
+			rate:            rate,
+			requests:        1,
+			expectCode:      http.StatusOK,
+			expectHeaders:   map[string]string{"RateLimit-Limit": "", "RateLimit-Remaining": "", "RateLimit-Reset": "", "RateLimit-Policy": "", "Retry-After": ""},
+			expectResponse:  nil,
+			expectNextCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var w *httptest.ResponseRecorder
+			var nextCalls int
+
+			mw := middleware.NewRateLimitMiddleware(tt.store, "sessions", tt.rate)
+
+			for range tt.requests {
+				w = httptest.NewRecorder()
+				c, r := gin.CreateTestContext(w)
+				r.POST("/sessions", func(c *gin.Context) {
+					if tt.accountID != uuid.Nil {
+						c.Set("accountID", tt.accountID)
+					}
+					c.Next()
+				}, mw.Limit, func(*gin.Context) {
+					nextCalls++
+				})
+
+				var err error
+				c.Request, err = http.NewRequestWithContext(t.Context(), "POST", "/sessions", http.NoBody)
+				if err != nil {
+					t.Error(err)
+				}
+				r.HandleContext(c)
+			}
+
+			if w.Code != tt.expectCode {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCode, w.Code)
+			}
+
+			for key, expect := range tt.expectHeaders {
+				if got := w.Header().Get(key); got != expect {
+					t.Errorf("%s\nexpect: %v\ngot: %v", key, expect, got)
+				}
+			}
+
+			if diff := cmp.Diff(tt.expectResponse, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+
+			if nextCalls != tt.expectNextCalls {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectNextCalls, nextCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/gin-gonic/gin"
+
+	hdlerr "github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/errors"
+)
+
+var ErrInvalidClientCredential = stderr.New("invalid client credential")
+
+type ServiceAuthenticationMiddleware interface {
+	Authenticate(*gin.Context)
+}
+
+type serviceAuthenticationMiddleware struct {
+	clients map[string][32]byte
+}
+
+func NewServiceAuthenticationMiddleware(clients map[string]string) ServiceAuthenticationMiddleware {
+	hashed := make(map[string][32]byte, len(clients))
+	for id, secret := range clients {
+		hashed[id] = sha256.Sum256([]byte(secret))
+	}
+
+	return &serviceAuthenticationMiddleware{
+		clients: hashed,
+	}
+}
+
+// NOTE: シークレットの長さによる比較時間の差を生じさせないよう, ハッシュ化した値同士を比較する.
+func (m *serviceAuthenticationMiddleware) Authenticate(c *gin.Context) {
+	id, secret, ok := c.Request.BasicAuth()
+	if !ok || !m.verify(id, secret) {
+		c.Header("WWW-Authenticate", `Basic realm="holos-account-api"`)
+		hdlerr.Handle(c, errors.Wrap(ErrInvalidClientCredential, errors.CodeUnauthenticated, "failed to authenticate client"))
+		c.Abort()
+		return
+	}
+
+	c.Set("clientID", id)
+	c.Next()
+}
+
+func (m *serviceAuthenticationMiddleware) verify(id, secret string) bool {
+	expect, ok := m.clients[id]
+	if !ok {
+		return false
+	}
+
+	got := sha256.Sum256([]byte(secret))
+	return subtle.ConstantTimeCompare(expect[:], got[:]) == 1
+}
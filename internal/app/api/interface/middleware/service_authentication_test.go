@@ -0,0 +1,85 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/middleware"
+)
+
+func TestServiceAuthentication_Authenticate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		hasBasicAuth bool
+		clientID     string
+		clientSecret string
+		expectResult string
+		expectError  []byte
+	}{
+		{
+			name:         "client credential is valid",
+			hasBasicAuth: true,
+			clientID:     "gateway",
+			clientSecret: "secret",
+			expectResult: "gateway",
+			expectError:  nil,
+		},
+		{
+			name:         "client credential not set",
+			hasBasicAuth: false,
+			expectResult: "",
+			expectError:  []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+		},
+		{
+			name:         "unknown client",
+			hasBasicAuth: true,
+			clientID:     "unknown",
+			clientSecret: "secret",
+			expectResult: "",
+			expectError:  []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+		},
+		{
+			name:         "incorrect secret",
+			hasBasicAuth: true,
+			clientID:     "gateway",
+			clientSecret: "SECRET",
+			expectResult: "",
+			expectError:  []byte(`{"error":{"code":"UNAUTHENTICATED","message":"unauthenticated"}}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			w := httptest.NewRecorder()
+
+			c, _ := gin.CreateTestContext(w)
+			var err error
+			c.Request, err = http.NewRequestWithContext(ctx, "POST", "/sessions/introspect", http.NoBody)
+			if err != nil {
+				t.Error(err)
+			}
+			if tt.hasBasicAuth {
+				c.Request.SetBasicAuth(tt.clientID, tt.clientSecret)
+			}
+
+			mw := middleware.NewServiceAuthenticationMiddleware(map[string]string{"gateway": "secret"})
+			mw.Authenticate(c)
+
+			clientID, _ := c.Get("clientID")
+			result, _ := clientID.(string)
+			if diff := cmp.Diff(result, tt.expectResult); diff != "" {
+				t.Error(diff)
+			}
+
+			if diff := cmp.Diff(tt.expectError, w.Body.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+type SessionCache interface {
+	Get(token string) (*dto.VerifiedSessionDTO, bool)
+	Set(token string, session *dto.VerifiedSessionDTO)
+	DeleteBySessionID(id uuid.UUID)
+	DeleteByAccountID(accountID uuid.UUID)
+}
+
+type sessionCacheEntry struct {
+	token      string
+	session    *dto.VerifiedSessionDTO
+	expiresAt  time.Time
+	prev, next *sessionCacheEntry
+}
+
+// NOTE: 上限を超えた際は最も長く参照されていないエントリから破棄する.
+type sessionCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	size       int
+	entries    map[string]*sessionCacheEntry
+	head, tail *sessionCacheEntry
+}
+
+func NewSessionCache(ttl time.Duration, size int) SessionCache {
+	return &sessionCache{
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[string]*sessionCacheEntry),
+	}
+}
+
+func (c *sessionCache) Get(token string) (*dto.VerifiedSessionDTO, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[token]
+	if !ok {
+		return nil, false
+	}
+	if !time.Now().Before(entry.expiresAt) {
+		c.remove(entry)
+		return nil, false
+	}
+
+	c.unlink(entry)
+	c.pushFront(entry)
+	return entry.session, true
+}
+
+func (c *sessionCache) Set(token string, session *dto.VerifiedSessionDTO) {
+	if session == nil || session.Session == nil || session.Account == nil || c.size <= 0 {
+		return
+	}
+
+	// NOTE: トークンの有効期限を超えてキャッシュが有効にならないよう, 有効期限はトークンの有効期限で切り詰める.
+	expiresAt := time.Now().Add(c.ttl)
+	if session.Session.ExpiresAt.Before(expiresAt) {
+		expiresAt = session.Session.ExpiresAt
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[token]; ok {
+		c.remove(entry)
+	}
+
+	entry := &sessionCacheEntry{
+		token:     token,
+		session:   session,
+		expiresAt: expiresAt,
+	}
+	c.entries[token] = entry
+	c.pushFront(entry)
+
+	for len(c.entries) > c.size {
+		c.remove(c.tail)
+	}
+}
+
+func (c *sessionCache) DeleteBySessionID(id uuid.UUID) {
+	c.deleteFunc(func(session *dto.VerifiedSessionDTO) bool {
+		return session.Session.ID == id
+	})
+}
+
+func (c *sessionCache) DeleteByAccountID(accountID uuid.UUID) {
+	c.deleteFunc(func(session *dto.VerifiedSessionDTO) bool {
+		return session.Account.ID == accountID
+	})
+}
+
+// NOTE: 失効処理は認証に比べて頻度が低く, エントリ数も上限で抑えられるため, 索引は持たずに全件走査する.
+func (c *sessionCache) deleteFunc(match func(*dto.VerifiedSessionDTO) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for entry := c.head; entry != nil; {
+		next := entry.next
+		if match(entry.session) {
+			c.remove(entry)
+		}
+		entry = next
+	}
+}
+
+func (c *sessionCache) remove(entry *sessionCacheEntry) {
+	c.unlink(entry)
+	delete(c.entries, entry.token)
+}
+
+func (c *sessionCache) pushFront(entry *sessionCacheEntry) {
+	entry.next = c.head
+	if c.head != nil {
+		c.head.prev = entry
+	}
+	c.head = entry
+	if c.tail == nil {
+		c.tail = entry
+	}
+}
+
+func (c *sessionCache) unlink(entry *sessionCacheEntry) {
+	if entry.prev != nil {
+		entry.prev.next = entry.next
+	} else {
+		c.head = entry.next
+	}
+	if entry.next != nil {
+		entry.next.prev = entry.prev
+	} else {
+		c.tail = entry.prev
+	}
+	entry.prev = nil
+	entry.next = nil
+}
@@ -0,0 +1,110 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/cache"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+func newVerifiedSessionDTO(accountID uuid.UUID, expiresAt time.Time) *dto.VerifiedSessionDTO {
+	return &dto.VerifiedSessionDTO{
+		Session: &dto.SessionDTO{
+			ID:        uuid.New(),
+			AccountID: accountID,
+			ExpiresAt: expiresAt,
+		},
+		Account: &dto.AccountDTO{
+			ID: accountID,
+		},
+	}
+}
+
+func TestSessionCache_Get(t *testing.T) {
+	tests := []struct {
+		name         string
+		ttl          time.Duration
+		expiresAt    time.Time
+		expectCached bool
+	}{
+		{
+			name:         "cached",
+			ttl:          time.Minute,
+			expiresAt:    time.Now().Add(time.Hour),
+			expectCached: true,
+		},
+		{
+			name:         "ttl expired",
+			ttl:          time.Nanosecond,
+			expiresAt:    time.Now().Add(time.Hour),
+			expectCached: false,
+		},
+		{
+			name:         "token expired",
+			ttl:          time.Minute,
+			expiresAt:    time.Now().Add(-time.Second),
+			expectCached: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := cache.NewSessionCache(tt.ttl, 10)
+			c.Set("token", newVerifiedSessionDTO(uuid.New(), tt.expiresAt))
+			time.Sleep(time.Millisecond)
+
+			if _, ok := c.Get("token"); ok != tt.expectCached {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCached, ok)
+			}
+		})
+	}
+}
+
+func TestSessionCache_Set(t *testing.T) {
+	c := cache.NewSessionCache(time.Minute, 2)
+	c.Set("token1", newVerifiedSessionDTO(uuid.New(), time.Now().Add(time.Hour)))
+	c.Set("token2", newVerifiedSessionDTO(uuid.New(), time.Now().Add(time.Hour)))
+	c.Get("token1")
+	c.Set("token3", newVerifiedSessionDTO(uuid.New(), time.Now().Add(time.Hour)))
+
+	for token, expect := range map[string]bool{"token1": true, "token2": false, "token3": true} {
+		if _, ok := c.Get(token); ok != expect {
+			t.Errorf("%s\nexpect: %v\ngot: %v", token, expect, ok)
+		}
+	}
+}
+
+func TestSessionCache_DeleteBySessionID(t *testing.T) {
+	accountID := uuid.New()
+	session1 := newVerifiedSessionDTO(accountID, time.Now().Add(time.Hour))
+	session2 := newVerifiedSessionDTO(accountID, time.Now().Add(time.Hour))
+
+	c := cache.NewSessionCache(time.Minute, 10)
+	c.Set("token1", session1)
+	c.Set("token2", session2)
+	c.DeleteBySessionID(session1.Session.ID)
+
+	for token, expect := range map[string]bool{"token1": false, "token2": true} {
+		if _, ok := c.Get(token); ok != expect {
+			t.Errorf("%s\nexpect: %v\ngot: %v", token, expect, ok)
+		}
+	}
+}
+
+func TestSessionCache_DeleteByAccountID(t *testing.T) {
+	accountID := uuid.New()
+
+	c := cache.NewSessionCache(time.Minute, 10)
+	c.Set("token1", newVerifiedSessionDTO(accountID, time.Now().Add(time.Hour)))
+	c.Set("token2", newVerifiedSessionDTO(accountID, time.Now().Add(time.Hour)))
+	c.Set("token3", newVerifiedSessionDTO(uuid.New(), time.Now().Add(time.Hour)))
+	c.DeleteByAccountID(accountID)
+
+	for token, expect := range map[string]bool{"token1": false, "token2": false, "token3": true} {
+		if _, ok := c.Get(token); ok != expect {
+			t.Errorf("%s\nexpect: %v\ngot: %v", token, expect, ok)
+		}
+	}
+}
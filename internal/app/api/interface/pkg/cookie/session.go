@@ -0,0 +1,118 @@
+package cookie
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+const (
+	SessionTokenName = "holos_session"
+	RefreshTokenName = "holos_refresh_token"
+	CSRFTokenName    = "holos_csrf_token"
+	CSRFHeaderName   = "X-CSRF-Token"
+
+	refreshTokenPath = "/sessions/refresh"
+
+	csrfKeyLabel = "csrf"
+)
+
+type SessionCookie interface {
+	Set(*gin.Context, *dto.SessionDTO) string
+	Clear(*gin.Context)
+	GetToken(*gin.Context) (string, bool)
+	GetRefreshToken(*gin.Context) (string, bool)
+	VerifyCSRFToken(*gin.Context, string) bool
+}
+
+type sessionCookie struct {
+	domain   string
+	secure   bool
+	sameSite http.SameSite
+	csrfKey  []byte
+}
+
+// NOTE: DBに保存するセッショントークンのハッシュ値とCSRFトークンが一致しないよう, CSRFトークンには秘密鍵から導出した専用の鍵を用いる.
+func NewSessionCookie(domain string, secure bool, sameSite http.SameSite, secret []byte) SessionCookie {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(csrfKeyLabel))
+
+	return &sessionCookie{
+		domain:   domain,
+		secure:   secure,
+		sameSite: sameSite,
+		csrfKey:  mac.Sum(nil),
+	}
+}
+
+func (sc *sessionCookie) Set(c *gin.Context, session *dto.SessionDTO) string {
+	csrfToken := sc.csrfToken(session.Token)
+
+	sc.set(c, SessionTokenName, session.Token, "/", session.ExpiresAt, true)
+	sc.set(c, RefreshTokenName, session.RefreshToken, refreshTokenPath, session.RefreshExpiresAt, true)
+	sc.set(c, CSRFTokenName, csrfToken, "/", session.RefreshExpiresAt, false)
+
+	return csrfToken
+}
+
+func (sc *sessionCookie) Clear(c *gin.Context) {
+	sc.set(c, SessionTokenName, "", "/", time.Unix(0, 0), true)
+	sc.set(c, RefreshTokenName, "", refreshTokenPath, time.Unix(0, 0), true)
+	sc.set(c, CSRFTokenName, "", "/", time.Unix(0, 0), false)
+}
+
+func (sc *sessionCookie) GetToken(c *gin.Context) (string, bool) {
+	return get(c, SessionTokenName)
+}
+
+func (sc *sessionCookie) GetRefreshToken(c *gin.Context) (string, bool) {
+	return get(c, RefreshTokenName)
+}
+
+// NOTE: CSRFトークンはセッショントークンのHMACとし, ヘッダの値がCookieのセッショントークンから算出した値と一致するかで検証する.
+// Cookieの値同士の比較ではないため, サブドメイン等からCSRFトークンのCookieを上書きされても検証を通過できない.
+func (sc *sessionCookie) VerifyCSRFToken(c *gin.Context, token string) bool {
+	csrfToken := c.GetHeader(CSRFHeaderName)
+	if csrfToken == "" {
+		return false
+	}
+	return hmac.Equal([]byte(csrfToken), []byte(sc.csrfToken(token)))
+}
+
+func (sc *sessionCookie) csrfToken(token string) string {
+	mac := hmac.New(sha256.New, sc.csrfKey)
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (sc *sessionCookie) set(c *gin.Context, name, value, path string, expiresAt time.Time, httpOnly bool) {
+	maxAge := int(time.Until(expiresAt).Seconds())
+	if maxAge <= 0 {
+		maxAge = -1
+	}
+
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   sc.domain,
+		MaxAge:   maxAge,
+		Secure:   sc.secure,
+		HttpOnly: httpOnly,
+		SameSite: sc.sameSite,
+	})
+}
+
+func get(c *gin.Context, name string) (string, bool) {
+	value, err := c.Cookie(name)
+	if err != nil || value == "" {
+		return "", false
+	}
+	return value, true
+}
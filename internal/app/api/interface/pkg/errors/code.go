@@ -6,6 +6,11 @@ import (
 	"github.com/atsumarukun/holos-api-pkg/errors"
 )
 
+var (
+	CodeTooManyRequests errors.ErrorCode = "TOO_MANY_REQUESTS"
+	CodeLoginLocked     errors.ErrorCode = "LOGIN_LOCKED"
+)
+
 var StatusCode = map[errors.ErrorCode]int{
 	errors.CodeBadRequest:          http.StatusBadRequest,
 	errors.CodeUnauthenticated:     http.StatusUnauthorized,
@@ -14,6 +19,8 @@ var StatusCode = map[errors.ErrorCode]int{
 	errors.CodeDuplicate:           http.StatusConflict,
 	errors.CodeConstraintViolation: http.StatusConflict,
 	errors.CodeInvalidInput:        http.StatusUnprocessableEntity,
+	CodeTooManyRequests:            http.StatusTooManyRequests,
+	CodeLoginLocked:                http.StatusTooManyRequests,
 	errors.CodeInternalServerError: http.StatusInternalServerError,
 	errors.CodeUnknown:             http.StatusInternalServerError,
 }
@@ -13,6 +13,11 @@ import (
 type ErrorResponse struct {
 	Code    errors.ErrorCode `json:"code"`
 	Message string           `json:"message"`
+	Details []string         `json:"details,omitempty"`
+}
+
+type detailer interface {
+	Details() []string
 }
 
 func Handle(c *gin.Context, err error) {
@@ -23,17 +28,20 @@ func Handle(c *gin.Context, err error) {
 	slog.ErrorContext(c.Request.Context(), err.Error())
 
 	status := http.StatusInternalServerError
-	res := ErrorResponse{errors.CodeUnknown, "internal server error"}
+	res := ErrorResponse{Code: errors.CodeUnknown, Message: "internal server error"}
 
 	if v, ok := err.(interface{ Code() errors.ErrorCode }); ok {
 		status = StatusCode[v.Code()]
 		switch v.Code() {
 		case errors.CodeUnknown:
-			res = ErrorResponse{v.Code(), "internal server error"}
+			res = ErrorResponse{Code: v.Code(), Message: "internal server error"}
 		case errors.CodeDuplicate, errors.CodeConstraintViolation, errors.CodeInvalidInput:
-			res = ErrorResponse{v.Code(), stderr.Unwrap(err).Error()}
+			res = ErrorResponse{Code: v.Code(), Message: stderr.Unwrap(err).Error()}
+			if d, ok := stderr.Unwrap(err).(detailer); ok {
+				res.Details = d.Details()
+			}
 		default:
-			res = ErrorResponse{v.Code(), strings.ToLower(strings.ReplaceAll(v.Code().String(), "_", " "))}
+			res = ErrorResponse{Code: v.Code(), Message: strings.ToLower(strings.ReplaceAll(v.Code().String(), "_", " "))}
 		}
 	}
 
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	fullAt    time.Time
+}
+
+type memoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	sweptAt time.Time
+}
+
+// NOTE: バケットはプロセス内に保持するため, 複数のインスタンスで起動する場合はRedisストアを使用する.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		buckets: make(map[string]*bucket),
+		sweptAt: time.Now(),
+	}
+}
+
+func (s *memoryStore) Take(_ context.Context, key string, rate Rate) (*Result, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(rate.Limit), updatedAt: now}
+		s.buckets[key] = b
+	}
+
+	b.tokens = refill(rate, b.tokens, now.Sub(b.updatedAt))
+	b.updatedAt = now
+
+	allowed := 1 <= b.tokens
+	if allowed {
+		b.tokens--
+	}
+
+	result := newResult(rate, b.tokens, allowed)
+	b.fullAt = now.Add(result.ResetAfter)
+	return result, nil
+}
+
+// NOTE: 満タンまで補充されたバケットは新規作成と区別できないため, 定期的に破棄してメモリの増加を抑える.
+func (s *memoryStore) sweep(now time.Time) {
+	if now.Sub(s.sweptAt) < sweepInterval {
+		return
+	}
+	s.sweptAt = now
+
+	for key, b := range s.buckets {
+		if !now.Before(b.fullAt) {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package ratelimit
+
+import (
+	"context"
+	stderr "errors"
+	"strconv"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+var ErrUnexpectedResult = stderr.New("unexpected rate limit script result")
+
+// NOTE: 補充と消費を1つのスクリプトで行い, 複数のインスタンスから同時に消費しても原子的に処理されるようにする.
+var takeScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "updated_at")
+local tokens = tonumber(bucket[1])
+local updated_at = tonumber(bucket[2])
+if tokens == nil or updated_at == nil then
+  tokens = limit
+  updated_at = now
+end
+
+if updated_at < now then
+  tokens = math.min(limit, tokens + (now - updated_at) / interval)
+end
+
+local allowed = 0
+if 1 <= tokens then
+  tokens = tokens - 1
+  allowed = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated_at", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil((limit - tokens) * interval / 1000) + 1)
+
+return {allowed, tostring(tokens)}
+`)
+
+type redisStore struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+func NewRedisStore(client redis.UniversalClient, prefix string) Store {
+	return &redisStore{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (s *redisStore) Take(ctx context.Context, key string, rate Rate) (*Result, error) {
+	const errMessage = "failed to take rate limit token"
+
+	values, err := takeScript.Run(
+		ctx,
+		s.client,
+		[]string{s.prefix + key},
+		rate.Limit,
+		rate.interval().Microseconds(),
+		time.Now().UnixMicro(),
+	).Slice()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	allowed, tokens, err := parseTakeResult(values)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternalServerError, errMessage)
+	}
+
+	return newResult(rate, tokens, allowed), nil
+}
+
+func parseTakeResult(values []any) (bool, float64, error) {
+	if len(values) != 2 {
+		return false, 0, ErrUnexpectedResult
+	}
+
+	allowed, ok := values[0].(int64)
+	if !ok {
+		return false, 0, ErrUnexpectedResult
+	}
+	s, ok := values[1].(string)
+	if !ok {
+		return false, 0, ErrUnexpectedResult
+	}
+	tokens, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return allowed == 1, tokens, nil
+}
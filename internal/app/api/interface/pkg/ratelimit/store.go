@@ -0,0 +1,49 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+type Rate struct {
+	Limit  int
+	Period time.Duration
+}
+
+type Result struct {
+	Allowed    bool
+	Remaining  int
+	ResetAfter time.Duration
+	RetryAfter time.Duration
+}
+
+type Store interface {
+	Take(ctx context.Context, key string, rate Rate) (*Result, error)
+}
+
+// NOTE: トークンバケットの容量をLimit, 補充間隔をPeriod/Limitとし, 容量までのバーストを許容する.
+func (r Rate) interval() time.Duration {
+	return r.Period / time.Duration(r.Limit)
+}
+
+func refill(rate Rate, tokens float64, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return tokens
+	}
+	return math.Min(float64(rate.Limit), tokens+float64(elapsed)/float64(rate.interval()))
+}
+
+func newResult(rate Rate, tokens float64, allowed bool) *Result {
+	interval := float64(rate.interval())
+
+	result := &Result{
+		Allowed:    allowed,
+		Remaining:  int(math.Floor(tokens)),
+		ResetAfter: time.Duration((float64(rate.Limit) - tokens) * interval),
+	}
+	if !allowed {
+		result.RetryAfter = time.Duration((1 - tokens) * interval)
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/ratelimit"
+)
+
+func newStores(t *testing.T) map[string]ratelimit.Store {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	return map[string]ratelimit.Store{
+		"memory": ratelimit.NewMemoryStore(),
+		"redis":  ratelimit.NewRedisStore(client, "test:"),
+	}
+}
+
+func TestStore_Take(t *testing.T) {
+	rate := ratelimit.Rate{Limit: 3, Period: time.Minute}
+
+	for name, store := range newStores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+
+			for i := range rate.Limit {
+				result, err := store.Take(ctx, "key", rate)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !result.Allowed {
+					t.Errorf("request %d is not allowed", i+1)
+				}
+				if expect := rate.Limit - i - 1; result.Remaining != expect {
+					t.Errorf("\nexpect: %v\ngot: %v", expect, result.Remaining)
+				}
+				if result.ResetAfter <= 0 || time.Minute < result.ResetAfter {
+					t.Errorf("invalid reset_after: %v", result.ResetAfter)
+				}
+			}
+
+			result, err := store.Take(ctx, "key", rate)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Allowed {
+				t.Error("request exceeding limit is allowed")
+			}
+			if result.Remaining != 0 {
+				t.Errorf("\nexpect: %v\ngot: %v", 0, result.Remaining)
+			}
+			if result.RetryAfter <= 0 || rate.Period/time.Duration(rate.Limit) < result.RetryAfter {
+				t.Errorf("invalid retry_after: %v", result.RetryAfter)
+			}
+
+			result, err = store.Take(ctx, "other", rate)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !result.Allowed {
+				t.Error("request with other key is not allowed")
+			}
+		})
+	}
+}
+
+func TestStore_Take_Refill(t *testing.T) {
+	rate := ratelimit.Rate{Limit: 2, Period: time.Millisecond * 100}
+
+	for name, store := range newStores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+
+			for range rate.Limit {
+				if _, err := store.Take(ctx, "key", rate); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			time.Sleep(rate.Period / time.Duration(rate.Limit))
+
+			result, err := store.Take(ctx, "key", rate)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !result.Allowed {
+				t.Error("request after refill is not allowed")
+			}
+		})
+	}
+}
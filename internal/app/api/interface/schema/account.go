@@ -1,5 +1,11 @@
 package schema
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
 type CreateAccountRequest struct {
 	Name            string `json:"name"`
 	Password        string `json:"password"`
@@ -21,6 +27,39 @@ type DeleteAccountRequest struct {
 	Password string `json:"password"`
 }
 
+type RestoreAccountRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
 type AccountResponse struct {
-	Name string `json:"name"`
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type AccountSecurityResponse struct {
+	TwoFactorEnabled bool `json:"two_factor_enabled"`
+	PasskeyCount     int  `json:"passkey_count"`
+}
+
+type GetAccountResponse struct {
+	ID        uuid.UUID                `json:"id"`
+	Name      string                   `json:"name"`
+	Email     string                   `json:"email,omitempty"`
+	CreatedAt time.Time                `json:"created_at"`
+	UpdatedAt time.Time                `json:"updated_at"`
+	Security  *AccountSecurityResponse `json:"security"`
+}
+
+type UpdateAccountPasswordResponse struct {
+	Name    string           `json:"name"`
+	Session *SessionResponse `json:"session"`
+}
+
+type DeleteAccountResponse struct {
+	Name            string    `json:"name"`
+	RestoreDeadline time.Time `json:"restore_deadline"`
 }
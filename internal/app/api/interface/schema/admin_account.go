@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GetAdminAccountsRequest struct {
+	Name          string    `form:"name"`
+	Deleted       *bool     `form:"deleted"`
+	CreatedAfter  time.Time `form:"created_after" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedBefore time.Time `form:"created_before" time_format:"2006-01-02T15:04:05Z07:00"`
+	Cursor        string    `form:"cursor"`
+	Limit         int       `form:"limit"`
+}
+
+type AdminAccountResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	Email     string     `json:"email,omitempty"`
+	Roles     []string   `json:"roles"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+type GetAdminAccountsResponse struct {
+	Accounts   []*AdminAccountResponse `json:"accounts"`
+	NextCursor string                  `json:"next_cursor,omitempty"`
+}
+
+type GetAdminAccountResponse struct {
+	ID        uuid.UUID                `json:"id"`
+	Name      string                   `json:"name"`
+	Email     string                   `json:"email,omitempty"`
+	Roles     []string                 `json:"roles"`
+	CreatedAt time.Time                `json:"created_at"`
+	UpdatedAt time.Time                `json:"updated_at"`
+	DeletedAt *time.Time               `json:"deleted_at,omitempty"`
+	Security  *AccountSecurityResponse `json:"security"`
+}
@@ -0,0 +1,14 @@
+package schema
+
+type JWKResponse struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+}
+
+type JWKSResponse struct {
+	Keys []*JWKResponse `json:"keys"`
+}
@@ -0,0 +1,126 @@
+package schema
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NOTE: WebAuthnのJSON表現に合わせ, バイナリはパディングなしのbase64url文字列として送受信する.
+type Base64URL []byte
+
+func (b Base64URL) MarshalJSON() ([]byte, error) {
+	return json.Marshal(base64.RawURLEncoding.EncodeToString(b))
+}
+
+func (b *Base64URL) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return err
+	}
+
+	*b = decoded
+	return nil
+}
+
+// NOTE: 認証器の応答はPublicKeyCredential.toJSON()の形式で受け付ける.
+type RegisterPasskeyRequest struct {
+	Name       string                       `json:"name"`
+	Credential PasskeyAttestationCredential `json:"credential"`
+}
+
+type PasskeyAttestationCredential struct {
+	ID       string                     `json:"id"`
+	RawID    Base64URL                  `json:"rawId"`
+	Type     string                     `json:"type"`
+	Response PasskeyAttestationResponse `json:"response"`
+}
+
+type PasskeyAttestationResponse struct {
+	ClientDataJSON    Base64URL `json:"clientDataJSON"`
+	AttestationObject Base64URL `json:"attestationObject"`
+}
+
+type RenamePasskeyRequest struct {
+	Name string `json:"name"`
+}
+
+type CreatePasskeySessionRequest struct {
+	Credential PasskeyAssertionCredential `json:"credential"`
+	RememberMe bool                       `json:"remember_me"`
+	UseCookie  bool                       `json:"use_cookie"`
+}
+
+type PasskeyAssertionCredential struct {
+	ID       string                   `json:"id"`
+	RawID    Base64URL                `json:"rawId"`
+	Type     string                   `json:"type"`
+	Response PasskeyAssertionResponse `json:"response"`
+}
+
+type PasskeyAssertionResponse struct {
+	ClientDataJSON    Base64URL `json:"clientDataJSON"`
+	AuthenticatorData Base64URL `json:"authenticatorData"`
+	Signature         Base64URL `json:"signature"`
+	UserHandle        Base64URL `json:"userHandle"`
+}
+
+type PasskeyResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// NOTE: 登録と認証のオプションはPublicKeyCredential.parseCreationOptionsFromJSON()等にそのまま渡せる形式で返す.
+type PasskeyRegistrationOptionsResponse struct {
+	Challenge              string                                `json:"challenge"`
+	RP                     PasskeyRelyingPartyResponse           `json:"rp"`
+	User                   PasskeyUserResponse                   `json:"user"`
+	PubKeyCredParams       []PasskeyCredentialParameterResponse  `json:"pubKeyCredParams"`
+	Timeout                int64                                 `json:"timeout"`
+	ExcludeCredentials     []PasskeyCredentialDescriptorResponse `json:"excludeCredentials"`
+	AuthenticatorSelection PasskeyAuthenticatorSelectionResponse `json:"authenticatorSelection"`
+	Attestation            string                                `json:"attestation"`
+}
+
+type PasskeyRelyingPartyResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type PasskeyUserResponse struct {
+	ID          Base64URL `json:"id"`
+	Name        string    `json:"name"`
+	DisplayName string    `json:"displayName"`
+}
+
+type PasskeyCredentialParameterResponse struct {
+	Type string `json:"type"`
+	Alg  int    `json:"alg"`
+}
+
+type PasskeyCredentialDescriptorResponse struct {
+	Type string    `json:"type"`
+	ID   Base64URL `json:"id"`
+}
+
+type PasskeyAuthenticatorSelectionResponse struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+type PasskeyAuthenticationOptionsResponse struct {
+	Challenge        string `json:"challenge"`
+	RPID             string `json:"rpId"`
+	Timeout          int64  `json:"timeout"`
+	UserVerification string `json:"userVerification"`
+}
@@ -0,0 +1,13 @@
+package schema
+
+type RegenerateRecoveryCodesRequest struct {
+	Password string `json:"password"`
+}
+
+type RecoveryCodesResponse struct {
+	Codes []string `json:"codes"`
+}
+
+type RecoveryCodeCountResponse struct {
+	Remaining int `json:"remaining"`
+}
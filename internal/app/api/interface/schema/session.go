@@ -1,17 +1,74 @@
 package schema
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type CreateSessionRequest struct {
 	AccountName string `json:"account_name"`
 	Password    string `json:"password"`
+	RememberMe  bool   `json:"remember_me"`
+	UseCookie   bool   `json:"use_cookie"`
+}
+
+type ChallengeSessionRequest struct {
+	ChallengeToken string `json:"challenge_token"`
+	Method         string `json:"method"`
+	Code           string `json:"code"`
+	UseCookie      bool   `json:"use_cookie"`
+}
+
+type RefreshSessionRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type IntrospectSessionRequest struct {
+	Token         string `form:"token" json:"token"`
+	TokenTypeHint string `form:"token_type_hint" json:"token_type_hint"`
 }
 
 type SessionResponse struct {
-	Token string `json:"token"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	AccessToken  string `json:"access_token,omitempty"`
+}
+
+type CookieSessionResponse struct {
+	CSRFToken   string `json:"csrf_token"`
+	AccessToken string `json:"access_token,omitempty"`
+}
+
+type LoginChallengeResponse struct {
+	ChallengeToken string    `json:"challenge_token"`
+	Methods        []string  `json:"methods"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+type SessionDetailResponse struct {
+	ID         uuid.UUID `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	Current    bool      `json:"current"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
 type VerifiedSessionResponse struct {
-	ID   uuid.UUID `json:"id"`
-	Name string    `json:"name"`
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Roles       []string  `json:"roles"`
+	Permissions []string  `json:"permissions"`
+}
+
+type IntrospectSessionResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	Username  string `json:"username,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Sub       string `json:"sub,omitempty"`
 }
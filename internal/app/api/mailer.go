@@ -0,0 +1,18 @@
+package api
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/mailer"
+	infraMailer "github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/mailer"
+)
+
+const (
+	mailBackendSMTP = "smtp"
+	mailBackendFile = "file"
+)
+
+func NewMailer(conf *mailConfig) mailer.Mailer {
+	if conf.Backend == mailBackendSMTP {
+		return infraMailer.NewSMTPMailer(conf.SMTPHost, conf.SMTPPort, conf.SMTPUsername, conf.SMTPPassword, conf.From)
+	}
+	return infraMailer.NewFileMailer(conf.FileDir, conf.From)
+}
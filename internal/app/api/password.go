@@ -0,0 +1,53 @@
+package api
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/hasher"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/denylist"
+)
+
+const (
+	passwordHashAlgorithmArgon2id = "argon2id"
+	passwordHashAlgorithmBcrypt   = "bcrypt"
+)
+
+// NOTE: 拒否リストのファイルが設定されていない場合は拒否リストによる検証を行わない.
+func NewPasswordPolicy(conf *passwordConfig) (entity.PasswordPolicy, error) {
+	policy := entity.PasswordPolicy{
+		MinLength:     conf.MinLength,
+		MaxLength:     conf.MaxLength,
+		RequireUpper:  conf.RequireUpper,
+		RequireLower:  conf.RequireLower,
+		RequireDigit:  conf.RequireDigit,
+		RequireSymbol: conf.RequireSymbol,
+		MinEntropy:    conf.MinEntropy,
+	}
+
+	if conf.DenylistFile != "" {
+		d, err := denylist.NewBloomDenylist(conf.DenylistFile, conf.DenylistFalsePositiveRate)
+		if err != nil {
+			return entity.PasswordPolicy{}, err
+		}
+		policy.Denylist = d
+	}
+
+	return policy, nil
+}
+
+// NOTE: 指定した方式でハッシュを生成し, もう一方の方式のハッシュも検証できるようにする.
+// 旧方式や旧パラメータのハッシュはログイン時に再生成される.
+func NewPasswordHasher(conf *passwordConfig) hasher.PasswordHasher {
+	argon2idHasher := hasher.NewArgon2idHasher(hasher.Argon2idParams{
+		Memory:      conf.Argon2Memory,
+		Iterations:  conf.Argon2Iterations,
+		Parallelism: conf.Argon2Parallelism,
+		SaltLength:  16,
+		KeyLength:   32,
+	})
+	bcryptHasher := hasher.NewBcryptHasher(conf.BcryptCost)
+
+	if conf.HashAlgorithm == passwordHashAlgorithmBcrypt {
+		return hasher.NewPasswordHasher(bcryptHasher, argon2idHasher)
+	}
+	return hasher.NewPasswordHasher(argon2idHasher, bcryptHasher)
+}
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/ratelimit"
+)
+
+const rateLimitKeyPrefix = "holos_account:rate_limit:"
+
+func NewRateLimitStore(conf *rateLimitConfig) (ratelimit.Store, error) {
+	if conf.RedisURL == "" {
+		return ratelimit.NewMemoryStore(), nil
+	}
+
+	opts, err := redis.ParseURL(conf.RedisURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client := redis.NewClient(opts)
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		return nil, err
+	}
+
+	return ratelimit.NewRedisStore(client, rateLimitKeyPrefix), nil
+}
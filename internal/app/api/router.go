@@ -1,18 +1,56 @@
 package api
 
-import "github.com/gin-gonic/gin"
+import (
+	"github.com/gin-gonic/gin"
 
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+)
+
+// NOTE: 認証が必要なエンドポイントはアカウントID毎に制限するため, レート制限は認証ミドルウェアの後に設定する.
+// /sessions/introspectと/sessions/verifyは他サービスからリクエスト毎に呼び出されるため制限しない.
 func registerRouter(r *gin.Engine) {
 	r.GET("/health", healthHdl.Health)
+	r.GET("/.well-known/jwks.json", keyHdl.GetJWKS)
 
 	accounts := r.Group("accounts")
-	accounts.POST("/", accountHdl.Create)
-	accounts.DELETE("/", authenticationMW.Authenticate, accountHdl.Delete)
-	accounts.PATCH("/name", authenticationMW.Authenticate, accountHdl.UpdateName)
-	accounts.PATCH("/password", authenticationMW.Authenticate, accountHdl.UpdatePassword)
+	accounts.GET("/me", authenticationMW.Authenticate, accountHdl.Get)
+	accounts.POST("/", accountRateLimitMW.Limit, accountHdl.Create)
+	accounts.DELETE("/", authenticationMW.Authenticate, accountRateLimitMW.Limit, accountHdl.Delete)
+	accounts.POST("/restore", accountRateLimitMW.Limit, accountHdl.Restore)
+	accounts.PATCH("/name", authenticationMW.Authenticate, accountRateLimitMW.Limit, accountHdl.UpdateName)
+	accounts.PATCH("/password", authenticationMW.Authenticate, accountRateLimitMW.Limit, accountHdl.UpdatePassword)
+	accounts.POST("/password-reset", accountRateLimitMW.Limit, passwordResetHdl.Request)
+	accounts.POST("/password-reset/confirm", accountRateLimitMW.Limit, passwordResetHdl.Confirm)
+	accounts.PATCH("/email", authenticationMW.Authenticate, accountRateLimitMW.Limit, emailHdl.Change)
+	accounts.POST("/email/verify", accountRateLimitMW.Limit, emailHdl.Verify)
+	accounts.POST("/totp", authenticationMW.Authenticate, accountRateLimitMW.Limit, totpHdl.Enroll)
+	accounts.POST("/totp/confirm", authenticationMW.Authenticate, accountRateLimitMW.Limit, totpHdl.Confirm)
+	accounts.DELETE("/totp", authenticationMW.Authenticate, accountRateLimitMW.Limit, totpHdl.Disable)
+	accounts.POST("/recovery-codes", authenticationMW.Authenticate, accountRateLimitMW.Limit, recoveryCodeHdl.Regenerate)
+	accounts.GET("/recovery-codes", authenticationMW.Authenticate, recoveryCodeHdl.Count)
+	accounts.POST("/passkeys/options", authenticationMW.Authenticate, accountRateLimitMW.Limit, passkeyHdl.CreateRegistrationOptions)
+	accounts.POST("/passkeys", authenticationMW.Authenticate, accountRateLimitMW.Limit, passkeyHdl.Register)
+	accounts.GET("/passkeys", authenticationMW.Authenticate, passkeyHdl.GetAll)
+	accounts.PATCH("/passkeys/:id", authenticationMW.Authenticate, accountRateLimitMW.Limit, passkeyHdl.Rename)
+	accounts.DELETE("/passkeys/:id", authenticationMW.Authenticate, accountRateLimitMW.Limit, passkeyHdl.Delete)
 
 	sessions := r.Group("sessions")
-	sessions.POST("/", sessionHdl.Create)
-	sessions.DELETE("/", authenticationMW.Authenticate, sessionHdl.Delete)
+	sessions.GET("/", authenticationMW.Authenticate, sessionRateLimitMW.Limit, sessionHdl.GetAll)
+	sessions.POST("/", sessionRateLimitMW.Limit, sessionHdl.Create)
+	sessions.DELETE("/", authenticationMW.Authenticate, sessionRateLimitMW.Limit, sessionHdl.Delete)
+	sessions.POST("/challenge", sessionRateLimitMW.Limit, sessionHdl.Challenge)
+	sessions.POST("/passkey/options", sessionRateLimitMW.Limit, sessionHdl.CreatePasskeyOptions)
+	sessions.POST("/passkey", sessionRateLimitMW.Limit, sessionHdl.CreateWithPasskey)
+	sessions.DELETE("/:id", authenticationMW.Authenticate, sessionRateLimitMW.Limit, sessionHdl.DeleteByID)
+	sessions.POST("/introspect", serviceAuthenticationMW.Authenticate, sessionHdl.Introspect)
+	sessions.POST("/refresh", sessionRateLimitMW.Limit, sessionHdl.Refresh)
 	sessions.GET("/verify", authenticationMW.Authenticate, sessionHdl.Verify)
+
+	admin := r.Group("admin")
+	admin.GET("/accounts", authenticationMW.Authenticate, authorizationMW.Authorize(entity.PermissionAccountsRead), adminAccountHdl.GetAll)
+	admin.GET("/accounts/:id", authenticationMW.Authenticate, authorizationMW.Authorize(entity.PermissionAccountsRead), adminAccountHdl.Get)
+	admin.DELETE("/accounts/:id", authenticationMW.Authenticate, authorizationMW.Authorize(entity.PermissionAccountsWrite), accountRateLimitMW.Limit, adminAccountHdl.Delete)
+	admin.POST("/accounts/:id/restore", authenticationMW.Authenticate, authorizationMW.Authorize(entity.PermissionAccountsWrite), accountRateLimitMW.Limit, adminAccountHdl.Restore)
+	admin.POST("/accounts/:id/password-reset", authenticationMW.Authenticate, authorizationMW.Authorize(entity.PermissionAccountsWrite), accountRateLimitMW.Limit, adminAccountHdl.ResetPassword)
+	admin.DELETE("/accounts/:id/sessions", authenticationMW.Authenticate, authorizationMW.Authorize(entity.PermissionAccountsWrite), accountRateLimitMW.Limit, adminAccountHdl.RevokeSessions)
 }
@@ -0,0 +1,9 @@
+package api
+
+import "github.com/atsumarukun/holos-account-api/internal/app/api/interface/job"
+
+func registerScheduler(s job.Scheduler, conf *jobConfig) {
+	s.Register(conf.SessionPurgeInterval, cleanupJob.PurgeExpiredSessions)
+	s.Register(conf.AccountPurgeInterval, cleanupJob.PurgeDeletedAccounts)
+	s.Register(conf.LoginFailurePurgeInterval, cleanupJob.PurgeLoginFailures)
+}
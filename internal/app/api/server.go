@@ -10,19 +10,42 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/job"
 )
 
 func Serve() {
-	conf := loadServerConfig()
+	conf, err := loadServerConfig()
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
 
 	db, err := NewDatabase(&conf.database)
 	if err != nil {
 		log.Fatalln(err.Error())
 	}
 
-	inject(db)
+	accessTokenIssuer, err := NewAccessTokenIssuer(&conf.accessToken)
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
 
-	r := gin.Default()
+	rateLimitStore, err := NewRateLimitStore(&conf.rateLimit)
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+
+	passwordPolicy, err := NewPasswordPolicy(&conf.password)
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+
+	inject(db, accessTokenIssuer, rateLimitStore, passwordPolicy, conf)
+
+	r, err := newEngine(&conf.http)
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
 	registerRouter(r)
 
 	srv := &http.Server{
@@ -31,9 +54,18 @@ func Serve() {
 		ReadHeaderTimeout: 10 * time.Second,
 	}
 
+	scheduler := job.NewScheduler()
+	registerScheduler(scheduler, &conf.job)
+
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt, os.Kill)
 	defer stop()
 
+	jobCtx, stopJob := context.WithCancel(context.Background())
+	defer stopJob()
+
+	bootstrapJob.BootstrapAdmin(jobCtx)
+	scheduler.Start(jobCtx)
+
 	go func() {
 		if err := srv.ListenAndServe(); err != nil {
 			log.Println(err.Error())
@@ -42,10 +74,23 @@ func Serve() {
 
 	<-ctx.Done()
 
+	stopJob()
+
 	ctx, stop = context.WithTimeout(context.Background(), 10*time.Second)
 	defer stop()
 
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Println(err.Error())
 	}
+
+	scheduler.Wait()
+}
+
+// NOTE: 送信元IPアドレスはレート制限とログイン試行の制限に用いるため, 信頼するプロキシ以外が付与したX-Forwarded-Forは無視する.
+func newEngine(conf *httpConfig) (*gin.Engine, error) {
+	r := gin.Default()
+	if err := r.SetTrustedProxies(conf.TrustedProxies); err != nil {
+		return nil, err
+	}
+	return r, nil
 }
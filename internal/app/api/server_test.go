@@ -0,0 +1,84 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/middleware"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/interface/pkg/ratelimit"
+)
+
+func TestNewEngine(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		conf           *httpConfig
+		forwardedFor   []string
+		expectCodes    []int
+		expectClientIP string
+		expectError    bool
+	}{
+		{
+			name:           "spoofed header ignored",
+			conf:           &httpConfig{},
+			forwardedFor:   []string{"203.0.113.1", "203.0.113.2"},
+			expectCodes:    []int{http.StatusOK, http.StatusTooManyRequests},
+			expectClientIP: "192.0.2.1",
+			expectError:    false,
+		},
+		{
+			name:           "trusted proxy",
+			conf:           &httpConfig{TrustedProxies: []string{"192.0.2.0/24"}},
+			forwardedFor:   []string{"203.0.113.1", "203.0.113.2"},
+			expectCodes:    []int{http.StatusOK, http.StatusOK},
+			expectClientIP: "203.0.113.2",
+			expectError:    false,
+		},
+		{
+			name:        "invalid proxy",
+			conf:        &httpConfig{TrustedProxies: []string{"invalid"}},
+			expectError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := newEngine(tt.conf)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("\nexpect error: %v\ngot: %v", tt.expectError, err)
+			}
+			if err != nil {
+				return
+			}
+
+			var clientIP string
+			mw := middleware.NewRateLimitMiddleware(ratelimit.NewMemoryStore(), "sessions", ratelimit.Rate{Limit: 1, Period: time.Minute})
+			r.POST("/sessions", mw.Limit, func(c *gin.Context) {
+				clientIP = c.ClientIP()
+			})
+
+			for i, forwardedFor := range tt.forwardedFor {
+				w := httptest.NewRecorder()
+				req, err := http.NewRequestWithContext(t.Context(), "POST", "/sessions", http.NoBody)
+				if err != nil {
+					t.Error(err)
+				}
+				req.RemoteAddr = "192.0.2.1:12345"
+				req.Header.Set("X-Forwarded-For", forwardedFor)
+				r.ServeHTTP(w, req)
+
+				if w.Code != tt.expectCodes[i] {
+					t.Errorf("\nexpect: %v\ngot: %v", tt.expectCodes[i], w.Code)
+				}
+			}
+
+			if clientIP != tt.expectClientIP {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectClientIP, clientIP)
+			}
+		})
+	}
+}
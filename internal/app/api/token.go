@@ -0,0 +1,14 @@
+package api
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/token"
+	infraToken "github.com/atsumarukun/holos-account-api/internal/app/api/infrastructure/token"
+)
+
+// NOTE: 鍵ファイルが設定されていない場合は署名付きアクセストークンを無効とし, nilを返す.
+func NewAccessTokenIssuer(conf *accessTokenConfig) (token.AccessTokenIssuer, error) {
+	if len(conf.KeyFiles) == 0 {
+		return nil, nil
+	}
+	return infraToken.NewEd25519AccessTokenIssuer(conf.KeyFiles, conf.Issuer, conf.Lifetime)
+}
@@ -4,11 +4,13 @@ package usecase
 import (
 	"context"
 	stderr "errors"
+	"time"
 
 	"github.com/atsumarukun/holos-api-pkg/errors"
 	"github.com/google/uuid"
 
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/hasher"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/transaction"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/service"
@@ -19,32 +21,79 @@ import (
 var ErrAccountNotFound = stderr.New("account not found")
 
 type AccountUsecase interface {
+	Get(context.Context, uuid.UUID) (*dto.AccountDTO, *dto.AccountSecurityDTO, error)
 	Create(context.Context, string, string, string) (*dto.AccountDTO, error)
 	UpdateName(context.Context, uuid.UUID, string, string) (*dto.AccountDTO, error)
-	UpdatePassword(context.Context, uuid.UUID, string, string, string) (*dto.AccountDTO, error)
-	Delete(context.Context, uuid.UUID, string) error
+	UpdatePassword(context.Context, uuid.UUID, uuid.UUID, string, string, string) (*dto.AccountDTO, *dto.SessionDTO, error)
+	Delete(context.Context, uuid.UUID, string) (*dto.DeletedAccountDTO, error)
+	Restore(context.Context, string, string, string) (*dto.AccountDTO, error)
 }
 
 type accountUsecase struct {
 	transactionObj transaction.TransactionObject
 	accountRepo    repository.AccountRepository
+	sessionRepo    repository.SessionRepository
+	totpRepo       repository.TOTPRepository
+	passkeyRepo    repository.PasskeyRepository
 	accountServ    service.AccountService
+	passwordPolicy entity.PasswordPolicy
+	passwordHasher hasher.PasswordHasher
+	restorePeriod  time.Duration
+	loginThrottle  *loginThrottle
 }
 
 func NewAccountUsecase(
 	transactionObj transaction.TransactionObject,
 	accountRepo repository.AccountRepository,
+	sessionRepo repository.SessionRepository,
+	totpRepo repository.TOTPRepository,
+	passkeyRepo repository.PasskeyRepository,
+	loginFailureRepo repository.LoginFailureRepository,
 	accountServ service.AccountService,
+	passwordPolicy entity.PasswordPolicy,
+	passwordHasher hasher.PasswordHasher,
+	restorePeriod time.Duration,
+	accountLoginPolicy entity.LoginThrottlePolicy,
+	ipLoginPolicy entity.LoginThrottlePolicy,
 ) AccountUsecase {
 	return &accountUsecase{
 		transactionObj: transactionObj,
 		accountRepo:    accountRepo,
+		sessionRepo:    sessionRepo,
+		totpRepo:       totpRepo,
+		passkeyRepo:    passkeyRepo,
 		accountServ:    accountServ,
+		passwordPolicy: passwordPolicy,
+		passwordHasher: passwordHasher,
+		restorePeriod:  restorePeriod,
+		loginThrottle:  newLoginThrottle(transactionObj, loginFailureRepo, accountLoginPolicy, ipLoginPolicy),
 	}
 }
 
+func (u *accountUsecase) Get(ctx context.Context, id uuid.UUID) (*dto.AccountDTO, *dto.AccountSecurityDTO, error) {
+	account, err := u.accountRepo.FindOneByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if account == nil {
+		return nil, nil, errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, "failed to get account")
+	}
+
+	totp, err := u.totpRepo.FindOneByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	passkeys, err := u.passkeyRepo.FindByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mapper.ToAccountDTO(account), mapper.ToAccountSecurityDTO(totp, passkeys), nil
+}
+
 func (u *accountUsecase) Create(ctx context.Context, name, password, confirmPassword string) (*dto.AccountDTO, error) {
-	account, err := entity.NewAccount(name, password, confirmPassword)
+	account, err := entity.NewAccount(name, password, confirmPassword, u.passwordPolicy, u.passwordHasher)
 	if err != nil {
 		return nil, err
 	}
@@ -54,6 +103,10 @@ func (u *accountUsecase) Create(ctx context.Context, name, password, confirmPass
 			return err
 		}
 
+		if err := u.releaseExpiredName(ctx, account.Name); err != nil {
+			return err
+		}
+
 		return u.accountRepo.Create(ctx, account)
 	}); err != nil {
 		return nil, err
@@ -75,7 +128,7 @@ func (u *accountUsecase) UpdateName(ctx context.Context, id uuid.UUID, password,
 			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, "failed to update account name")
 		}
 
-		if err := account.VerifyPassword(password); err != nil {
+		if err := account.VerifyPassword(password, u.passwordHasher); err != nil {
 			return err
 		}
 
@@ -91,6 +144,10 @@ func (u *accountUsecase) UpdateName(ctx context.Context, id uuid.UUID, password,
 			return err
 		}
 
+		if err := u.releaseExpiredName(ctx, account.Name); err != nil {
+			return err
+		}
+
 		return u.accountRepo.Update(ctx, account)
 	}); err != nil {
 		return nil, err
@@ -99,8 +156,9 @@ func (u *accountUsecase) UpdateName(ctx context.Context, id uuid.UUID, password,
 	return mapper.ToAccountDTO(account), nil
 }
 
-func (u *accountUsecase) UpdatePassword(ctx context.Context, id uuid.UUID, password, newPassword, confirmPassword string) (*dto.AccountDTO, error) {
+func (u *accountUsecase) UpdatePassword(ctx context.Context, id, sessionID uuid.UUID, password, newPassword, confirmPassword string) (*dto.AccountDTO, *dto.SessionDTO, error) {
 	var account *entity.Account
+	var session *entity.Session
 
 	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
 		var err error
@@ -112,36 +170,132 @@ func (u *accountUsecase) UpdatePassword(ctx context.Context, id uuid.UUID, passw
 			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, "failed to update account password")
 		}
 
-		if err := account.VerifyPassword(password); err != nil {
+		if err := account.VerifyPassword(password, u.passwordHasher); err != nil {
 			return err
 		}
 
-		if err := account.SetPassword(newPassword, confirmPassword); err != nil {
+		if err := account.SetPassword(newPassword, confirmPassword, u.passwordPolicy, u.passwordHasher); err != nil {
 			return err
 		}
 
-		return u.accountRepo.Update(ctx, account)
+		if err := u.accountRepo.Update(ctx, account); err != nil {
+			return err
+		}
+
+		session, err = u.revokeOtherSessions(ctx, id, sessionID)
+		return err
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	return mapper.ToAccountDTO(account), mapper.ToSessionDTO(session), nil
+}
+
+// NOTE: 削除したアカウントは復元期間内であれば復元できるため, 論理削除に留めて復元期限を返却する.
+// 復元時に削除前のセッションが有効にならないよう, セッションは同一トランザクション内で全て失効させる.
+func (u *accountUsecase) Delete(ctx context.Context, id uuid.UUID, password string) (*dto.DeletedAccountDTO, error) {
+	var account *entity.Account
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		account, err = u.accountRepo.FindOneByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, "failed to delete account")
+		}
+
+		if err := account.VerifyPassword(password, u.passwordHasher); err != nil {
+			return err
+		}
+
+		if err := u.accountRepo.Delete(ctx, account); err != nil {
+			return err
+		}
+
+		return u.sessionRepo.DeleteByAccountID(ctx, account.ID)
 	}); err != nil {
 		return nil, err
 	}
 
-	return mapper.ToAccountDTO(account), nil
+	return mapper.ToDeletedAccountDTO(account, time.Now().Add(u.restorePeriod)), nil
 }
 
-func (u *accountUsecase) Delete(ctx context.Context, id uuid.UUID, password string) error {
-	return u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
-		account, err := u.accountRepo.FindOneByID(ctx, id)
+// NOTE: パスワードの総当たりを防ぐため, ログインと同じ対象で失敗回数を記録し, ロック中は復元も受け付けない.
+func (u *accountUsecase) Restore(ctx context.Context, name, password, ipAddress string) (*dto.AccountDTO, error) {
+	targets := u.loginThrottle.targets(name, ipAddress)
+	if err := u.loginThrottle.check(ctx, targets); err != nil {
+		return nil, err
+	}
+
+	var account *entity.Account
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		account, err = u.accountRepo.FindOneByNameAndDeletedAtAfter(ctx, name, time.Now().Add(-u.restorePeriod))
 		if err != nil {
 			return err
 		}
 		if account == nil {
-			return nil
+			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, "failed to restore account")
 		}
 
-		if err := account.VerifyPassword(password); err != nil {
+		if err := account.VerifyPassword(password, u.passwordHasher); err != nil {
 			return err
 		}
 
-		return u.accountRepo.Delete(ctx, account)
-	})
+		if err := u.loginThrottle.reset(ctx, targets); err != nil {
+			return err
+		}
+
+		return u.accountRepo.Restore(ctx, account)
+	}); err != nil {
+		if stderr.Is(err, ErrAccountNotFound) || stderr.Is(err, entity.ErrAccountPasswordIncorrect) {
+			if err := u.loginThrottle.record(ctx, targets); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+
+	return mapper.ToAccountDTO(account), nil
+}
+
+// NOTE: 漏洩したトークンを無効化するため, 呼び出し元以外のセッションを失効させ呼び出し元のトークンも再発行する.
+func (u *accountUsecase) revokeOtherSessions(ctx context.Context, accountID, sessionID uuid.UUID) (*entity.Session, error) {
+	session, err := u.sessionRepo.FindOneByIDAndAccountID(ctx, sessionID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, errors.Wrap(ErrSessionNotFound, errors.CodeUnauthenticated, "failed to revoke sessions")
+	}
+
+	if err := u.sessionRepo.DeleteByAccountIDExcludingID(ctx, accountID, session.ID); err != nil {
+		return nil, err
+	}
+
+	if err := session.GenerateToken(); err != nil {
+		return nil, err
+	}
+
+	if err := u.sessionRepo.Update(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// NOTE: 名前の使用可否を判定した後に呼び出すため, 同名のアカウントが残っている場合は保持期間を経過して物理削除を待っているものに限られる.
+func (u *accountUsecase) releaseExpiredName(ctx context.Context, name string) error {
+	account, err := u.accountRepo.FindOneByNameIncludingDeleted(ctx, name)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return nil
+	}
+
+	return u.accountServ.Release(ctx, account)
 }
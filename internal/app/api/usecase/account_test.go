@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/atsumarukun/holos-api-pkg/errors"
 	"github.com/google/go-cmp/cmp"
@@ -12,6 +13,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/hasher"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/service"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
@@ -21,11 +23,250 @@ import (
 	mockServ "github.com/atsumarukun/holos-account-api/test/mock/domain/service"
 )
 
+var (
+	passwordPolicy = entity.PasswordPolicy{
+		MinLength: 8,
+		MaxLength: 72,
+	}
+	argon2idParams = hasher.Argon2idParams{
+		Memory:      64,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+	passwordHasher       = hasher.NewPasswordHasher(hasher.NewArgon2idHasher(argon2idParams), hasher.NewBcryptHasher(10))
+	accountRestorePeriod = time.Hour * 24 * 30
+)
+
+func TestAccount_Get(t *testing.T) {
+	account := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		Email:     "name@example.com",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	accountDTO := &dto.AccountDTO{
+		ID:        account.ID,
+		Name:      account.Name,
+		Email:     account.Email,
+		CreatedAt: account.CreatedAt,
+		UpdatedAt: account.UpdatedAt,
+	}
+
+	tests := []struct {
+		name               string
+		expectResult       *dto.AccountDTO
+		expectSecurity     *dto.AccountSecurityDTO
+		expectError        error
+		setMockAccountRepo func(*mockRepo.MockAccountRepository)
+		setMockTOTPRepo    func(*mockRepo.MockTOTPRepository)
+		setMockPasskeyRepo func(*mockRepo.MockPasskeyRepository)
+	}{
+		{
+			name:           "successfully got",
+			expectResult:   accountDTO,
+			expectSecurity: &dto.AccountSecurityDTO{TwoFactorEnabled: true, PasskeyCount: 1},
+			expectError:    nil,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *mockRepo.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountID(gomock.Any(), account.ID).
+					Return(&entity.TOTP{AccountID: account.ID, Enabled: true}, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *mockRepo.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), account.ID).
+					Return([]*entity.Passkey{{ID: uuid.New(), AccountID: account.ID}}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "two factor not enabled",
+			expectResult:   accountDTO,
+			expectSecurity: &dto.AccountSecurityDTO{TwoFactorEnabled: false, PasskeyCount: 0},
+			expectError:    nil,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *mockRepo.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountID(gomock.Any(), account.ID).
+					Return(&entity.TOTP{AccountID: account.ID, Enabled: false}, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *mockRepo.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "two factor not registered",
+			expectResult:   accountDTO,
+			expectSecurity: &dto.AccountSecurityDTO{TwoFactorEnabled: false, PasskeyCount: 0},
+			expectError:    nil,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *mockRepo.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *mockRepo.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "account not found",
+			expectResult:   nil,
+			expectSecurity: nil,
+			expectError:    usecase.ErrAccountNotFound,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockTOTPRepo:    func(*mockRepo.MockTOTPRepository) {},
+			setMockPasskeyRepo: func(*mockRepo.MockPasskeyRepository) {},
+		},
+		{
+			name:           "find account error",
+			expectResult:   nil,
+			expectSecurity: nil,
+			expectError:    sql.ErrConnDone,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find account by id")).
+					Times(1)
+			},
+			setMockTOTPRepo:    func(*mockRepo.MockTOTPRepository) {},
+			setMockPasskeyRepo: func(*mockRepo.MockPasskeyRepository) {},
+		},
+		{
+			name:           "find totp error",
+			expectResult:   nil,
+			expectSecurity: nil,
+			expectError:    sql.ErrConnDone,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *mockRepo.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountID(gomock.Any(), account.ID).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find totp by account id")).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(*mockRepo.MockPasskeyRepository) {},
+		},
+		{
+			name:           "find passkeys error",
+			expectResult:   nil,
+			expectSecurity: nil,
+			expectError:    sql.ErrConnDone,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *mockRepo.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *mockRepo.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), account.ID).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find passkeys by account id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			accountRepo := mockRepo.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			totpRepo := mockRepo.NewMockTOTPRepository(ctrl)
+			tt.setMockTOTPRepo(totpRepo)
+
+			passkeyRepo := mockRepo.NewMockPasskeyRepository(ctrl)
+			tt.setMockPasskeyRepo(passkeyRepo)
+
+			uc := usecase.NewAccountUsecase(nil, accountRepo, nil, totpRepo, passkeyRepo, nil, nil, passwordPolicy, passwordHasher, accountRestorePeriod, accountLoginPolicy, ipLoginPolicy)
+			result, security, err := uc.Get(ctx, account.ID)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(tt.expectResult, result); diff != "" {
+				t.Error(diff)
+			}
+
+			if diff := cmp.Diff(tt.expectSecurity, security); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
 func TestAccount_Create(t *testing.T) {
 	accountDTO := &dto.AccountDTO{
-		ID:       uuid.New(),
-		Name:     "name",
-		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		ID:   uuid.New(),
+		Name: "name",
+	}
+
+	expiredAccount := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		DeletedAt: time.Now().Add(-time.Hour * 24 * 31),
 	}
 
 	tests := []struct {
@@ -56,6 +297,11 @@ func TestAccount_Create(t *testing.T) {
 					Times(1)
 			},
 			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByNameIncludingDeleted(gomock.Any(), "name").
+					Return(nil, nil).
+					Times(1)
 				accountRepo.
 					EXPECT().
 					Create(gomock.Any(), gomock.Any()).
@@ -87,7 +333,7 @@ func TestAccount_Create(t *testing.T) {
 			inputPassword:         "",
 			inputConfirmPassword:  "",
 			expectResult:          nil,
-			expectError:           entity.ErrAccountPasswordInvalidLength,
+			expectError:           entity.ErrPasswordTooShort,
 			setMockTransactionObj: func(*transaction.MockTransactionObject) {},
 			setMockAccountRepo:    func(*mockRepo.MockAccountRepository) {},
 			setMockAccountServ:    func(*mockServ.MockAccountService) {},
@@ -117,6 +363,83 @@ func TestAccount_Create(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			name:                 "expired account released",
+			inputName:            "name",
+			inputPassword:        "password",
+			inputConfirmPassword: "password",
+			expectResult:         accountDTO,
+			expectError:          nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByNameIncludingDeleted(gomock.Any(), "name").
+					Return(expiredAccount, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *mockServ.MockAccountService) {
+				accountServ.
+					EXPECT().
+					Exists(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+				accountServ.
+					EXPECT().
+					Release(gomock.Any(), expiredAccount).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                 "release error",
+			inputName:            "name",
+			inputPassword:        "password",
+			inputConfirmPassword: "password",
+			expectResult:         nil,
+			expectError:          sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByNameIncludingDeleted(gomock.Any(), "name").
+					Return(expiredAccount, nil).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *mockServ.MockAccountService) {
+				accountServ.
+					EXPECT().
+					Exists(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+				accountServ.
+					EXPECT().
+					Release(gomock.Any(), expiredAccount).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to save account name tombstone")).
+					Times(1)
+			},
+		},
 		{
 			name:                 "create error",
 			inputName:            "name",
@@ -134,6 +457,11 @@ func TestAccount_Create(t *testing.T) {
 					Times(1)
 			},
 			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByNameIncludingDeleted(gomock.Any(), "name").
+					Return(nil, nil).
+					Times(1)
 				accountRepo.
 					EXPECT().
 					Create(gomock.Any(), gomock.Any()).
@@ -165,12 +493,12 @@ func TestAccount_Create(t *testing.T) {
 			accountServ := mockServ.NewMockAccountService(ctrl)
 			tt.setMockAccountServ(accountServ)
 
-			uc := usecase.NewAccountUsecase(transactionObj, accountRepo, accountServ)
+			uc := usecase.NewAccountUsecase(transactionObj, accountRepo, nil, nil, nil, nil, accountServ, passwordPolicy, passwordHasher, accountRestorePeriod, accountLoginPolicy, ipLoginPolicy)
 			result, err := uc.Create(ctx, tt.inputName, tt.inputPassword, tt.inputConfirmPassword)
 			assert.Error(t, err, tt.expectError)
 
 			opts := cmp.Options{
-				cmpopts.IgnoreFields(dto.AccountDTO{}, "ID", "Password"),
+				cmpopts.IgnoreFields(dto.AccountDTO{}, "ID", "CreatedAt", "UpdatedAt"),
 			}
 			if diff := cmp.Diff(tt.expectResult, result, opts...); diff != "" {
 				t.Error(diff)
@@ -186,9 +514,15 @@ func TestAccount_UpdateName(t *testing.T) {
 		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
 	}
 	accountDTO := &dto.AccountDTO{
-		ID:       account.ID,
-		Name:     "update",
-		Password: account.Password,
+		ID:   account.ID,
+		Name: "update",
+	}
+
+	expiredAccount := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "update",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		DeletedAt: time.Now().Add(-time.Hour * 24 * 31),
 	}
 
 	tests := []struct {
@@ -224,6 +558,11 @@ func TestAccount_UpdateName(t *testing.T) {
 					FindOneByID(gomock.Any(), gomock.Any()).
 					Return(account, nil).
 					Times(1)
+				accountRepo.
+					EXPECT().
+					FindOneByNameIncludingDeleted(gomock.Any(), "update").
+					Return(nil, nil).
+					Times(1)
 				accountRepo.
 					EXPECT().
 					Update(gomock.Any(), gomock.Any()).
@@ -395,12 +734,12 @@ func TestAccount_UpdateName(t *testing.T) {
 			},
 		},
 		{
-			name:          "update error",
+			name:          "expired account released",
 			inputID:       account.ID,
 			inputPassword: "password",
 			inputName:     "update",
-			expectResult:  nil,
-			expectError:   sql.ErrConnDone,
+			expectResult:  accountDTO,
+			expectError:   nil,
 			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
 				transactionObj.
 					EXPECT().
@@ -414,12 +753,17 @@ func TestAccount_UpdateName(t *testing.T) {
 				accountRepo.
 					EXPECT().
 					FindOneByID(gomock.Any(), gomock.Any()).
-					Return(account, nil).
+					Return(&entity.Account{ID: account.ID, Name: "name", Password: account.Password}, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					FindOneByNameIncludingDeleted(gomock.Any(), "update").
+					Return(expiredAccount, nil).
 					Times(1)
 				accountRepo.
 					EXPECT().
 					Update(gomock.Any(), gomock.Any()).
-					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to update account")).
+					Return(nil).
 					Times(1)
 			},
 			setMockAccountServ: func(accountServ *mockServ.MockAccountService) {
@@ -428,66 +772,173 @@ func TestAccount_UpdateName(t *testing.T) {
 					Exists(gomock.Any(), gomock.Any()).
 					Return(nil).
 					Times(1)
+				accountServ.
+					EXPECT().
+					Release(gomock.Any(), expiredAccount).
+					Return(nil).
+					Times(1)
 			},
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			ctx := t.Context()
-
-			transactionObj := transaction.NewMockTransactionObject(ctrl)
-			tt.setMockTransactionObj(transactionObj)
-
-			accountRepo := mockRepo.NewMockAccountRepository(ctrl)
-			tt.setMockAccountRepo(accountRepo)
-
-			accountServ := mockServ.NewMockAccountService(ctrl)
-			tt.setMockAccountServ(accountServ)
-
-			uc := usecase.NewAccountUsecase(transactionObj, accountRepo, accountServ)
-			result, err := uc.UpdateName(ctx, tt.inputID, tt.inputPassword, tt.inputName)
-			assert.Error(t, err, tt.expectError)
-
-			if diff := cmp.Diff(tt.expectResult, result); diff != "" {
-				t.Error(diff)
-			}
-		})
-	}
-}
-
-func TestAccount_UpdatePassword(t *testing.T) {
-	account := &entity.Account{
-		ID:       uuid.New(),
-		Name:     "name",
-		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		{
+			name:          "release error",
+			inputID:       account.ID,
+			inputPassword: "password",
+			inputName:     "update",
+			expectResult:  nil,
+			expectError:   sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), gomock.Any()).
+					Return(&entity.Account{ID: account.ID, Name: "name", Password: account.Password}, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					FindOneByNameIncludingDeleted(gomock.Any(), "update").
+					Return(expiredAccount, nil).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *mockServ.MockAccountService) {
+				accountServ.
+					EXPECT().
+					Exists(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+				accountServ.
+					EXPECT().
+					Release(gomock.Any(), expiredAccount).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to save account name tombstone")).
+					Times(1)
+			},
+		},
+		{
+			name:          "update error",
+			inputID:       account.ID,
+			inputPassword: "password",
+			inputName:     "update",
+			expectResult:  nil,
+			expectError:   sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), gomock.Any()).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					FindOneByNameIncludingDeleted(gomock.Any(), "update").
+					Return(nil, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to update account")).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *mockServ.MockAccountService) {
+				accountServ.
+					EXPECT().
+					Exists(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := mockRepo.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			accountServ := mockServ.NewMockAccountService(ctrl)
+			tt.setMockAccountServ(accountServ)
+
+			uc := usecase.NewAccountUsecase(transactionObj, accountRepo, nil, nil, nil, nil, accountServ, passwordPolicy, passwordHasher, accountRestorePeriod, accountLoginPolicy, ipLoginPolicy)
+			result, err := uc.UpdateName(ctx, tt.inputID, tt.inputPassword, tt.inputName)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(tt.expectResult, result, cmpopts.IgnoreFields(dto.AccountDTO{}, "UpdatedAt")); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAccount_UpdatePassword(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
 	}
 	accountDTO := &dto.AccountDTO{
-		ID:       account.ID,
-		Name:     account.Name,
-		Password: "$2a$10$aAjIc6dW5T07F3WzoWGnq.qGO2rMwoVAjDVeH6/t86AsIs/uIgMAG	",
+		ID:   account.ID,
+		Name: account.Name,
+	}
+	session := &entity.Session{
+		ID:               uuid.New(),
+		AccountID:        account.ID,
+		UserAgent:        "Mozilla/5.0",
+		IPAddress:        "192.0.2.1",
+		RefreshExpiresAt: time.Now().Add(time.Hour * 24),
+		CreatedAt:        time.Now(),
+	}
+	sessionDTO := &dto.SessionDTO{
+		ID:               session.ID,
+		AccountID:        session.AccountID,
+		UserAgent:        session.UserAgent,
+		IPAddress:        session.IPAddress,
+		RefreshExpiresAt: session.RefreshExpiresAt,
+		CreatedAt:        session.CreatedAt,
 	}
 
 	tests := []struct {
 		name                  string
 		inputID               uuid.UUID
+		inputSessionID        uuid.UUID
 		inputPassword         string
 		inputNewPassword      string
 		inputConfirmPassword  string
 		expectResult          *dto.AccountDTO
+		expectSession         *dto.SessionDTO
 		expectError           error
 		setMockTransactionObj func(*transaction.MockTransactionObject)
 		setMockAccountRepo    func(*mockRepo.MockAccountRepository)
+		setMockSessionRepo    func(*mockRepo.MockSessionRepository)
 	}{
 		{
 			name:                 "successfully updated",
 			inputID:              account.ID,
+			inputSessionID:       session.ID,
 			inputPassword:        "password",
 			inputNewPassword:     "password",
 			inputConfirmPassword: "password",
 			expectResult:         accountDTO,
+			expectSession:        sessionDTO,
 			expectError:          nil,
 			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
 				transactionObj.
@@ -510,10 +961,28 @@ func TestAccount_UpdatePassword(t *testing.T) {
 					Return(nil).
 					Times(1)
 			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					FindOneByIDAndAccountID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(session, nil).
+					Times(1)
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountIDExcludingID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+				sessionRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
 		},
 		{
 			name:                 "account not found",
 			inputID:              account.ID,
+			inputSessionID:       session.ID,
 			inputPassword:        "password",
 			inputNewPassword:     "password",
 			inputConfirmPassword: "password",
@@ -535,10 +1004,12 @@ func TestAccount_UpdatePassword(t *testing.T) {
 					Return(nil, nil).
 					Times(1)
 			},
+			setMockSessionRepo: func(*mockRepo.MockSessionRepository) {},
 		},
 		{
 			name:                 "authentication failed",
 			inputID:              account.ID,
+			inputSessionID:       session.ID,
 			inputPassword:        "PASSWORD",
 			inputNewPassword:     "password",
 			inputConfirmPassword: "password",
@@ -560,15 +1031,17 @@ func TestAccount_UpdatePassword(t *testing.T) {
 					Return(account, nil).
 					Times(1)
 			},
+			setMockSessionRepo: func(*mockRepo.MockSessionRepository) {},
 		},
 		{
 			name:                 "invalid password",
 			inputID:              account.ID,
+			inputSessionID:       session.ID,
 			inputPassword:        "password",
 			inputNewPassword:     "",
 			inputConfirmPassword: "",
 			expectResult:         nil,
-			expectError:          entity.ErrAccountPasswordInvalidLength,
+			expectError:          entity.ErrPasswordTooShort,
 			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
 				transactionObj.
 					EXPECT().
@@ -585,10 +1058,12 @@ func TestAccount_UpdatePassword(t *testing.T) {
 					Return(account, nil).
 					Times(1)
 			},
+			setMockSessionRepo: func(*mockRepo.MockSessionRepository) {},
 		},
 		{
 			name:                 "find error",
 			inputID:              account.ID,
+			inputSessionID:       session.ID,
 			inputPassword:        "password",
 			inputNewPassword:     "password",
 			inputConfirmPassword: "password",
@@ -610,10 +1085,12 @@ func TestAccount_UpdatePassword(t *testing.T) {
 					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to find account by id")).
 					Times(1)
 			},
+			setMockSessionRepo: func(*mockRepo.MockSessionRepository) {},
 		},
 		{
 			name:                 "update error",
 			inputID:              account.ID,
+			inputSessionID:       session.ID,
 			inputPassword:        "password",
 			inputNewPassword:     "password",
 			inputConfirmPassword: "password",
@@ -640,6 +1117,178 @@ func TestAccount_UpdatePassword(t *testing.T) {
 					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to update account")).
 					Times(1)
 			},
+			setMockSessionRepo: func(*mockRepo.MockSessionRepository) {},
+		},
+		{
+			name:                 "session not found",
+			inputID:              account.ID,
+			inputSessionID:       session.ID,
+			inputPassword:        "password",
+			inputNewPassword:     "password",
+			inputConfirmPassword: "password",
+			expectResult:         nil,
+			expectSession:        nil,
+			expectError:          usecase.ErrSessionNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), gomock.Any()).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					FindOneByIDAndAccountID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                 "find session error",
+			inputID:              account.ID,
+			inputSessionID:       session.ID,
+			inputPassword:        "password",
+			inputNewPassword:     "password",
+			inputConfirmPassword: "password",
+			expectResult:         nil,
+			expectSession:        nil,
+			expectError:          sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), gomock.Any()).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					FindOneByIDAndAccountID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to find session by id and account_id")).
+					Times(1)
+			},
+		},
+		{
+			name:                 "delete sessions error",
+			inputID:              account.ID,
+			inputSessionID:       session.ID,
+			inputPassword:        "password",
+			inputNewPassword:     "password",
+			inputConfirmPassword: "password",
+			expectResult:         nil,
+			expectSession:        nil,
+			expectError:          sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), gomock.Any()).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					FindOneByIDAndAccountID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(session, nil).
+					Times(1)
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountIDExcludingID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete sessions by account_id excluding id")).
+					Times(1)
+			},
+		},
+		{
+			name:                 "update session error",
+			inputID:              account.ID,
+			inputSessionID:       session.ID,
+			inputPassword:        "password",
+			inputNewPassword:     "password",
+			inputConfirmPassword: "password",
+			expectResult:         nil,
+			expectSession:        nil,
+			expectError:          sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), gomock.Any()).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					FindOneByIDAndAccountID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(session, nil).
+					Times(1)
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountIDExcludingID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+				sessionRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to update session")).
+					Times(1)
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -655,16 +1304,23 @@ func TestAccount_UpdatePassword(t *testing.T) {
 			accountRepo := mockRepo.NewMockAccountRepository(ctrl)
 			tt.setMockAccountRepo(accountRepo)
 
-			uc := usecase.NewAccountUsecase(transactionObj, accountRepo, nil)
-			result, err := uc.UpdatePassword(ctx, tt.inputID, tt.inputPassword, tt.inputNewPassword, tt.inputConfirmPassword)
+			sessionRepo := mockRepo.NewMockSessionRepository(ctrl)
+			tt.setMockSessionRepo(sessionRepo)
+
+			uc := usecase.NewAccountUsecase(transactionObj, accountRepo, sessionRepo, nil, nil, nil, nil, passwordPolicy, passwordHasher, accountRestorePeriod, accountLoginPolicy, ipLoginPolicy)
+			result, resultSession, err := uc.UpdatePassword(ctx, tt.inputID, tt.inputSessionID, tt.inputPassword, tt.inputNewPassword, tt.inputConfirmPassword)
 			assert.Error(t, err, tt.expectError)
 
 			opts := cmp.Options{
-				cmpopts.IgnoreFields(dto.AccountDTO{}, "Password"),
+				cmpopts.IgnoreFields(dto.AccountDTO{}, "UpdatedAt"),
+				cmpopts.IgnoreFields(dto.SessionDTO{}, "Token", "RefreshToken", "ExpiresAt", "IssuedAt", "LastUsedAt"),
 			}
 			if diff := cmp.Diff(tt.expectResult, result, opts...); diff != "" {
 				t.Error(diff)
 			}
+			if diff := cmp.Diff(tt.expectSession, resultSession, opts...); diff != "" {
+				t.Error(diff)
+			}
 		})
 	}
 }
@@ -680,14 +1336,17 @@ func TestAccount_Delete(t *testing.T) {
 		name                  string
 		inputID               uuid.UUID
 		inputPassword         string
+		expectResult          *dto.DeletedAccountDTO
 		expectError           error
 		setMockTransactionObj func(*transaction.MockTransactionObject)
 		setMockAccountRepo    func(*mockRepo.MockAccountRepository)
+		setMockSessionRepo    func(*mockRepo.MockSessionRepository)
 	}{
 		{
 			name:          "successfully deleted",
 			inputID:       account.ID,
 			inputPassword: "password",
+			expectResult:  &dto.DeletedAccountDTO{Name: "name"},
 			expectError:   nil,
 			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
 				transactionObj.
@@ -710,12 +1369,20 @@ func TestAccount_Delete(t *testing.T) {
 					Return(nil).
 					Times(1)
 			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(nil).
+					Times(1)
+			},
 		},
 		{
 			name:          "account not found",
 			inputID:       account.ID,
 			inputPassword: "password",
-			expectError:   nil,
+			expectResult:  nil,
+			expectError:   usecase.ErrAccountNotFound,
 			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
 				transactionObj.
 					EXPECT().
@@ -732,11 +1399,13 @@ func TestAccount_Delete(t *testing.T) {
 					Return(nil, nil).
 					Times(1)
 			},
+			setMockSessionRepo: func(*mockRepo.MockSessionRepository) {},
 		},
 		{
 			name:          "authentication failed",
 			inputID:       account.ID,
 			inputPassword: "PASSWORD",
+			expectResult:  nil,
 			expectError:   entity.ErrAccountPasswordIncorrect,
 			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
 				transactionObj.
@@ -754,11 +1423,13 @@ func TestAccount_Delete(t *testing.T) {
 					Return(account, nil).
 					Times(1)
 			},
+			setMockSessionRepo: func(*mockRepo.MockSessionRepository) {},
 		},
 		{
 			name:          "find error",
 			inputID:       account.ID,
 			inputPassword: "password",
+			expectResult:  nil,
 			expectError:   sql.ErrConnDone,
 			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
 				transactionObj.
@@ -776,11 +1447,13 @@ func TestAccount_Delete(t *testing.T) {
 					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to find account by id")).
 					Times(1)
 			},
+			setMockSessionRepo: func(*mockRepo.MockSessionRepository) {},
 		},
 		{
 			name:          "delete error",
 			inputID:       account.ID,
 			inputPassword: "password",
+			expectResult:  nil,
 			expectError:   sql.ErrConnDone,
 			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
 				transactionObj.
@@ -803,6 +1476,42 @@ func TestAccount_Delete(t *testing.T) {
 					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete account")).
 					Times(1)
 			},
+			setMockSessionRepo: func(*mockRepo.MockSessionRepository) {},
+		},
+		{
+			name:          "delete sessions error",
+			inputID:       account.ID,
+			inputPassword: "password",
+			expectResult:  nil,
+			expectError:   sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), gomock.Any()).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Delete(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete sessions by account_id")).
+					Times(1)
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -818,9 +1527,312 @@ func TestAccount_Delete(t *testing.T) {
 			accountRepo := mockRepo.NewMockAccountRepository(ctrl)
 			tt.setMockAccountRepo(accountRepo)
 
-			uc := usecase.NewAccountUsecase(transactionObj, accountRepo, nil)
-			err := uc.Delete(ctx, tt.inputID, tt.inputPassword)
+			sessionRepo := mockRepo.NewMockSessionRepository(ctrl)
+			tt.setMockSessionRepo(sessionRepo)
+
+			uc := usecase.NewAccountUsecase(transactionObj, accountRepo, sessionRepo, nil, nil, nil, nil, passwordPolicy, passwordHasher, accountRestorePeriod, accountLoginPolicy, ipLoginPolicy)
+			result, err := uc.Delete(ctx, tt.inputID, tt.inputPassword)
 			assert.Error(t, err, tt.expectError)
+
+			if result != nil {
+				if deadline := time.Now().Add(accountRestorePeriod); result.RestoreDeadline.After(deadline) || result.RestoreDeadline.Before(deadline.Add(-time.Minute)) {
+					t.Errorf("unexpected restore deadline: %v", result.RestoreDeadline)
+				}
+			}
+
+			opts := cmp.Options{
+				cmpopts.IgnoreFields(dto.DeletedAccountDTO{}, "RestoreDeadline"),
+			}
+			if diff := cmp.Diff(tt.expectResult, result, opts...); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAccount_Restore(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+	lockedLoginFailure := &entity.LoginFailure{
+		TargetType:   entity.LoginFailureTargetAccount,
+		Target:       "name",
+		Count:        5,
+		LockedUntil:  time.Now().Add(time.Minute),
+		LastFailedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name                    string
+		inputName               string
+		inputPassword           string
+		inputIPAddress          string
+		expectResult            *dto.AccountDTO
+		expectError             error
+		setMockTransactionObj   func(*transaction.MockTransactionObject)
+		setMockAccountRepo      func(*mockRepo.MockAccountRepository)
+		setMockLoginFailureRepo func(*mockRepo.MockLoginFailureRepository)
+	}{
+		{
+			name:           "successfully restored",
+			inputName:      "name",
+			inputPassword:  "password",
+			inputIPAddress: "192.0.2.1",
+			expectResult:   &dto.AccountDTO{ID: account.ID, Name: "name"},
+			expectError:    nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByNameAndDeletedAtAfter(gomock.Any(), "name", gomock.Any()).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Restore(gomock.Any(), account).
+					Return(nil).
+					Times(1)
+			},
+			setMockLoginFailureRepo: func(loginFailureRepo *mockRepo.MockLoginFailureRepository) {
+				loginFailureRepo.
+					EXPECT().
+					FindOneByTarget(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(2)
+				loginFailureRepo.
+					EXPECT().
+					Delete(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "login locked",
+			inputName:             "name",
+			inputPassword:         "password",
+			inputIPAddress:        "192.0.2.1",
+			expectResult:          nil,
+			expectError:           entity.ErrLoginLocked,
+			setMockTransactionObj: func(*transaction.MockTransactionObject) {},
+			setMockAccountRepo:    func(*mockRepo.MockAccountRepository) {},
+			setMockLoginFailureRepo: func(loginFailureRepo *mockRepo.MockLoginFailureRepository) {
+				loginFailureRepo.
+					EXPECT().
+					FindOneByTarget(gomock.Any(), entity.LoginFailureTargetAccount, "name").
+					Return(lockedLoginFailure, nil).
+					Times(1)
+				loginFailureRepo.
+					EXPECT().
+					FindOneByTarget(gomock.Any(), entity.LoginFailureTargetIP, "192.0.2.1").
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "account not found",
+			inputName:      "name",
+			inputPassword:  "password",
+			inputIPAddress: "192.0.2.1",
+			expectResult:   nil,
+			expectError:    usecase.ErrAccountNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(2)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByNameAndDeletedAtAfter(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockLoginFailureRepo: func(loginFailureRepo *mockRepo.MockLoginFailureRepository) {
+				loginFailureRepo.
+					EXPECT().
+					FindOneByTarget(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(2)
+				loginFailureRepo.
+					EXPECT().
+					Ensure(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(2)
+				loginFailureRepo.
+					EXPECT().
+					FindOneByTargetForUpdate(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, targetType, target string) (*entity.LoginFailure, error) {
+						return entity.NewLoginFailure(targetType, target), nil
+					}).
+					Times(2)
+				loginFailureRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(2)
+			},
+		},
+		{
+			name:           "authentication failed",
+			inputName:      "name",
+			inputPassword:  "PASSWORD",
+			inputIPAddress: "192.0.2.1",
+			expectResult:   nil,
+			expectError:    entity.ErrAccountPasswordIncorrect,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(2)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByNameAndDeletedAtAfter(gomock.Any(), "name", gomock.Any()).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockLoginFailureRepo: func(loginFailureRepo *mockRepo.MockLoginFailureRepository) {
+				loginFailureRepo.
+					EXPECT().
+					FindOneByTarget(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(2)
+				loginFailureRepo.
+					EXPECT().
+					Ensure(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(2)
+				loginFailureRepo.
+					EXPECT().
+					FindOneByTargetForUpdate(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, targetType, target string) (*entity.LoginFailure, error) {
+						return entity.NewLoginFailure(targetType, target), nil
+					}).
+					Times(2)
+				loginFailureRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(2)
+			},
+		},
+		{
+			name:           "find error",
+			inputName:      "name",
+			inputPassword:  "password",
+			inputIPAddress: "192.0.2.1",
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByNameAndDeletedAtAfter(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find account by name and deleted_at")).
+					Times(1)
+			},
+			setMockLoginFailureRepo: func(loginFailureRepo *mockRepo.MockLoginFailureRepository) {
+				loginFailureRepo.
+					EXPECT().
+					FindOneByTarget(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(2)
+			},
+		},
+		{
+			name:           "restore error",
+			inputName:      "name",
+			inputPassword:  "password",
+			inputIPAddress: "192.0.2.1",
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByNameAndDeletedAtAfter(gomock.Any(), "name", gomock.Any()).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Restore(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to restore account")).
+					Times(1)
+			},
+			setMockLoginFailureRepo: func(loginFailureRepo *mockRepo.MockLoginFailureRepository) {
+				loginFailureRepo.
+					EXPECT().
+					FindOneByTarget(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(2)
+				loginFailureRepo.
+					EXPECT().
+					Delete(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := mockRepo.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			loginFailureRepo := mockRepo.NewMockLoginFailureRepository(ctrl)
+			tt.setMockLoginFailureRepo(loginFailureRepo)
+
+			uc := usecase.NewAccountUsecase(transactionObj, accountRepo, nil, nil, nil, loginFailureRepo, nil, passwordPolicy, passwordHasher, accountRestorePeriod, accountLoginPolicy, ipLoginPolicy)
+			result, err := uc.Restore(ctx, tt.inputName, tt.inputPassword, tt.inputIPAddress)
+			assert.Error(t, err, tt.expectError)
+
+			opts := cmp.Options{
+				cmpopts.IgnoreFields(dto.AccountDTO{}, "UpdatedAt"),
+			}
+			if diff := cmp.Diff(tt.expectResult, result, opts...); diff != "" {
+				t.Error(diff)
+			}
 		})
 	}
 }
@@ -0,0 +1,266 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../test/mock/$GOPACKAGE/$GOFILE
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	stderr "errors"
+	"strings"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/mailer"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/mapper"
+)
+
+const (
+	defaultAccountSearchLimit = 20
+	maxAccountSearchLimit     = 100
+)
+
+var (
+	ErrInvalidAccountCursor       = stderr.New("invalid cursor")
+	ErrInvalidAccountSearchLimit  = stderr.New("limit must be between 1 and 100")
+	ErrAccountEmailNotSet         = stderr.New("account email is not set")
+	ErrAccountNotDeletedOrExpired = stderr.New("account is not deleted or restore period has expired")
+)
+
+type AdminAccountUsecase interface {
+	GetAll(context.Context, *dto.AccountSearchDTO) (*dto.AccountPageDTO, error)
+	Get(context.Context, uuid.UUID) (*dto.AccountDTO, *dto.AccountSecurityDTO, error)
+	ResetPassword(context.Context, uuid.UUID) error
+	RevokeSessions(context.Context, uuid.UUID) error
+	Delete(context.Context, uuid.UUID) error
+	Restore(context.Context, uuid.UUID) error
+}
+
+type adminAccountUsecase struct {
+	transactionObj    transaction.TransactionObject
+	accountRepo       repository.AccountRepository
+	sessionRepo       repository.SessionRepository
+	totpRepo          repository.TOTPRepository
+	passkeyRepo       repository.PasskeyRepository
+	roleRepo          repository.RoleRepository
+	passwordResetRepo repository.PasswordResetRepository
+	mailer            mailer.Mailer
+	restorePeriod     time.Duration
+}
+
+func NewAdminAccountUsecase(
+	transactionObj transaction.TransactionObject,
+	accountRepo repository.AccountRepository,
+	sessionRepo repository.SessionRepository,
+	totpRepo repository.TOTPRepository,
+	passkeyRepo repository.PasskeyRepository,
+	roleRepo repository.RoleRepository,
+	passwordResetRepo repository.PasswordResetRepository,
+	mailer mailer.Mailer,
+	restorePeriod time.Duration,
+) AdminAccountUsecase {
+	return &adminAccountUsecase{
+		transactionObj:    transactionObj,
+		accountRepo:       accountRepo,
+		sessionRepo:       sessionRepo,
+		totpRepo:          totpRepo,
+		passkeyRepo:       passkeyRepo,
+		roleRepo:          roleRepo,
+		passwordResetRepo: passwordResetRepo,
+		mailer:            mailer,
+		restorePeriod:     restorePeriod,
+	}
+}
+
+// NOTE: 続きのアカウントが存在するかを判定するため, 指定された件数より1件多く取得する.
+func (u *adminAccountUsecase) GetAll(ctx context.Context, search *dto.AccountSearchDTO) (*dto.AccountPageDTO, error) {
+	const errMessage = "failed to get accounts"
+
+	limit := search.Limit
+	if limit == 0 {
+		limit = defaultAccountSearchLimit
+	}
+	if limit < 0 || maxAccountSearchLimit < limit {
+		return nil, errors.Wrap(ErrInvalidAccountSearchLimit, errors.CodeInvalidInput, errMessage)
+	}
+
+	condition := repository.AccountSearchCondition{
+		NamePrefix:    search.NamePrefix,
+		Deleted:       search.Deleted,
+		CreatedAfter:  search.CreatedAfter,
+		CreatedBefore: search.CreatedBefore,
+		Limit:         limit + 1,
+	}
+	if search.Cursor != "" {
+		cursor, err := decodeAccountCursor(search.Cursor)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.CodeBadRequest, errMessage)
+		}
+		condition.Cursor = cursor
+	}
+
+	accounts, err := u.accountRepo.Search(ctx, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if limit < len(accounts) {
+		accounts = accounts[:limit]
+		nextCursor = encodeAccountCursor(accounts[limit-1])
+	}
+
+	return mapper.ToAccountPageDTO(accounts, nextCursor), nil
+}
+
+func (u *adminAccountUsecase) Get(ctx context.Context, id uuid.UUID) (*dto.AccountDTO, *dto.AccountSecurityDTO, error) {
+	account, err := u.accountRepo.FindOneByIDIncludingDeleted(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if account == nil {
+		return nil, nil, errors.Wrap(ErrAccountNotFound, errors.CodeNotFound, "failed to get account")
+	}
+
+	account.Roles, err = u.roleRepo.FindByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totp, err := u.totpRepo.FindOneByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	passkeys, err := u.passkeyRepo.FindByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mapper.ToAccountDTO(account), mapper.ToAccountSecurityDTO(totp, passkeys), nil
+}
+
+// NOTE: 漏洩が疑われる場合を想定し, 従来のパスワードを無効化して再設定用のメールを送信すると同時にセッションを全て失効させる.
+// 確認済みのメールアドレスが設定されていないアカウントは再設定できないため受け付けない.
+// セッションの失効が送信結果に左右されないよう, メーラーには非同期に送信するものを渡す.
+func (u *adminAccountUsecase) ResetPassword(ctx context.Context, id uuid.UUID) error {
+	const errMessage = "failed to reset account password"
+
+	var mail *mailer.Mail
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		account, err := u.accountRepo.FindOneByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrAccountNotFound, errors.CodeNotFound, errMessage)
+		}
+		if account.Email == "" {
+			return errors.Wrap(ErrAccountEmailNotSet, errors.CodeConstraintViolation, errMessage)
+		}
+
+		account.InvalidatePassword()
+		if err := u.accountRepo.Update(ctx, account); err != nil {
+			return err
+		}
+
+		reset, err := entity.NewPasswordReset(account)
+		if err != nil {
+			return err
+		}
+
+		if err := u.passwordResetRepo.Save(ctx, reset); err != nil {
+			return err
+		}
+
+		if err := u.sessionRepo.DeleteByAccountID(ctx, account.ID); err != nil {
+			return err
+		}
+
+		mail = newPasswordResetMail(account, reset)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return u.mailer.Send(ctx, mail)
+}
+
+func (u *adminAccountUsecase) RevokeSessions(ctx context.Context, id uuid.UUID) error {
+	return u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		account, err := u.accountRepo.FindOneByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrAccountNotFound, errors.CodeNotFound, "failed to revoke account sessions")
+		}
+
+		return u.sessionRepo.DeleteByAccountID(ctx, account.ID)
+	})
+}
+
+func (u *adminAccountUsecase) Delete(ctx context.Context, id uuid.UUID) error {
+	return u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		account, err := u.accountRepo.FindOneByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrAccountNotFound, errors.CodeNotFound, "failed to delete account")
+		}
+
+		if err := u.accountRepo.Delete(ctx, account); err != nil {
+			return err
+		}
+
+		return u.sessionRepo.DeleteByAccountID(ctx, account.ID)
+	})
+}
+
+// NOTE: 本人による復元と同様に, 保持期間内の削除済みアカウントのみ復元できる.
+func (u *adminAccountUsecase) Restore(ctx context.Context, id uuid.UUID) error {
+	return u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		account, err := u.accountRepo.FindOneByIDAndDeletedAtAfter(ctx, id, time.Now().Add(-u.restorePeriod))
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrAccountNotDeletedOrExpired, errors.CodeNotFound, "failed to restore account")
+		}
+
+		return u.accountRepo.Restore(ctx, account)
+	})
+}
+
+// NOTE: カーソルは最後に返却したアカウントの作成日時とIDを連結し, URLで扱えるようBase64で符号化する.
+func encodeAccountCursor(account *entity.Account) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(account.CreatedAt.UTC().Format(time.RFC3339Nano) + "," + account.ID.String()))
+}
+
+func decodeAccountCursor(cursor string) (*repository.AccountCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidAccountCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(decoded), ",")
+	if !ok {
+		return nil, ErrInvalidAccountCursor
+	}
+
+	var result repository.AccountCursor
+	if result.CreatedAt, err = time.Parse(time.RFC3339Nano, createdAt); err != nil {
+		return nil, ErrInvalidAccountCursor
+	}
+	if result.ID, err = uuid.Parse(id); err != nil {
+		return nil, ErrInvalidAccountCursor
+	}
+
+	return &result, nil
+}
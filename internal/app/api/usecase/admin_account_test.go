@@ -0,0 +1,1064 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/mailer"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	mockMailer "github.com/atsumarukun/holos-account-api/test/mock/domain/pkg/mailer"
+	mockRepo "github.com/atsumarukun/holos-account-api/test/mock/domain/repository"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository/pkg/transaction"
+)
+
+func TestAdminAccount_GetAll(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	accounts := []*entity.Account{
+		{ID: uuid.New(), Name: "name1", CreatedAt: createdAt.Add(2 * time.Second), UpdatedAt: createdAt},
+		{ID: uuid.New(), Name: "name2", CreatedAt: createdAt.Add(time.Second), UpdatedAt: createdAt},
+		{ID: uuid.New(), Name: "name3", CreatedAt: createdAt, UpdatedAt: createdAt},
+	}
+	cursor := base64.RawURLEncoding.EncodeToString([]byte(accounts[1].CreatedAt.Format(time.RFC3339Nano) + "," + accounts[1].ID.String()))
+	deleted := true
+
+	tests := []struct {
+		name               string
+		inputSearch        *dto.AccountSearchDTO
+		expectResult       *dto.AccountPageDTO
+		expectError        error
+		setMockAccountRepo func(*mockRepo.MockAccountRepository)
+	}{
+		{
+			name:        "successfully got",
+			inputSearch: &dto.AccountSearchDTO{NamePrefix: "name", Deleted: &deleted},
+			expectResult: &dto.AccountPageDTO{
+				Accounts: []*dto.AccountDTO{
+					{ID: accounts[0].ID, Name: "name1", CreatedAt: accounts[0].CreatedAt, UpdatedAt: createdAt},
+					{ID: accounts[1].ID, Name: "name2", CreatedAt: accounts[1].CreatedAt, UpdatedAt: createdAt},
+					{ID: accounts[2].ID, Name: "name3", CreatedAt: accounts[2].CreatedAt, UpdatedAt: createdAt},
+				},
+			},
+			expectError: nil,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					Search(gomock.Any(), repository.AccountSearchCondition{NamePrefix: "name", Deleted: &deleted, Limit: 21}).
+					Return(accounts, nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "next page exists",
+			inputSearch: &dto.AccountSearchDTO{Limit: 2},
+			expectResult: &dto.AccountPageDTO{
+				Accounts: []*dto.AccountDTO{
+					{ID: accounts[0].ID, Name: "name1", CreatedAt: accounts[0].CreatedAt, UpdatedAt: createdAt},
+					{ID: accounts[1].ID, Name: "name2", CreatedAt: accounts[1].CreatedAt, UpdatedAt: createdAt},
+				},
+				NextCursor: cursor,
+			},
+			expectError: nil,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					Search(gomock.Any(), repository.AccountSearchCondition{Limit: 3}).
+					Return(accounts, nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "with cursor",
+			inputSearch: &dto.AccountSearchDTO{Cursor: cursor, Limit: 2},
+			expectResult: &dto.AccountPageDTO{
+				Accounts: []*dto.AccountDTO{
+					{ID: accounts[2].ID, Name: "name3", CreatedAt: accounts[2].CreatedAt, UpdatedAt: createdAt},
+				},
+			},
+			expectError: nil,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					Search(gomock.Any(), repository.AccountSearchCondition{
+						Cursor: &repository.AccountCursor{CreatedAt: accounts[1].CreatedAt, ID: accounts[1].ID},
+						Limit:  3,
+					}).
+					Return(accounts[2:], nil).
+					Times(1)
+			},
+		},
+		{
+			name:               "invalid cursor",
+			inputSearch:        &dto.AccountSearchDTO{Cursor: "invalid"},
+			expectResult:       nil,
+			expectError:        usecase.ErrInvalidAccountCursor,
+			setMockAccountRepo: func(*mockRepo.MockAccountRepository) {},
+		},
+		{
+			name:               "invalid limit",
+			inputSearch:        &dto.AccountSearchDTO{Limit: 101},
+			expectResult:       nil,
+			expectError:        usecase.ErrInvalidAccountSearchLimit,
+			setMockAccountRepo: func(*mockRepo.MockAccountRepository) {},
+		},
+		{
+			name:         "search error",
+			inputSearch:  &dto.AccountSearchDTO{},
+			expectResult: nil,
+			expectError:  sql.ErrConnDone,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					Search(gomock.Any(), repository.AccountSearchCondition{Limit: 21}).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to search accounts")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			accountRepo := mockRepo.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			uc := usecase.NewAdminAccountUsecase(nil, accountRepo, nil, nil, nil, nil, nil, nil, accountRestorePeriod)
+			result, err := uc.GetAll(ctx, tt.inputSearch)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(tt.expectResult, result); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAdminAccount_Get(t *testing.T) {
+	account := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		Email:     "name@example.com",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		DeletedAt: time.Now(),
+	}
+	role := &entity.Role{
+		ID:          uuid.New(),
+		Name:        entity.RoleNameAdmin,
+		Permissions: []entity.Permission{entity.PermissionAccountsRead},
+	}
+
+	tests := []struct {
+		name               string
+		expectResult       *dto.AccountDTO
+		expectSecurity     *dto.AccountSecurityDTO
+		expectError        error
+		setMockAccountRepo func(*mockRepo.MockAccountRepository)
+		setMockRoleRepo    func(*mockRepo.MockRoleRepository)
+		setMockTOTPRepo    func(*mockRepo.MockTOTPRepository)
+		setMockPasskeyRepo func(*mockRepo.MockPasskeyRepository)
+	}{
+		{
+			name: "successfully got",
+			expectResult: &dto.AccountDTO{
+				ID:          account.ID,
+				Name:        account.Name,
+				Email:       account.Email,
+				Roles:       []string{entity.RoleNameAdmin},
+				Permissions: []string{string(entity.PermissionAccountsRead)},
+				CreatedAt:   account.CreatedAt,
+				UpdatedAt:   account.UpdatedAt,
+				DeletedAt:   account.DeletedAt,
+			},
+			expectSecurity: &dto.AccountSecurityDTO{TwoFactorEnabled: true, PasskeyCount: 1},
+			expectError:    nil,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByIDIncludingDeleted(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockRoleRepo: func(roleRepo *mockRepo.MockRoleRepository) {
+				roleRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), account.ID).
+					Return([]*entity.Role{role}, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *mockRepo.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountID(gomock.Any(), account.ID).
+					Return(&entity.TOTP{AccountID: account.ID, Enabled: true}, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *mockRepo.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), account.ID).
+					Return([]*entity.Passkey{{ID: uuid.New(), AccountID: account.ID}}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "account not found",
+			expectResult:   nil,
+			expectSecurity: nil,
+			expectError:    usecase.ErrAccountNotFound,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByIDIncludingDeleted(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockRoleRepo:    func(*mockRepo.MockRoleRepository) {},
+			setMockTOTPRepo:    func(*mockRepo.MockTOTPRepository) {},
+			setMockPasskeyRepo: func(*mockRepo.MockPasskeyRepository) {},
+		},
+		{
+			name:           "find account error",
+			expectResult:   nil,
+			expectSecurity: nil,
+			expectError:    sql.ErrConnDone,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByIDIncludingDeleted(gomock.Any(), account.ID).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find account by id")).
+					Times(1)
+			},
+			setMockRoleRepo:    func(*mockRepo.MockRoleRepository) {},
+			setMockTOTPRepo:    func(*mockRepo.MockTOTPRepository) {},
+			setMockPasskeyRepo: func(*mockRepo.MockPasskeyRepository) {},
+		},
+		{
+			name:           "find roles error",
+			expectResult:   nil,
+			expectSecurity: nil,
+			expectError:    sql.ErrConnDone,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByIDIncludingDeleted(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockRoleRepo: func(roleRepo *mockRepo.MockRoleRepository) {
+				roleRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), account.ID).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find roles by account id")).
+					Times(1)
+			},
+			setMockTOTPRepo:    func(*mockRepo.MockTOTPRepository) {},
+			setMockPasskeyRepo: func(*mockRepo.MockPasskeyRepository) {},
+		},
+		{
+			name:           "find totp error",
+			expectResult:   nil,
+			expectSecurity: nil,
+			expectError:    sql.ErrConnDone,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByIDIncludingDeleted(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockRoleRepo: func(roleRepo *mockRepo.MockRoleRepository) {
+				roleRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), account.ID).
+					Return([]*entity.Role{role}, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *mockRepo.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountID(gomock.Any(), account.ID).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find totp by account id")).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(*mockRepo.MockPasskeyRepository) {},
+		},
+		{
+			name:           "find passkeys error",
+			expectResult:   nil,
+			expectSecurity: nil,
+			expectError:    sql.ErrConnDone,
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByIDIncludingDeleted(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockRoleRepo: func(roleRepo *mockRepo.MockRoleRepository) {
+				roleRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), account.ID).
+					Return([]*entity.Role{role}, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *mockRepo.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *mockRepo.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), account.ID).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find passkeys by account id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			accountRepo := mockRepo.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			roleRepo := mockRepo.NewMockRoleRepository(ctrl)
+			tt.setMockRoleRepo(roleRepo)
+
+			totpRepo := mockRepo.NewMockTOTPRepository(ctrl)
+			tt.setMockTOTPRepo(totpRepo)
+
+			passkeyRepo := mockRepo.NewMockPasskeyRepository(ctrl)
+			tt.setMockPasskeyRepo(passkeyRepo)
+
+			uc := usecase.NewAdminAccountUsecase(nil, accountRepo, nil, totpRepo, passkeyRepo, roleRepo, nil, nil, accountRestorePeriod)
+			result, security, err := uc.Get(ctx, account.ID)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(tt.expectResult, result); diff != "" {
+				t.Error(diff)
+			}
+
+			if diff := cmp.Diff(tt.expectSecurity, security); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAdminAccount_ResetPassword(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		Email:    "name@example.com",
+	}
+
+	var token string
+
+	tests := []struct {
+		name                     string
+		expectError              error
+		setMockTransactionObj    func(*transaction.MockTransactionObject)
+		setMockAccountRepo       func(*mockRepo.MockAccountRepository)
+		setMockSessionRepo       func(*mockRepo.MockSessionRepository)
+		setMockPasswordResetRepo func(*mockRepo.MockPasswordResetRepository)
+		setMockMailer            func(*mockMailer.MockMailer)
+	}{
+		{
+			name:        "successfully reset",
+			expectError: nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, account *entity.Account) error {
+						if err := account.VerifyPassword("password", passwordHasher); err == nil {
+							t.Error("password is not invalidated")
+						}
+						return nil
+					}).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(nil).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(passwordResetRepo *mockRepo.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, reset *entity.PasswordReset) error {
+						if reset.AccountID != account.ID {
+							t.Errorf("\nexpect: %v\ngot: %v", account.ID, reset.AccountID)
+						}
+						token = reset.Token
+						return nil
+					}).
+					Times(1)
+			},
+			setMockMailer: func(m *mockMailer.MockMailer) {
+				m.
+					EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, mail *mailer.Mail) error {
+						if mail.To != "name@example.com" {
+							t.Errorf("\nexpect: %v\ngot: %v", "name@example.com", mail.To)
+						}
+						if !strings.Contains(mail.Body, token) {
+							t.Error("token is not contained in mail body")
+						}
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name:        "account not found",
+			expectError: usecase.ErrAccountNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockSessionRepo:       func(*mockRepo.MockSessionRepository) {},
+			setMockPasswordResetRepo: func(*mockRepo.MockPasswordResetRepository) {},
+			setMockMailer:            func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:        "email not set",
+			expectError: usecase.ErrAccountEmailNotSet,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(&entity.Account{ID: account.ID, Name: account.Name, Password: account.Password}, nil).
+					Times(1)
+			},
+			setMockSessionRepo:       func(*mockRepo.MockSessionRepository) {},
+			setMockPasswordResetRepo: func(*mockRepo.MockPasswordResetRepository) {},
+			setMockMailer:            func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:        "update error",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), account).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to update account")).
+					Times(1)
+			},
+			setMockSessionRepo:       func(*mockRepo.MockSessionRepository) {},
+			setMockPasswordResetRepo: func(*mockRepo.MockPasswordResetRepository) {},
+			setMockMailer:            func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:        "save error",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), account).
+					Return(nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(*mockRepo.MockSessionRepository) {},
+			setMockPasswordResetRepo: func(passwordResetRepo *mockRepo.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to save password reset")).
+					Times(1)
+			},
+			setMockMailer: func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:        "delete sessions error",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), account).
+					Return(nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete sessions by account id")).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(passwordResetRepo *mockRepo.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockMailer: func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:        "send error",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), account).
+					Return(nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(nil).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(passwordResetRepo *mockRepo.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockMailer: func(m *mockMailer.MockMailer) {
+				m.
+					EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to send mail")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := mockRepo.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			sessionRepo := mockRepo.NewMockSessionRepository(ctrl)
+			tt.setMockSessionRepo(sessionRepo)
+
+			passwordResetRepo := mockRepo.NewMockPasswordResetRepository(ctrl)
+			tt.setMockPasswordResetRepo(passwordResetRepo)
+
+			m := mockMailer.NewMockMailer(ctrl)
+			tt.setMockMailer(m)
+
+			uc := usecase.NewAdminAccountUsecase(transactionObj, accountRepo, sessionRepo, nil, nil, nil, passwordResetRepo, m, accountRestorePeriod)
+			err := uc.ResetPassword(ctx, account.ID)
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
+
+func TestAdminAccount_RevokeSessions(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+
+	tests := []struct {
+		name                  string
+		expectError           error
+		setMockTransactionObj func(*transaction.MockTransactionObject)
+		setMockAccountRepo    func(*mockRepo.MockAccountRepository)
+		setMockSessionRepo    func(*mockRepo.MockSessionRepository)
+	}{
+		{
+			name:        "successfully revoked",
+			expectError: nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "account not found",
+			expectError: usecase.ErrAccountNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(*mockRepo.MockSessionRepository) {},
+		},
+		{
+			name:        "delete sessions error",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete sessions by account id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := mockRepo.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			sessionRepo := mockRepo.NewMockSessionRepository(ctrl)
+			tt.setMockSessionRepo(sessionRepo)
+
+			uc := usecase.NewAdminAccountUsecase(transactionObj, accountRepo, sessionRepo, nil, nil, nil, nil, nil, accountRestorePeriod)
+			err := uc.RevokeSessions(ctx, account.ID)
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
+
+func TestAdminAccount_Delete(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+
+	tests := []struct {
+		name                  string
+		expectError           error
+		setMockTransactionObj func(*transaction.MockTransactionObject)
+		setMockAccountRepo    func(*mockRepo.MockAccountRepository)
+		setMockSessionRepo    func(*mockRepo.MockSessionRepository)
+	}{
+		{
+			name:        "successfully deleted",
+			expectError: nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Delete(gomock.Any(), account).
+					Return(nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "account not found",
+			expectError: usecase.ErrAccountNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(*mockRepo.MockSessionRepository) {},
+		},
+		{
+			name:        "delete error",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Delete(gomock.Any(), account).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete account")).
+					Times(1)
+			},
+			setMockSessionRepo: func(*mockRepo.MockSessionRepository) {},
+		},
+		{
+			name:        "delete sessions error",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Delete(gomock.Any(), account).
+					Return(nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *mockRepo.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete sessions by account id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := mockRepo.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			sessionRepo := mockRepo.NewMockSessionRepository(ctrl)
+			tt.setMockSessionRepo(sessionRepo)
+
+			uc := usecase.NewAdminAccountUsecase(transactionObj, accountRepo, sessionRepo, nil, nil, nil, nil, nil, accountRestorePeriod)
+			err := uc.Delete(ctx, account.ID)
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
+
+func TestAdminAccount_Restore(t *testing.T) {
+	account := &entity.Account{
+		ID:        uuid.New(),
+		Name:      "name",
+		Password:  "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		DeletedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name                  string
+		expectError           error
+		setMockTransactionObj func(*transaction.MockTransactionObject)
+		setMockAccountRepo    func(*mockRepo.MockAccountRepository)
+	}{
+		{
+			name:        "successfully restored",
+			expectError: nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByIDAndDeletedAtAfter(gomock.Any(), account.ID, gomock.Any()).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Restore(gomock.Any(), account).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "account not found",
+			expectError: usecase.ErrAccountNotDeletedOrExpired,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByIDAndDeletedAtAfter(gomock.Any(), account.ID, gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "find error",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByIDAndDeletedAtAfter(gomock.Any(), account.ID, gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find account by id and deleted at after")).
+					Times(1)
+			},
+		},
+		{
+			name:        "restore error",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *mockRepo.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByIDAndDeletedAtAfter(gomock.Any(), account.ID, gomock.Any()).
+					Return(account, nil).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Restore(gomock.Any(), account).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to restore account")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := mockRepo.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			uc := usecase.NewAdminAccountUsecase(transactionObj, accountRepo, nil, nil, nil, nil, nil, nil, accountRestorePeriod)
+			err := uc.Restore(ctx, account.ID)
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
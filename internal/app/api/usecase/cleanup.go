@@ -0,0 +1,122 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../test/mock/$GOPACKAGE/$GOFILE
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/lock"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/service"
+)
+
+const (
+	purgeExpiredSessionsLockName = "holos_account_purge_expired_sessions"
+	purgeDeletedAccountsLockName = "holos_account_purge_deleted_accounts"
+	purgeLoginFailuresLockName   = "holos_account_purge_login_failures"
+)
+
+type CleanupUsecase interface {
+	PurgeExpiredSessions(context.Context) error
+	PurgeDeletedAccounts(context.Context) error
+	PurgeLoginFailures(context.Context) error
+}
+
+type cleanupUsecase struct {
+	lockObj                  lock.LockObject
+	transactionObj           transaction.TransactionObject
+	sessionRepo              repository.SessionRepository
+	loginChallengeRepo       repository.LoginChallengeRepository
+	passkeyChallengeRepo     repository.PasskeyChallengeRepository
+	emailVerificationRepo    repository.EmailVerificationRepository
+	passwordResetRepo        repository.PasswordResetRepository
+	accountRepo              repository.AccountRepository
+	accountNameTombstoneRepo repository.AccountNameTombstoneRepository
+	loginFailureRepo         repository.LoginFailureRepository
+	accountServ              service.AccountService
+	accountRetentionPolicy   entity.AccountRetentionPolicy
+	loginFailureRetention    time.Duration
+}
+
+func NewCleanupUsecase(
+	lockObj lock.LockObject,
+	transactionObj transaction.TransactionObject,
+	sessionRepo repository.SessionRepository,
+	loginChallengeRepo repository.LoginChallengeRepository,
+	passkeyChallengeRepo repository.PasskeyChallengeRepository,
+	emailVerificationRepo repository.EmailVerificationRepository,
+	passwordResetRepo repository.PasswordResetRepository,
+	accountRepo repository.AccountRepository,
+	accountNameTombstoneRepo repository.AccountNameTombstoneRepository,
+	loginFailureRepo repository.LoginFailureRepository,
+	accountServ service.AccountService,
+	accountRetentionPolicy entity.AccountRetentionPolicy,
+	loginFailureRetention time.Duration,
+) CleanupUsecase {
+	return &cleanupUsecase{
+		lockObj:                  lockObj,
+		transactionObj:           transactionObj,
+		sessionRepo:              sessionRepo,
+		loginChallengeRepo:       loginChallengeRepo,
+		passkeyChallengeRepo:     passkeyChallengeRepo,
+		emailVerificationRepo:    emailVerificationRepo,
+		passwordResetRepo:        passwordResetRepo,
+		accountRepo:              accountRepo,
+		accountNameTombstoneRepo: accountNameTombstoneRepo,
+		loginFailureRepo:         loginFailureRepo,
+		accountServ:              accountServ,
+		accountRetentionPolicy:   accountRetentionPolicy,
+		loginFailureRetention:    loginFailureRetention,
+	}
+}
+
+// NOTE: 2要素目の認証やパスキーの応答を待つチャレンジもセッションの前段階として同時に削除する.
+// 有効期限を経過したメールアドレスの確認待ちとパスワードの再設定要求も同じ周期で削除する.
+func (u *cleanupUsecase) PurgeExpiredSessions(ctx context.Context) error {
+	return u.lockObj.Lock(ctx, purgeExpiredSessionsLockName, func(ctx context.Context) error {
+		if err := u.sessionRepo.DeleteExpired(ctx); err != nil {
+			return err
+		}
+		if err := u.loginChallengeRepo.DeleteExpired(ctx); err != nil {
+			return err
+		}
+		if err := u.passkeyChallengeRepo.DeleteExpired(ctx); err != nil {
+			return err
+		}
+		if err := u.emailVerificationRepo.DeleteExpired(ctx); err != nil {
+			return err
+		}
+		return u.passwordResetRepo.DeleteExpired(ctx)
+	})
+}
+
+// NOTE: 保持期間を経過したアカウントは名前を解放して物理削除し, クールダウン期間を経過した名前の記録も削除する.
+func (u *cleanupUsecase) PurgeDeletedAccounts(ctx context.Context) error {
+	return u.lockObj.Lock(ctx, purgeDeletedAccountsLockName, func(ctx context.Context) error {
+		return u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+			now := time.Now()
+
+			accounts, err := u.accountRepo.FindByDeletedAtBefore(ctx, now.Add(-u.accountRetentionPolicy.Retention))
+			if err != nil {
+				return err
+			}
+
+			for _, account := range accounts {
+				if err := u.accountServ.Release(ctx, account); err != nil {
+					return err
+				}
+			}
+
+			return u.accountNameTombstoneRepo.DeleteByReleasedAtBefore(ctx, now.Add(-u.accountRetentionPolicy.NameCooldown))
+		})
+	})
+}
+
+// NOTE: 集計期間を経過した失敗は次回の失敗時にリセットされるため, 集計期間を保持期間として削除する.
+func (u *cleanupUsecase) PurgeLoginFailures(ctx context.Context) error {
+	return u.lockObj.Lock(ctx, purgeLoginFailuresLockName, func(ctx context.Context) error {
+		return u.loginFailureRepo.DeleteByLastFailedAtBefore(ctx, time.Now().Add(-u.loginFailureRetention))
+	})
+}
@@ -0,0 +1,635 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository/pkg/lock"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/service"
+)
+
+var retentionPolicy = entity.AccountRetentionPolicy{
+	Retention:    time.Hour * 24 * 30,
+	NameCooldown: time.Hour * 24 * 90,
+}
+
+func TestCleanup_PurgeExpiredSessions(t *testing.T) {
+	tests := []struct {
+		name                         string
+		expectError                  error
+		setMockLockObj               func(*lock.MockLockObject)
+		setMockSessionRepo           func(*repository.MockSessionRepository)
+		setMockLoginChallengeRepo    func(*repository.MockLoginChallengeRepository)
+		setMockPasskeyChallengeRepo  func(*repository.MockPasskeyChallengeRepository)
+		setMockEmailVerificationRepo func(*repository.MockEmailVerificationRepository)
+		setMockPasswordResetRepo     func(*repository.MockPasswordResetRepository)
+	}{
+		{
+			name:        "successfully purged",
+			expectError: nil,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *repository.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockLoginChallengeRepo: func(loginChallengeRepo *repository.MockLoginChallengeRepository) {
+				loginChallengeRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockPasskeyChallengeRepo: func(passkeyChallengeRepo *repository.MockPasskeyChallengeRepository) {
+				passkeyChallengeRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockEmailVerificationRepo: func(emailVerificationRepo *repository.MockEmailVerificationRepository) {
+				emailVerificationRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(passwordResetRepo *repository.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "lock not acquired",
+			expectError: nil,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockSessionRepo:           func(*repository.MockSessionRepository) {},
+			setMockLoginChallengeRepo:    func(*repository.MockLoginChallengeRepository) {},
+			setMockPasskeyChallengeRepo:  func(*repository.MockPasskeyChallengeRepository) {},
+			setMockEmailVerificationRepo: func(*repository.MockEmailVerificationRepository) {},
+			setMockPasswordResetRepo:     func(*repository.MockPasswordResetRepository) {},
+		},
+		{
+			name:        "delete sessions error",
+			expectError: sql.ErrConnDone,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *repository.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete expired sessions")).
+					Times(1)
+			},
+			setMockLoginChallengeRepo:    func(*repository.MockLoginChallengeRepository) {},
+			setMockPasskeyChallengeRepo:  func(*repository.MockPasskeyChallengeRepository) {},
+			setMockEmailVerificationRepo: func(*repository.MockEmailVerificationRepository) {},
+			setMockPasswordResetRepo:     func(*repository.MockPasswordResetRepository) {},
+		},
+		{
+			name:        "delete login challenges error",
+			expectError: sql.ErrConnDone,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *repository.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockLoginChallengeRepo: func(loginChallengeRepo *repository.MockLoginChallengeRepository) {
+				loginChallengeRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete expired login challenges")).
+					Times(1)
+			},
+			setMockPasskeyChallengeRepo:  func(*repository.MockPasskeyChallengeRepository) {},
+			setMockEmailVerificationRepo: func(*repository.MockEmailVerificationRepository) {},
+			setMockPasswordResetRepo:     func(*repository.MockPasswordResetRepository) {},
+		},
+		{
+			name:        "delete passkey challenges error",
+			expectError: sql.ErrConnDone,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *repository.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockLoginChallengeRepo: func(loginChallengeRepo *repository.MockLoginChallengeRepository) {
+				loginChallengeRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockPasskeyChallengeRepo: func(passkeyChallengeRepo *repository.MockPasskeyChallengeRepository) {
+				passkeyChallengeRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete expired passkey challenges")).
+					Times(1)
+			},
+			setMockEmailVerificationRepo: func(*repository.MockEmailVerificationRepository) {},
+			setMockPasswordResetRepo:     func(*repository.MockPasswordResetRepository) {},
+		},
+		{
+			name:        "delete email verifications error",
+			expectError: sql.ErrConnDone,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *repository.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockLoginChallengeRepo: func(loginChallengeRepo *repository.MockLoginChallengeRepository) {
+				loginChallengeRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockPasskeyChallengeRepo: func(passkeyChallengeRepo *repository.MockPasskeyChallengeRepository) {
+				passkeyChallengeRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockEmailVerificationRepo: func(emailVerificationRepo *repository.MockEmailVerificationRepository) {
+				emailVerificationRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete expired email verifications")).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(*repository.MockPasswordResetRepository) {},
+		},
+		{
+			name:        "delete password resets error",
+			expectError: sql.ErrConnDone,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *repository.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockLoginChallengeRepo: func(loginChallengeRepo *repository.MockLoginChallengeRepository) {
+				loginChallengeRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockPasskeyChallengeRepo: func(passkeyChallengeRepo *repository.MockPasskeyChallengeRepository) {
+				passkeyChallengeRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockEmailVerificationRepo: func(emailVerificationRepo *repository.MockEmailVerificationRepository) {
+				emailVerificationRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(passwordResetRepo *repository.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					DeleteExpired(gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete expired password resets")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			lockObj := lock.NewMockLockObject(ctrl)
+			tt.setMockLockObj(lockObj)
+
+			sessionRepo := repository.NewMockSessionRepository(ctrl)
+			tt.setMockSessionRepo(sessionRepo)
+
+			loginChallengeRepo := repository.NewMockLoginChallengeRepository(ctrl)
+			tt.setMockLoginChallengeRepo(loginChallengeRepo)
+
+			passkeyChallengeRepo := repository.NewMockPasskeyChallengeRepository(ctrl)
+			tt.setMockPasskeyChallengeRepo(passkeyChallengeRepo)
+
+			emailVerificationRepo := repository.NewMockEmailVerificationRepository(ctrl)
+			tt.setMockEmailVerificationRepo(emailVerificationRepo)
+
+			passwordResetRepo := repository.NewMockPasswordResetRepository(ctrl)
+			tt.setMockPasswordResetRepo(passwordResetRepo)
+
+			uc := usecase.NewCleanupUsecase(lockObj, nil, sessionRepo, loginChallengeRepo, passkeyChallengeRepo, emailVerificationRepo, passwordResetRepo, nil, nil, nil, nil, retentionPolicy, time.Minute*15)
+			err := uc.PurgeExpiredSessions(ctx)
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
+
+func TestCleanup_PurgeDeletedAccounts(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+
+	tests := []struct {
+		name                            string
+		expectError                     error
+		setMockLockObj                  func(*lock.MockLockObject)
+		setMockTransactionObj           func(*transaction.MockTransactionObject)
+		setMockAccountRepo              func(*repository.MockAccountRepository)
+		setMockAccountServ              func(*service.MockAccountService)
+		setMockAccountNameTombstoneRepo func(*repository.MockAccountNameTombstoneRepository)
+	}{
+		{
+			name:        "successfully purged",
+			expectError: nil,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindByDeletedAtBefore(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, deletedAt time.Time) ([]*entity.Account, error) {
+						if expect := time.Now().Add(-retentionPolicy.Retention); deletedAt.After(expect) {
+							t.Errorf("\nexpect: before %v\ngot: %v", expect, deletedAt)
+						}
+						return []*entity.Account{account}, nil
+					}).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *service.MockAccountService) {
+				accountServ.
+					EXPECT().
+					Release(gomock.Any(), account).
+					Return(nil).
+					Times(1)
+			},
+			setMockAccountNameTombstoneRepo: func(accountNameTombstoneRepo *repository.MockAccountNameTombstoneRepository) {
+				accountNameTombstoneRepo.
+					EXPECT().
+					DeleteByReleasedAtBefore(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, releasedAt time.Time) error {
+						if expect := time.Now().Add(-retentionPolicy.NameCooldown); releasedAt.After(expect) {
+							t.Errorf("\nexpect: before %v\ngot: %v", expect, releasedAt)
+						}
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name:        "lock not acquired",
+			expectError: nil,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockTransactionObj:           func(*transaction.MockTransactionObject) {},
+			setMockAccountRepo:              func(*repository.MockAccountRepository) {},
+			setMockAccountServ:              func(*service.MockAccountService) {},
+			setMockAccountNameTombstoneRepo: func(*repository.MockAccountNameTombstoneRepository) {},
+		},
+		{
+			name:        "find accounts error",
+			expectError: sql.ErrConnDone,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindByDeletedAtBefore(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find accounts by deleted_at")).
+					Times(1)
+			},
+			setMockAccountServ:              func(*service.MockAccountService) {},
+			setMockAccountNameTombstoneRepo: func(*repository.MockAccountNameTombstoneRepository) {},
+		},
+		{
+			name:        "release error",
+			expectError: sql.ErrConnDone,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindByDeletedAtBefore(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, deletedAt time.Time) ([]*entity.Account, error) {
+						if expect := time.Now().Add(-retentionPolicy.Retention); deletedAt.After(expect) {
+							t.Errorf("\nexpect: before %v\ngot: %v", expect, deletedAt)
+						}
+						return []*entity.Account{account}, nil
+					}).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *service.MockAccountService) {
+				accountServ.
+					EXPECT().
+					Release(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to purge account")).
+					Times(1)
+			},
+			setMockAccountNameTombstoneRepo: func(*repository.MockAccountNameTombstoneRepository) {},
+		},
+		{
+			name:        "delete tombstones error",
+			expectError: sql.ErrConnDone,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindByDeletedAtBefore(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, deletedAt time.Time) ([]*entity.Account, error) {
+						if expect := time.Now().Add(-retentionPolicy.Retention); deletedAt.After(expect) {
+							t.Errorf("\nexpect: before %v\ngot: %v", expect, deletedAt)
+						}
+						return []*entity.Account{account}, nil
+					}).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *service.MockAccountService) {
+				accountServ.
+					EXPECT().
+					Release(gomock.Any(), account).
+					Return(nil).
+					Times(1)
+			},
+			setMockAccountNameTombstoneRepo: func(accountNameTombstoneRepo *repository.MockAccountNameTombstoneRepository) {
+				accountNameTombstoneRepo.
+					EXPECT().
+					DeleteByReleasedAtBefore(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete account name tombstones by released_at")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			lockObj := lock.NewMockLockObject(ctrl)
+			tt.setMockLockObj(lockObj)
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := repository.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			accountServ := service.NewMockAccountService(ctrl)
+			tt.setMockAccountServ(accountServ)
+
+			accountNameTombstoneRepo := repository.NewMockAccountNameTombstoneRepository(ctrl)
+			tt.setMockAccountNameTombstoneRepo(accountNameTombstoneRepo)
+
+			uc := usecase.NewCleanupUsecase(lockObj, transactionObj, nil, nil, nil, nil, nil, accountRepo, accountNameTombstoneRepo, nil, accountServ, retentionPolicy, time.Minute*15)
+			err := uc.PurgeDeletedAccounts(ctx)
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
+
+func TestCleanup_PurgeLoginFailures(t *testing.T) {
+	retention := time.Minute * 15
+
+	tests := []struct {
+		name                    string
+		expectError             error
+		setMockLockObj          func(*lock.MockLockObject)
+		setMockLoginFailureRepo func(*repository.MockLoginFailureRepository)
+	}{
+		{
+			name:        "successfully purged",
+			expectError: nil,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockLoginFailureRepo: func(loginFailureRepo *repository.MockLoginFailureRepository) {
+				loginFailureRepo.
+					EXPECT().
+					DeleteByLastFailedAtBefore(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, lastFailedAt time.Time) error {
+						if expect := time.Now().Add(-retention); lastFailedAt.After(expect) {
+							t.Errorf("\nexpect: before %v\ngot: %v", expect, lastFailedAt)
+						}
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name:        "lock not acquired",
+			expectError: nil,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockLoginFailureRepo: func(*repository.MockLoginFailureRepository) {},
+		},
+		{
+			name:        "delete login failures error",
+			expectError: sql.ErrConnDone,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockLoginFailureRepo: func(loginFailureRepo *repository.MockLoginFailureRepository) {
+				loginFailureRepo.
+					EXPECT().
+					DeleteByLastFailedAtBefore(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete login failures by last_failed_at")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			lockObj := lock.NewMockLockObject(ctrl)
+			tt.setMockLockObj(lockObj)
+
+			loginFailureRepo := repository.NewMockLoginFailureRepository(ctrl)
+			tt.setMockLoginFailureRepo(loginFailureRepo)
+
+			uc := usecase.NewCleanupUsecase(lockObj, nil, nil, nil, nil, nil, nil, nil, nil, loginFailureRepo, nil, retentionPolicy, retention)
+			err := uc.PurgeLoginFailures(ctx)
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
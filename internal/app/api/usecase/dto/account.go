@@ -1,9 +1,46 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	"time"
 
+	"github.com/google/uuid"
+)
+
+// NOTE: パスワードのハッシュ値はインターフェース層に渡さない.
+// ロールと権限はロールを読み込んだ場合のみ設定する.
 type AccountDTO struct {
-	ID       uuid.UUID
-	Name     string
-	Password string
+	ID          uuid.UUID
+	Name        string
+	Email       string
+	Roles       []string
+	Permissions []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   time.Time
+}
+
+type AccountSecurityDTO struct {
+	TwoFactorEnabled bool
+	PasskeyCount     int
+}
+
+type DeletedAccountDTO struct {
+	Name            string
+	RestoreDeadline time.Time
+}
+
+// NOTE: 削除済みかどうかを指定しない場合は削除済みのアカウントも含める.
+type AccountSearchDTO struct {
+	NamePrefix    string
+	Deleted       *bool
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Cursor        string
+	Limit         int
+}
+
+// NOTE: 続きのアカウントが存在しない場合, NextCursorは空文字とする.
+type AccountPageDTO struct {
+	Accounts   []*AccountDTO
+	NextCursor string
 }
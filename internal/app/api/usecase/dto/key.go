@@ -0,0 +1,8 @@
+package dto
+
+import "crypto/ed25519"
+
+type PublicKeyDTO struct {
+	ID  string
+	Key ed25519.PublicKey
+}
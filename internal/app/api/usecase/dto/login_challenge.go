@@ -0,0 +1,9 @@
+package dto
+
+import "time"
+
+type LoginChallengeDTO struct {
+	Token     string
+	Methods   []string
+	ExpiresAt time.Time
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PasskeyDTO struct {
+	ID         uuid.UUID
+	Name       string
+	LastUsedAt time.Time
+	CreatedAt  time.Time
+}
+
+type PasskeyRegistrationOptionsDTO struct {
+	Challenge            string
+	RPID                 string
+	RPName               string
+	UserID               []byte
+	UserName             string
+	Algorithms           []int
+	ExcludeCredentialIDs [][]byte
+	Timeout              time.Duration
+}
+
+type PasskeyAuthenticationOptionsDTO struct {
+	Challenge string
+	RPID      string
+	Timeout   time.Duration
+}
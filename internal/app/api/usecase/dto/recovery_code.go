@@ -0,0 +1,9 @@
+package dto
+
+type RecoveryCodesDTO struct {
+	Codes []string
+}
+
+type RecoveryCodeCountDTO struct {
+	Remaining int
+}
@@ -7,7 +7,21 @@ import (
 )
 
 type SessionDTO struct {
-	AccountID uuid.UUID
-	Token     string
-	ExpiresAt time.Time
+	ID               uuid.UUID
+	AccountID        uuid.UUID
+	Token            string
+	RefreshToken     string
+	AccessToken      string
+	UserAgent        string
+	IPAddress        string
+	ExpiresAt        time.Time
+	RefreshExpiresAt time.Time
+	IssuedAt         time.Time
+	LastUsedAt       time.Time
+	CreatedAt        time.Time
+}
+
+type VerifiedSessionDTO struct {
+	Session *SessionDTO
+	Account *AccountDTO
 }
@@ -0,0 +1,6 @@
+package dto
+
+type TOTPEnrollmentDTO struct {
+	Secret string
+	URI    string
+}
@@ -0,0 +1,157 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../test/mock/$GOPACKAGE/$GOFILE
+package usecase
+
+import (
+	"context"
+	stderr "errors"
+	"fmt"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/hasher"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/mailer"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/service"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/mapper"
+)
+
+var ErrEmailVerificationNotFound = stderr.New("email verification not found")
+
+type EmailUsecase interface {
+	Change(context.Context, uuid.UUID, string, string) error
+	Verify(context.Context, string) (*dto.AccountDTO, error)
+}
+
+type emailUsecase struct {
+	transactionObj        transaction.TransactionObject
+	accountRepo           repository.AccountRepository
+	emailVerificationRepo repository.EmailVerificationRepository
+	accountServ           service.AccountService
+	mailer                mailer.Mailer
+	passwordHasher        hasher.PasswordHasher
+}
+
+func NewEmailUsecase(
+	transactionObj transaction.TransactionObject,
+	accountRepo repository.AccountRepository,
+	emailVerificationRepo repository.EmailVerificationRepository,
+	accountServ service.AccountService,
+	mailer mailer.Mailer,
+	passwordHasher hasher.PasswordHasher,
+) EmailUsecase {
+	return &emailUsecase{
+		transactionObj:        transactionObj,
+		accountRepo:           accountRepo,
+		emailVerificationRepo: emailVerificationRepo,
+		accountServ:           accountServ,
+		mailer:                mailer,
+		passwordHasher:        passwordHasher,
+	}
+}
+
+// NOTE: 確認が完了するまで現在のメールアドレスは変更せず, 新しいメールアドレス宛に確認トークンを送信する.
+// 送信中にトランザクションを保持しないよう, メールはコミット後に送信し, 送信に失敗した場合は再度変更することで確認待ちを上書きして再送する.
+func (u *emailUsecase) Change(ctx context.Context, accountID uuid.UUID, password, email string) error {
+	var mail *mailer.Mail
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		account, err := u.accountRepo.FindOneByID(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, "failed to change email")
+		}
+
+		if err := account.VerifyPassword(password, u.passwordHasher); err != nil {
+			return err
+		}
+
+		if account.Email == email {
+			return nil
+		}
+
+		verification, err := entity.NewEmailVerification(account, email)
+		if err != nil {
+			return err
+		}
+
+		if err := u.accountServ.EmailExists(ctx, email); err != nil {
+			return err
+		}
+
+		if err := u.emailVerificationRepo.Save(ctx, verification); err != nil {
+			return err
+		}
+
+		mail = newEmailVerificationMail(verification)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if mail == nil {
+		return nil
+	}
+	return u.mailer.Send(ctx, mail)
+}
+
+// NOTE: 確認待ちの間に他のアカウントで同じメールアドレスが確認されている場合があるため, 確定時にも重複を判定する.
+func (u *emailUsecase) Verify(ctx context.Context, token string) (*dto.AccountDTO, error) {
+	const errMessage = "failed to verify email"
+
+	var account *entity.Account
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		verification, err := u.emailVerificationRepo.FindOneByTokenAndNotExpired(ctx, token)
+		if err != nil {
+			return err
+		}
+		if verification == nil {
+			return errors.Wrap(ErrEmailVerificationNotFound, errors.CodeBadRequest, errMessage)
+		}
+
+		account, err = u.accountRepo.FindOneByID(ctx, verification.AccountID)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrEmailVerificationNotFound, errors.CodeBadRequest, errMessage)
+		}
+
+		if err := u.accountServ.EmailExists(ctx, verification.Email); err != nil {
+			return err
+		}
+
+		if err := account.SetEmail(verification.Email); err != nil {
+			return err
+		}
+
+		if err := u.accountRepo.Update(ctx, account); err != nil {
+			return err
+		}
+
+		return u.emailVerificationRepo.Delete(ctx, verification)
+	}); err != nil {
+		return nil, err
+	}
+
+	return mapper.ToAccountDTO(account), nil
+}
+
+func newEmailVerificationMail(verification *entity.EmailVerification) *mailer.Mail {
+	return &mailer.Mail{
+		To:      verification.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Use the following token to verify your email address.\n\n%s\n\nThis token expires at %s.\nIf you did not request this change, you can ignore this email.\n",
+			verification.Token,
+			verification.ExpiresAt.UTC().Format(time.RFC3339),
+		),
+	}
+}
@@ -0,0 +1,696 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/mailer"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/service"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	mockMailer "github.com/atsumarukun/holos-account-api/test/mock/domain/pkg/mailer"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository/pkg/transaction"
+	mockServ "github.com/atsumarukun/holos-account-api/test/mock/domain/service"
+)
+
+func TestEmail_Change(t *testing.T) {
+	hashed, err := passwordHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: hashed,
+		Email:    "old@example.com",
+	}
+
+	var token string
+
+	tests := []struct {
+		name                         string
+		inputPassword                string
+		inputEmail                   string
+		expectError                  error
+		setMockTransactionObj        func(*transaction.MockTransactionObject)
+		setMockAccountRepo           func(*repository.MockAccountRepository)
+		setMockAccountServ           func(*mockServ.MockAccountService)
+		setMockEmailVerificationRepo func(*repository.MockEmailVerificationRepository)
+		setMockMailer                func(*mockMailer.MockMailer)
+	}{
+		{
+			name:          "successfully requested",
+			inputPassword: "password",
+			inputEmail:    "new@example.com",
+			expectError:   nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *mockServ.MockAccountService) {
+				accountServ.
+					EXPECT().
+					EmailExists(gomock.Any(), "new@example.com").
+					Return(nil).
+					Times(1)
+			},
+			setMockEmailVerificationRepo: func(emailVerificationRepo *repository.MockEmailVerificationRepository) {
+				emailVerificationRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, verification *entity.EmailVerification) error {
+						if verification.AccountID != account.ID || verification.Email != "new@example.com" {
+							t.Errorf("unexpected verification: %v", verification)
+						}
+						token = verification.Token
+						return nil
+					}).
+					Times(1)
+			},
+			setMockMailer: func(m *mockMailer.MockMailer) {
+				m.
+					EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, mail *mailer.Mail) error {
+						if mail.To != "new@example.com" {
+							t.Errorf("\nexpect: %v\ngot: %v", "new@example.com", mail.To)
+						}
+						if !strings.Contains(mail.Body, token) {
+							t.Error("token is not contained in mail body")
+						}
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name:          "same email",
+			inputPassword: "password",
+			inputEmail:    "old@example.com",
+			expectError:   nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockAccountServ:           func(*mockServ.MockAccountService) {},
+			setMockEmailVerificationRepo: func(*repository.MockEmailVerificationRepository) {},
+			setMockMailer:                func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:          "account not found",
+			inputPassword: "password",
+			inputEmail:    "new@example.com",
+			expectError:   usecase.ErrAccountNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockAccountServ:           func(*mockServ.MockAccountService) {},
+			setMockEmailVerificationRepo: func(*repository.MockEmailVerificationRepository) {},
+			setMockMailer:                func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:          "incorrect password",
+			inputPassword: "incorrect",
+			inputEmail:    "new@example.com",
+			expectError:   entity.ErrAccountPasswordIncorrect,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockAccountServ:           func(*mockServ.MockAccountService) {},
+			setMockEmailVerificationRepo: func(*repository.MockEmailVerificationRepository) {},
+			setMockMailer:                func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:          "invalid email",
+			inputPassword: "password",
+			inputEmail:    "example.com",
+			expectError:   entity.ErrAccountEmailInvalidFormat,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockAccountServ:           func(*mockServ.MockAccountService) {},
+			setMockEmailVerificationRepo: func(*repository.MockEmailVerificationRepository) {},
+			setMockMailer:                func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:          "email already in use",
+			inputPassword: "password",
+			inputEmail:    "new@example.com",
+			expectError:   service.ErrAccountEmailAlreadyInUse,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *mockServ.MockAccountService) {
+				accountServ.
+					EXPECT().
+					EmailExists(gomock.Any(), "new@example.com").
+					Return(errors.Wrap(service.ErrAccountEmailAlreadyInUse, errors.CodeDuplicate, "email already exists")).
+					Times(1)
+			},
+			setMockEmailVerificationRepo: func(*repository.MockEmailVerificationRepository) {},
+			setMockMailer:                func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:          "find error",
+			inputPassword: "password",
+			inputEmail:    "new@example.com",
+			expectError:   sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find account by id")).
+					Times(1)
+			},
+			setMockAccountServ:           func(*mockServ.MockAccountService) {},
+			setMockEmailVerificationRepo: func(*repository.MockEmailVerificationRepository) {},
+			setMockMailer:                func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:          "save error",
+			inputPassword: "password",
+			inputEmail:    "new@example.com",
+			expectError:   sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *mockServ.MockAccountService) {
+				accountServ.
+					EXPECT().
+					EmailExists(gomock.Any(), "new@example.com").
+					Return(nil).
+					Times(1)
+			},
+			setMockEmailVerificationRepo: func(emailVerificationRepo *repository.MockEmailVerificationRepository) {
+				emailVerificationRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to save email verification")).
+					Times(1)
+			},
+			setMockMailer: func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:          "send error",
+			inputPassword: "password",
+			inputEmail:    "new@example.com",
+			expectError:   sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *mockServ.MockAccountService) {
+				accountServ.
+					EXPECT().
+					EmailExists(gomock.Any(), "new@example.com").
+					Return(nil).
+					Times(1)
+			},
+			setMockEmailVerificationRepo: func(emailVerificationRepo *repository.MockEmailVerificationRepository) {
+				emailVerificationRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockMailer: func(m *mockMailer.MockMailer) {
+				m.
+					EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to send mail")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := repository.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			accountServ := mockServ.NewMockAccountService(ctrl)
+			tt.setMockAccountServ(accountServ)
+
+			emailVerificationRepo := repository.NewMockEmailVerificationRepository(ctrl)
+			tt.setMockEmailVerificationRepo(emailVerificationRepo)
+
+			m := mockMailer.NewMockMailer(ctrl)
+			tt.setMockMailer(m)
+
+			uc := usecase.NewEmailUsecase(transactionObj, accountRepo, emailVerificationRepo, accountServ, m, passwordHasher)
+			err := uc.Change(ctx, account.ID, tt.inputPassword, tt.inputEmail)
+			assert.Error(t, err, tt.expectError)
+
+			if account.Email != "old@example.com" {
+				t.Error("account email is changed before verification")
+			}
+		})
+	}
+}
+
+func TestEmail_Verify(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		Email:    "old@example.com",
+	}
+	verification := &entity.EmailVerification{
+		AccountID: account.ID,
+		Email:     "new@example.com",
+		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		ExpiresAt: time.Now().Add(time.Hour * 24),
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name                         string
+		expectEmail                  string
+		expectError                  error
+		setMockTransactionObj        func(*transaction.MockTransactionObject)
+		setMockAccountRepo           func(*repository.MockAccountRepository)
+		setMockAccountServ           func(*mockServ.MockAccountService)
+		setMockEmailVerificationRepo func(*repository.MockEmailVerificationRepository)
+	}{
+		{
+			name:        "successfully verified",
+			expectEmail: "new@example.com",
+			expectError: nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					DoAndReturn(func(context.Context, uuid.UUID) (*entity.Account, error) {
+						acc := *account
+						return &acc, nil
+					}).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, acc *entity.Account) error {
+						if acc.Email != "new@example.com" {
+							t.Errorf("\nexpect: %v\ngot: %v", "new@example.com", acc.Email)
+						}
+						return nil
+					}).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *mockServ.MockAccountService) {
+				accountServ.
+					EXPECT().
+					EmailExists(gomock.Any(), "new@example.com").
+					Return(nil).
+					Times(1)
+			},
+			setMockEmailVerificationRepo: func(emailVerificationRepo *repository.MockEmailVerificationRepository) {
+				emailVerificationRepo.
+					EXPECT().
+					FindOneByTokenAndNotExpired(gomock.Any(), verification.Token).
+					Return(verification, nil).
+					Times(1)
+				emailVerificationRepo.
+					EXPECT().
+					Delete(gomock.Any(), verification).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "verification not found",
+			expectEmail: "",
+			expectError: usecase.ErrEmailVerificationNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(*repository.MockAccountRepository) {},
+			setMockAccountServ: func(*mockServ.MockAccountService) {},
+			setMockEmailVerificationRepo: func(emailVerificationRepo *repository.MockEmailVerificationRepository) {
+				emailVerificationRepo.
+					EXPECT().
+					FindOneByTokenAndNotExpired(gomock.Any(), verification.Token).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "account not found",
+			expectEmail: "",
+			expectError: usecase.ErrEmailVerificationNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockAccountServ: func(*mockServ.MockAccountService) {},
+			setMockEmailVerificationRepo: func(emailVerificationRepo *repository.MockEmailVerificationRepository) {
+				emailVerificationRepo.
+					EXPECT().
+					FindOneByTokenAndNotExpired(gomock.Any(), verification.Token).
+					Return(verification, nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "email already in use",
+			expectEmail: "",
+			expectError: service.ErrAccountEmailAlreadyInUse,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					DoAndReturn(func(context.Context, uuid.UUID) (*entity.Account, error) {
+						acc := *account
+						return &acc, nil
+					}).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *mockServ.MockAccountService) {
+				accountServ.
+					EXPECT().
+					EmailExists(gomock.Any(), "new@example.com").
+					Return(errors.Wrap(service.ErrAccountEmailAlreadyInUse, errors.CodeDuplicate, "email already exists")).
+					Times(1)
+			},
+			setMockEmailVerificationRepo: func(emailVerificationRepo *repository.MockEmailVerificationRepository) {
+				emailVerificationRepo.
+					EXPECT().
+					FindOneByTokenAndNotExpired(gomock.Any(), verification.Token).
+					Return(verification, nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "find verification error",
+			expectEmail: "",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(*repository.MockAccountRepository) {},
+			setMockAccountServ: func(*mockServ.MockAccountService) {},
+			setMockEmailVerificationRepo: func(emailVerificationRepo *repository.MockEmailVerificationRepository) {
+				emailVerificationRepo.
+					EXPECT().
+					FindOneByTokenAndNotExpired(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find email verification by token and not expired")).
+					Times(1)
+			},
+		},
+		{
+			name:        "update error",
+			expectEmail: "",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					DoAndReturn(func(context.Context, uuid.UUID) (*entity.Account, error) {
+						acc := *account
+						return &acc, nil
+					}).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to update account")).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *mockServ.MockAccountService) {
+				accountServ.
+					EXPECT().
+					EmailExists(gomock.Any(), "new@example.com").
+					Return(nil).
+					Times(1)
+			},
+			setMockEmailVerificationRepo: func(emailVerificationRepo *repository.MockEmailVerificationRepository) {
+				emailVerificationRepo.
+					EXPECT().
+					FindOneByTokenAndNotExpired(gomock.Any(), verification.Token).
+					Return(verification, nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "delete error",
+			expectEmail: "",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					DoAndReturn(func(context.Context, uuid.UUID) (*entity.Account, error) {
+						acc := *account
+						return &acc, nil
+					}).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, acc *entity.Account) error {
+						if acc.Email != "new@example.com" {
+							t.Errorf("\nexpect: %v\ngot: %v", "new@example.com", acc.Email)
+						}
+						return nil
+					}).
+					Times(1)
+			},
+			setMockAccountServ: func(accountServ *mockServ.MockAccountService) {
+				accountServ.
+					EXPECT().
+					EmailExists(gomock.Any(), "new@example.com").
+					Return(nil).
+					Times(1)
+			},
+			setMockEmailVerificationRepo: func(emailVerificationRepo *repository.MockEmailVerificationRepository) {
+				emailVerificationRepo.
+					EXPECT().
+					FindOneByTokenAndNotExpired(gomock.Any(), verification.Token).
+					Return(verification, nil).
+					Times(1)
+				emailVerificationRepo.
+					EXPECT().
+					Delete(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete email verification")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := repository.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			accountServ := mockServ.NewMockAccountService(ctrl)
+			tt.setMockAccountServ(accountServ)
+
+			emailVerificationRepo := repository.NewMockEmailVerificationRepository(ctrl)
+			tt.setMockEmailVerificationRepo(emailVerificationRepo)
+
+			uc := usecase.NewEmailUsecase(transactionObj, accountRepo, emailVerificationRepo, accountServ, nil, passwordHasher)
+			result, err := uc.Verify(ctx, verification.Token)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectEmail == "" {
+				if result != nil {
+					t.Errorf("\nexpect: %v\ngot: %v", nil, result)
+				}
+			} else if result == nil || result.Email != tt.expectEmail {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectEmail, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../test/mock/$GOPACKAGE/$GOFILE
+package usecase
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/token"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/mapper"
+)
+
+type KeyUsecase interface {
+	GetPublicKeys() []*dto.PublicKeyDTO
+}
+
+type keyUsecase struct {
+	accessTokenIssuer token.AccessTokenIssuer
+}
+
+func NewKeyUsecase(accessTokenIssuer token.AccessTokenIssuer) KeyUsecase {
+	return &keyUsecase{
+		accessTokenIssuer: accessTokenIssuer,
+	}
+}
+
+func (u *keyUsecase) GetPublicKeys() []*dto.PublicKeyDTO {
+	if u.accessTokenIssuer == nil {
+		return []*dto.PublicKeyDTO{}
+	}
+	return mapper.ToPublicKeyDTOs(u.accessTokenIssuer.PublicKeys())
+}
@@ -0,0 +1,61 @@
+package usecase_test
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	domainToken "github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/token"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/pkg/token"
+)
+
+func TestKey_GetPublicKeys(t *testing.T) {
+	publicKey := ed25519.PublicKey(make([]byte, ed25519.PublicKeySize))
+
+	tests := []struct {
+		name          string
+		expectResult  []*dto.PublicKeyDTO
+		setMockIssuer func(*token.MockAccessTokenIssuer)
+	}{
+		{
+			name:         "successfully got",
+			expectResult: []*dto.PublicKeyDTO{{ID: "kid", Key: publicKey}},
+			setMockIssuer: func(issuer *token.MockAccessTokenIssuer) {
+				issuer.
+					EXPECT().
+					PublicKeys().
+					Return([]*domainToken.PublicKey{{ID: "kid", Key: publicKey}}).
+					Times(1)
+			},
+		},
+		{
+			name:          "access token disabled",
+			expectResult:  []*dto.PublicKeyDTO{},
+			setMockIssuer: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var issuer domainToken.AccessTokenIssuer
+			if tt.setMockIssuer != nil {
+				mockIssuer := token.NewMockAccessTokenIssuer(ctrl)
+				tt.setMockIssuer(mockIssuer)
+				issuer = mockIssuer
+			}
+
+			uc := usecase.NewKeyUsecase(issuer)
+			result := uc.GetPublicKeys()
+
+			if diff := cmp.Diff(tt.expectResult, result); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/transaction"
+)
+
+type loginTarget struct {
+	targetType string
+	target     string
+	policy     entity.LoginThrottlePolicy
+}
+
+// NOTE: パスワードを検証するユースケース間で失敗回数を共有するため, ログイン試行の制限を切り出す.
+type loginThrottle struct {
+	transactionObj   transaction.TransactionObject
+	loginFailureRepo repository.LoginFailureRepository
+	accountPolicy    entity.LoginThrottlePolicy
+	ipPolicy         entity.LoginThrottlePolicy
+}
+
+func newLoginThrottle(
+	transactionObj transaction.TransactionObject,
+	loginFailureRepo repository.LoginFailureRepository,
+	accountPolicy entity.LoginThrottlePolicy,
+	ipPolicy entity.LoginThrottlePolicy,
+) *loginThrottle {
+	return &loginThrottle{
+		transactionObj:   transactionObj,
+		loginFailureRepo: loginFailureRepo,
+		accountPolicy:    accountPolicy,
+		ipPolicy:         ipPolicy,
+	}
+}
+
+// NOTE: アカウント名として不正な値は該当するアカウントが存在し得ず, 保存できる長さも超え得るため, アカウント名毎の失敗回数の対象としない.
+func (t *loginThrottle) targets(accountName, ipAddress string) []loginTarget {
+	var targets []loginTarget
+	if 0 < t.accountPolicy.Threshold && entity.ValidateAccountName(accountName) == nil {
+		targets = append(targets, loginTarget{entity.LoginFailureTargetAccount, accountName, t.accountPolicy})
+	}
+	if 0 < t.ipPolicy.Threshold && ipAddress != "" {
+		targets = append(targets, loginTarget{entity.LoginFailureTargetIP, ipAddress, t.ipPolicy})
+	}
+	return targets
+}
+
+// NOTE: 複数の対象がロックされている場合は最も遅いロック解除までの時間を返す.
+func (t *loginThrottle) check(ctx context.Context, targets []loginTarget) error {
+	var retryAfter time.Duration
+	for _, target := range targets {
+		failure, err := t.loginFailureRepo.FindOneByTarget(ctx, target.targetType, target.target)
+		if err != nil {
+			return err
+		}
+		if failure != nil && retryAfter < failure.RetryAfter() {
+			retryAfter = failure.RetryAfter()
+		}
+	}
+
+	if 0 < retryAfter {
+		return errors.Wrap(&entity.LoginLockedError{RetryAfter: retryAfter}, errors.CodeUnauthenticated, "failed to authenticate")
+	}
+	return nil
+}
+
+// NOTE: 認証の失敗で呼び出し元のトランザクションがロールバックされても失敗回数が残るよう, 独立したトランザクションで記録する.
+// 存在しない行へのロックはギャップロックとなり初回の同時失敗でデッドロックするため, 行を用意してからロックして取得する.
+func (t *loginThrottle) record(ctx context.Context, targets []loginTarget) error {
+	return t.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		for _, target := range targets {
+			if err := t.loginFailureRepo.Ensure(ctx, entity.NewLoginFailure(target.targetType, target.target)); err != nil {
+				return err
+			}
+
+			failure, err := t.loginFailureRepo.FindOneByTargetForUpdate(ctx, target.targetType, target.target)
+			if err != nil {
+				return err
+			}
+			if failure == nil {
+				failure = entity.NewLoginFailure(target.targetType, target.target)
+			}
+
+			failure.Record(target.policy)
+
+			if err := t.loginFailureRepo.Save(ctx, failure); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// NOTE: 送信元IPの失敗回数は他アカウントへの試行も含むため, ログイン成功時にはリセットしない.
+func (t *loginThrottle) reset(ctx context.Context, targets []loginTarget) error {
+	for _, target := range targets {
+		if target.targetType != entity.LoginFailureTargetAccount {
+			continue
+		}
+		if err := t.loginFailureRepo.Delete(ctx, entity.NewLoginFailure(target.targetType, target.target)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package mapper
 
 import (
+	"time"
+
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
 )
@@ -10,9 +12,61 @@ func ToAccountDTO(account *entity.Account) *dto.AccountDTO {
 		return nil
 	}
 
+	var roles []string
+	for _, role := range account.Roles {
+		roles = append(roles, role.Name)
+	}
+
+	var permissions []string
+	for _, permission := range account.Permissions() {
+		permissions = append(permissions, string(permission))
+	}
+
 	return &dto.AccountDTO{
-		ID:       account.ID,
-		Name:     account.Name,
-		Password: account.Password,
+		ID:          account.ID,
+		Name:        account.Name,
+		Email:       account.Email,
+		Roles:       roles,
+		Permissions: permissions,
+		CreatedAt:   account.CreatedAt,
+		UpdatedAt:   account.UpdatedAt,
+		DeletedAt:   account.DeletedAt,
+	}
+}
+
+func ToAccountDTOs(accounts []*entity.Account) []*dto.AccountDTO {
+	if accounts == nil {
+		return nil
+	}
+
+	dtos := make([]*dto.AccountDTO, len(accounts))
+	for i, account := range accounts {
+		dtos[i] = ToAccountDTO(account)
+	}
+	return dtos
+}
+
+func ToAccountPageDTO(accounts []*entity.Account, nextCursor string) *dto.AccountPageDTO {
+	return &dto.AccountPageDTO{
+		Accounts:   ToAccountDTOs(accounts),
+		NextCursor: nextCursor,
+	}
+}
+
+func ToAccountSecurityDTO(totp *entity.TOTP, passkeys []*entity.Passkey) *dto.AccountSecurityDTO {
+	return &dto.AccountSecurityDTO{
+		TwoFactorEnabled: totp != nil && totp.Enabled,
+		PasskeyCount:     len(passkeys),
+	}
+}
+
+func ToDeletedAccountDTO(account *entity.Account, restoreDeadline time.Time) *dto.DeletedAccountDTO {
+	if account == nil {
+		return nil
+	}
+
+	return &dto.DeletedAccountDTO{
+		Name:            account.Name,
+		RestoreDeadline: restoreDeadline,
 	}
 }
@@ -0,0 +1,29 @@
+package mapper
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/token"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+func ToPublicKeyDTO(key *token.PublicKey) *dto.PublicKeyDTO {
+	if key == nil {
+		return nil
+	}
+
+	return &dto.PublicKeyDTO{
+		ID:  key.ID,
+		Key: key.Key,
+	}
+}
+
+func ToPublicKeyDTOs(keys []*token.PublicKey) []*dto.PublicKeyDTO {
+	if keys == nil {
+		return nil
+	}
+
+	dtos := make([]*dto.PublicKeyDTO, len(keys))
+	for i, key := range keys {
+		dtos[i] = ToPublicKeyDTO(key)
+	}
+	return dtos
+}
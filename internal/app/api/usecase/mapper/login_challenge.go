@@ -0,0 +1,18 @@
+package mapper
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+func ToLoginChallengeDTO(challenge *entity.LoginChallenge, methods []string) *dto.LoginChallengeDTO {
+	if challenge == nil {
+		return nil
+	}
+
+	return &dto.LoginChallengeDTO{
+		Token:     challenge.Token,
+		Methods:   methods,
+		ExpiresAt: challenge.ExpiresAt,
+	}
+}
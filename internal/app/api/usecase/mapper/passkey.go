@@ -0,0 +1,67 @@
+package mapper
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+func ToPasskeyDTO(passkey *entity.Passkey) *dto.PasskeyDTO {
+	if passkey == nil {
+		return nil
+	}
+
+	return &dto.PasskeyDTO{
+		ID:         passkey.ID,
+		Name:       passkey.Name,
+		LastUsedAt: passkey.LastUsedAt,
+		CreatedAt:  passkey.CreatedAt,
+	}
+}
+
+func ToPasskeyDTOs(passkeys []*entity.Passkey) []*dto.PasskeyDTO {
+	if passkeys == nil {
+		return nil
+	}
+
+	dtos := make([]*dto.PasskeyDTO, len(passkeys))
+	for i, passkey := range passkeys {
+		dtos[i] = ToPasskeyDTO(passkey)
+	}
+	return dtos
+}
+
+// NOTE: 同じ認証器を重複して登録しないよう, 登録済みの認証情報IDを除外対象として返す.
+func ToPasskeyRegistrationOptionsDTO(challenge *entity.PasskeyChallenge, account *entity.Account, passkeys []*entity.Passkey, rp webauthn.RelyingParty) *dto.PasskeyRegistrationOptionsDTO {
+	if challenge == nil || account == nil || rp == nil {
+		return nil
+	}
+
+	excludeCredentialIDs := make([][]byte, len(passkeys))
+	for i, passkey := range passkeys {
+		excludeCredentialIDs[i] = passkey.CredentialID
+	}
+
+	return &dto.PasskeyRegistrationOptionsDTO{
+		Challenge:            challenge.Challenge,
+		RPID:                 rp.ID(),
+		RPName:               rp.Name(),
+		UserID:               account.ID[:],
+		UserName:             account.Name,
+		Algorithms:           webauthn.SupportedAlgorithms(),
+		ExcludeCredentialIDs: excludeCredentialIDs,
+		Timeout:              challenge.Timeout(),
+	}
+}
+
+func ToPasskeyAuthenticationOptionsDTO(challenge *entity.PasskeyChallenge, rp webauthn.RelyingParty) *dto.PasskeyAuthenticationOptionsDTO {
+	if challenge == nil || rp == nil {
+		return nil
+	}
+
+	return &dto.PasskeyAuthenticationOptionsDTO{
+		Challenge: challenge.Challenge,
+		RPID:      rp.ID(),
+		Timeout:   challenge.Timeout(),
+	}
+}
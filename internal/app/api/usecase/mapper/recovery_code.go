@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+func ToRecoveryCodesDTO(codes []*entity.RecoveryCode) *dto.RecoveryCodesDTO {
+	formatted := make([]string, len(codes))
+	for i, code := range codes {
+		formatted[i] = code.Formatted()
+	}
+
+	return &dto.RecoveryCodesDTO{
+		Codes: formatted,
+	}
+}
+
+func ToRecoveryCodeCountDTO(remaining int) *dto.RecoveryCodeCountDTO {
+	return &dto.RecoveryCodeCountDTO{
+		Remaining: remaining,
+	}
+}
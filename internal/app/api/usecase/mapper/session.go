@@ -11,8 +11,49 @@ func ToSessionDTO(session *entity.Session) *dto.SessionDTO {
 	}
 
 	return &dto.SessionDTO{
-		AccountID: session.AccountID,
-		Token:     session.Token,
-		ExpiresAt: session.ExpiresAt,
+		ID:               session.ID,
+		AccountID:        session.AccountID,
+		Token:            session.Token,
+		RefreshToken:     session.RefreshToken,
+		UserAgent:        session.UserAgent,
+		IPAddress:        session.IPAddress,
+		ExpiresAt:        session.ExpiresAt,
+		RefreshExpiresAt: session.RefreshExpiresAt,
+		IssuedAt:         session.IssuedAt,
+		LastUsedAt:       session.LastUsedAt,
+		CreatedAt:        session.CreatedAt,
+	}
+}
+
+func ToSessionDTOWithAccessToken(session *entity.Session, accessToken string) *dto.SessionDTO {
+	sessionDTO := ToSessionDTO(session)
+	if sessionDTO == nil {
+		return nil
+	}
+
+	sessionDTO.AccessToken = accessToken
+	return sessionDTO
+}
+
+func ToSessionDTOs(sessions []*entity.Session) []*dto.SessionDTO {
+	if sessions == nil {
+		return nil
+	}
+
+	dtos := make([]*dto.SessionDTO, len(sessions))
+	for i, session := range sessions {
+		dtos[i] = ToSessionDTO(session)
+	}
+	return dtos
+}
+
+func ToVerifiedSessionDTO(session *entity.Session, account *entity.Account) *dto.VerifiedSessionDTO {
+	if session == nil || account == nil {
+		return nil
+	}
+
+	return &dto.VerifiedSessionDTO{
+		Session: ToSessionDTO(session),
+		Account: ToAccountDTO(account),
 	}
 }
@@ -0,0 +1,17 @@
+package mapper
+
+import (
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+)
+
+func ToTOTPEnrollmentDTO(totp *entity.TOTP, issuer, accountName string) *dto.TOTPEnrollmentDTO {
+	if totp == nil {
+		return nil
+	}
+
+	return &dto.TOTPEnrollmentDTO{
+		Secret: totp.EncodedSecret(),
+		URI:    totp.URI(issuer, accountName),
+	}
+}
@@ -0,0 +1,209 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../test/mock/$GOPACKAGE/$GOFILE
+package usecase
+
+import (
+	"context"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/mapper"
+)
+
+var (
+	ErrPasskeyNotFound          = stderr.New("passkey not found")
+	ErrPasskeyAlreadyRegistered = stderr.New("passkey already registered")
+	ErrPasskeyChallengeNotFound = stderr.New("passkey challenge not found")
+)
+
+type PasskeyUsecase interface {
+	CreateRegistrationOptions(context.Context, uuid.UUID) (*dto.PasskeyRegistrationOptionsDTO, error)
+	Register(context.Context, uuid.UUID, string, *webauthn.Attestation) (*dto.PasskeyDTO, error)
+	GetAll(context.Context, uuid.UUID) ([]*dto.PasskeyDTO, error)
+	Rename(context.Context, uuid.UUID, uuid.UUID, string) (*dto.PasskeyDTO, error)
+	Delete(context.Context, uuid.UUID, uuid.UUID) error
+}
+
+type passkeyUsecase struct {
+	transactionObj       transaction.TransactionObject
+	accountRepo          repository.AccountRepository
+	passkeyRepo          repository.PasskeyRepository
+	passkeyChallengeRepo repository.PasskeyChallengeRepository
+	relyingParty         webauthn.RelyingParty
+}
+
+func NewPasskeyUsecase(
+	transactionObj transaction.TransactionObject,
+	accountRepo repository.AccountRepository,
+	passkeyRepo repository.PasskeyRepository,
+	passkeyChallengeRepo repository.PasskeyChallengeRepository,
+	relyingParty webauthn.RelyingParty,
+) PasskeyUsecase {
+	return &passkeyUsecase{
+		transactionObj:       transactionObj,
+		accountRepo:          accountRepo,
+		passkeyRepo:          passkeyRepo,
+		passkeyChallengeRepo: passkeyChallengeRepo,
+		relyingParty:         relyingParty,
+	}
+}
+
+func (u *passkeyUsecase) CreateRegistrationOptions(ctx context.Context, accountID uuid.UUID) (*dto.PasskeyRegistrationOptionsDTO, error) {
+	var account *entity.Account
+	var passkeys []*entity.Passkey
+	var challenge *entity.PasskeyChallenge
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		account, err = u.accountRepo.FindOneByID(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, "failed to create passkey registration options")
+		}
+
+		passkeys, err = u.passkeyRepo.FindByAccountID(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		challenge, err = entity.NewPasskeyRegistrationChallenge(account)
+		if err != nil {
+			return err
+		}
+
+		return u.passkeyChallengeRepo.Create(ctx, challenge)
+	}); err != nil {
+		return nil, err
+	}
+
+	return mapper.ToPasskeyRegistrationOptionsDTO(challenge, account, passkeys, u.relyingParty), nil
+}
+
+// NOTE: チャレンジは発行したアカウントでのみ使用でき, 検証に成功した場合は再利用されないよう削除する.
+func (u *passkeyUsecase) Register(ctx context.Context, accountID uuid.UUID, name string, attestation *webauthn.Attestation) (*dto.PasskeyDTO, error) {
+	const errMessage = "failed to register passkey"
+
+	if attestation == nil {
+		return nil, errors.Wrap(webauthn.ErrAttestationInvalid, errors.CodeBadRequest, errMessage)
+	}
+
+	clientData, err := u.relyingParty.ParseClientData(attestation.ClientDataJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeBadRequest, errMessage)
+	}
+
+	var passkey *entity.Passkey
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		account, err := u.accountRepo.FindOneByID(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, errMessage)
+		}
+
+		credential, err := u.verifyRegistration(ctx, account, clientData.Challenge, attestation)
+		if err != nil {
+			return err
+		}
+
+		passkey, err = entity.NewPasskey(account, credential, name)
+		if err != nil {
+			return err
+		}
+
+		return u.passkeyRepo.Create(ctx, passkey)
+	}); err != nil {
+		return nil, err
+	}
+
+	return mapper.ToPasskeyDTO(passkey), nil
+}
+
+func (u *passkeyUsecase) GetAll(ctx context.Context, accountID uuid.UUID) ([]*dto.PasskeyDTO, error) {
+	passkeys, err := u.passkeyRepo.FindByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToPasskeyDTOs(passkeys), nil
+}
+
+func (u *passkeyUsecase) Rename(ctx context.Context, accountID, id uuid.UUID, name string) (*dto.PasskeyDTO, error) {
+	var passkey *entity.Passkey
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		passkey, err = u.passkeyRepo.FindOneByIDAndAccountID(ctx, id, accountID)
+		if err != nil {
+			return err
+		}
+		if passkey == nil {
+			return errors.Wrap(ErrPasskeyNotFound, errors.CodeNotFound, "failed to rename passkey")
+		}
+
+		if err := passkey.SetName(name); err != nil {
+			return err
+		}
+
+		return u.passkeyRepo.Update(ctx, passkey)
+	}); err != nil {
+		return nil, err
+	}
+
+	return mapper.ToPasskeyDTO(passkey), nil
+}
+
+func (u *passkeyUsecase) Delete(ctx context.Context, accountID, id uuid.UUID) error {
+	return u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		passkey, err := u.passkeyRepo.FindOneByIDAndAccountID(ctx, id, accountID)
+		if err != nil {
+			return err
+		}
+		if passkey == nil {
+			return nil
+		}
+
+		return u.passkeyRepo.Delete(ctx, passkey)
+	})
+}
+
+func (u *passkeyUsecase) verifyRegistration(ctx context.Context, account *entity.Account, value string, attestation *webauthn.Attestation) (*webauthn.Credential, error) {
+	const errMessage = "failed to register passkey"
+
+	challenge, err := u.passkeyChallengeRepo.FindOneByPurposeAndChallengeAndNotExpiredForUpdate(ctx, entity.PasskeyChallengePurposeRegistration, value)
+	if err != nil {
+		return nil, err
+	}
+	if challenge == nil || challenge.AccountID != account.ID {
+		return nil, errors.Wrap(ErrPasskeyChallengeNotFound, errors.CodeBadRequest, errMessage)
+	}
+
+	credential, err := u.relyingParty.VerifyRegistration(challenge.Challenge, attestation)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeBadRequest, errMessage)
+	}
+
+	registered, err := u.passkeyRepo.FindOneByCredentialIDForUpdate(ctx, credential.ID)
+	if err != nil {
+		return nil, err
+	}
+	if registered != nil {
+		return nil, errors.Wrap(ErrPasskeyAlreadyRegistered, errors.CodeDuplicate, errMessage)
+	}
+
+	if err := u.passkeyChallengeRepo.Delete(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	return credential, nil
+}
@@ -0,0 +1,840 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	"github.com/atsumarukun/holos-account-api/test/authenticator"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository/pkg/transaction"
+)
+
+const (
+	relyingPartyID     = "localhost"
+	relyingPartyOrigin = "http://localhost"
+)
+
+var relyingParty = webauthn.NewRelyingParty(relyingPartyID, "holos", []string{relyingPartyOrigin})
+
+func TestPasskey_CreateRegistrationOptions(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+	passkey := &entity.Passkey{
+		ID:           uuid.New(),
+		AccountID:    account.ID,
+		CredentialID: []byte("credential"),
+		Name:         "laptop",
+	}
+
+	tests := []struct {
+		name                        string
+		inputAccountID              uuid.UUID
+		expectResult                *dto.PasskeyRegistrationOptionsDTO
+		expectError                 error
+		setMockTransactionObj       func(*transaction.MockTransactionObject)
+		setMockAccountRepo          func(*repository.MockAccountRepository)
+		setMockPasskeyRepo          func(*repository.MockPasskeyRepository)
+		setMockPasskeyChallengeRepo func(*repository.MockPasskeyChallengeRepository)
+	}{
+		{
+			name:           "successfully created",
+			inputAccountID: account.ID,
+			expectResult: &dto.PasskeyRegistrationOptionsDTO{
+				RPID:                 relyingPartyID,
+				RPName:               "holos",
+				UserID:               account.ID[:],
+				UserName:             account.Name,
+				Algorithms:           []int{webauthn.AlgorithmES256, webauthn.AlgorithmRS256},
+				ExcludeCredentialIDs: [][]byte{passkey.CredentialID},
+				Timeout:              time.Minute * 5,
+			},
+			expectError: nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), account.ID).
+					Return([]*entity.Passkey{passkey}, nil).
+					Times(1)
+			},
+			setMockPasskeyChallengeRepo: func(passkeyChallengeRepo *repository.MockPasskeyChallengeRepository) {
+				passkeyChallengeRepo.
+					EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:           "account not found",
+			inputAccountID: account.ID,
+			expectResult:   nil,
+			expectError:    usecase.ErrAccountNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo:          func(*repository.MockPasskeyRepository) {},
+			setMockPasskeyChallengeRepo: func(*repository.MockPasskeyChallengeRepository) {},
+		},
+		{
+			name:           "create challenge error",
+			inputAccountID: account.ID,
+			expectResult:   nil,
+			expectError:    sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockPasskeyChallengeRepo: func(passkeyChallengeRepo *repository.MockPasskeyChallengeRepository) {
+				passkeyChallengeRepo.
+					EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to create passkey challenge")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := repository.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			passkeyRepo := repository.NewMockPasskeyRepository(ctrl)
+			tt.setMockPasskeyRepo(passkeyRepo)
+
+			passkeyChallengeRepo := repository.NewMockPasskeyChallengeRepository(ctrl)
+			tt.setMockPasskeyChallengeRepo(passkeyChallengeRepo)
+
+			uc := usecase.NewPasskeyUsecase(transactionObj, accountRepo, passkeyRepo, passkeyChallengeRepo, relyingParty)
+			result, err := uc.CreateRegistrationOptions(ctx, tt.inputAccountID)
+			assert.Error(t, err, tt.expectError)
+
+			opts := cmp.Options{
+				cmpopts.IgnoreFields(dto.PasskeyRegistrationOptionsDTO{}, "Challenge"),
+			}
+			if diff := cmp.Diff(tt.expectResult, result, opts...); diff != "" {
+				t.Error(diff)
+			}
+
+			if result != nil && len(result.Challenge) != 32 {
+				t.Error("challenge length is not 32")
+			}
+		})
+	}
+}
+
+func TestPasskey_Register(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+	challenge := &entity.PasskeyChallenge{
+		ID:        uuid.New(),
+		AccountID: account.ID,
+		Purpose:   entity.PasskeyChallengePurposeRegistration,
+		Challenge: "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		ExpiresAt: time.Now().Add(time.Minute * 5),
+		CreatedAt: time.Now(),
+	}
+
+	auth, err := authenticator.New(relyingPartyID, relyingPartyOrigin, account.ID[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	attestation, err := auth.Register(challenge.Challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	foreignAuth, err := authenticator.New(relyingPartyID, "http://example.com", account.ID[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreignAttestation, err := foreignAuth.Register(challenge.Challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                        string
+		inputName                   string
+		inputAttestation            *webauthn.Attestation
+		expectResult                *dto.PasskeyDTO
+		expectError                 error
+		setMockTransactionObj       func(*transaction.MockTransactionObject)
+		setMockAccountRepo          func(*repository.MockAccountRepository)
+		setMockPasskeyRepo          func(*repository.MockPasskeyRepository)
+		setMockPasskeyChallengeRepo func(*repository.MockPasskeyChallengeRepository)
+	}{
+		{
+			name:             "successfully registered",
+			inputName:        "laptop",
+			inputAttestation: attestation,
+			expectResult:     &dto.PasskeyDTO{Name: "laptop"},
+			expectError:      nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindOneByCredentialIDForUpdate(gomock.Any(), auth.CredentialID).
+					Return(nil, nil).
+					Times(1)
+				passkeyRepo.
+					EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockPasskeyChallengeRepo: func(passkeyChallengeRepo *repository.MockPasskeyChallengeRepository) {
+				passkeyChallengeRepo.
+					EXPECT().
+					FindOneByPurposeAndChallengeAndNotExpiredForUpdate(gomock.Any(), entity.PasskeyChallengePurposeRegistration, challenge.Challenge).
+					Return(challenge, nil).
+					Times(1)
+				passkeyChallengeRepo.
+					EXPECT().
+					Delete(gomock.Any(), challenge).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                        "invalid client data",
+			inputName:                   "laptop",
+			inputAttestation:            &webauthn.Attestation{ClientDataJSON: []byte("invalid"), AttestationObject: attestation.AttestationObject},
+			expectResult:                nil,
+			expectError:                 webauthn.ErrClientDataInvalid,
+			setMockTransactionObj:       func(*transaction.MockTransactionObject) {},
+			setMockAccountRepo:          func(*repository.MockAccountRepository) {},
+			setMockPasskeyRepo:          func(*repository.MockPasskeyRepository) {},
+			setMockPasskeyChallengeRepo: func(*repository.MockPasskeyChallengeRepository) {},
+		},
+		{
+			name:             "challenge not found",
+			inputName:        "laptop",
+			inputAttestation: attestation,
+			expectResult:     nil,
+			expectError:      usecase.ErrPasskeyChallengeNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(*repository.MockPasskeyRepository) {},
+			setMockPasskeyChallengeRepo: func(passkeyChallengeRepo *repository.MockPasskeyChallengeRepository) {
+				passkeyChallengeRepo.
+					EXPECT().
+					FindOneByPurposeAndChallengeAndNotExpiredForUpdate(gomock.Any(), entity.PasskeyChallengePurposeRegistration, challenge.Challenge).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:             "challenge of another account",
+			inputName:        "laptop",
+			inputAttestation: attestation,
+			expectResult:     nil,
+			expectError:      usecase.ErrPasskeyChallengeNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(*repository.MockPasskeyRepository) {},
+			setMockPasskeyChallengeRepo: func(passkeyChallengeRepo *repository.MockPasskeyChallengeRepository) {
+				passkeyChallengeRepo.
+					EXPECT().
+					FindOneByPurposeAndChallengeAndNotExpiredForUpdate(gomock.Any(), entity.PasskeyChallengePurposeRegistration, challenge.Challenge).
+					Return(&entity.PasskeyChallenge{ID: uuid.New(), AccountID: uuid.New(), Purpose: challenge.Purpose, Challenge: challenge.Challenge}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:             "origin not allowed",
+			inputName:        "laptop",
+			inputAttestation: foreignAttestation,
+			expectResult:     nil,
+			expectError:      webauthn.ErrOriginNotAllowed,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(*repository.MockPasskeyRepository) {},
+			setMockPasskeyChallengeRepo: func(passkeyChallengeRepo *repository.MockPasskeyChallengeRepository) {
+				passkeyChallengeRepo.
+					EXPECT().
+					FindOneByPurposeAndChallengeAndNotExpiredForUpdate(gomock.Any(), entity.PasskeyChallengePurposeRegistration, challenge.Challenge).
+					Return(challenge, nil).
+					Times(1)
+			},
+		},
+		{
+			name:             "already registered",
+			inputName:        "laptop",
+			inputAttestation: attestation,
+			expectResult:     nil,
+			expectError:      usecase.ErrPasskeyAlreadyRegistered,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindOneByCredentialIDForUpdate(gomock.Any(), auth.CredentialID).
+					Return(&entity.Passkey{ID: uuid.New(), AccountID: account.ID, CredentialID: auth.CredentialID}, nil).
+					Times(1)
+			},
+			setMockPasskeyChallengeRepo: func(passkeyChallengeRepo *repository.MockPasskeyChallengeRepository) {
+				passkeyChallengeRepo.
+					EXPECT().
+					FindOneByPurposeAndChallengeAndNotExpiredForUpdate(gomock.Any(), entity.PasskeyChallengePurposeRegistration, challenge.Challenge).
+					Return(challenge, nil).
+					Times(1)
+			},
+		},
+		{
+			name:             "invalid name",
+			inputName:        "",
+			inputAttestation: attestation,
+			expectResult:     nil,
+			expectError:      entity.ErrPasskeyNameInvalidLength,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindOneByCredentialIDForUpdate(gomock.Any(), auth.CredentialID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockPasskeyChallengeRepo: func(passkeyChallengeRepo *repository.MockPasskeyChallengeRepository) {
+				passkeyChallengeRepo.
+					EXPECT().
+					FindOneByPurposeAndChallengeAndNotExpiredForUpdate(gomock.Any(), entity.PasskeyChallengePurposeRegistration, challenge.Challenge).
+					Return(challenge, nil).
+					Times(1)
+				passkeyChallengeRepo.
+					EXPECT().
+					Delete(gomock.Any(), challenge).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:             "account not found",
+			inputName:        "laptop",
+			inputAttestation: attestation,
+			expectResult:     nil,
+			expectError:      usecase.ErrAccountNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockPasskeyRepo:          func(*repository.MockPasskeyRepository) {},
+			setMockPasskeyChallengeRepo: func(*repository.MockPasskeyChallengeRepository) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := repository.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			passkeyRepo := repository.NewMockPasskeyRepository(ctrl)
+			tt.setMockPasskeyRepo(passkeyRepo)
+
+			passkeyChallengeRepo := repository.NewMockPasskeyChallengeRepository(ctrl)
+			tt.setMockPasskeyChallengeRepo(passkeyChallengeRepo)
+
+			uc := usecase.NewPasskeyUsecase(transactionObj, accountRepo, passkeyRepo, passkeyChallengeRepo, relyingParty)
+			result, err := uc.Register(ctx, account.ID, tt.inputName, tt.inputAttestation)
+			assert.Error(t, err, tt.expectError)
+
+			opts := cmp.Options{
+				cmpopts.IgnoreFields(dto.PasskeyDTO{}, "ID", "CreatedAt"),
+			}
+			if diff := cmp.Diff(tt.expectResult, result, opts...); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestPasskey_GetAll(t *testing.T) {
+	passkey := &entity.Passkey{
+		ID:           uuid.New(),
+		AccountID:    uuid.New(),
+		CredentialID: []byte("credential"),
+		PublicKey:    []byte("public key"),
+		SignCount:    1,
+		Name:         "laptop",
+		LastUsedAt:   time.Now(),
+		CreatedAt:    time.Now(),
+	}
+
+	tests := []struct {
+		name               string
+		expectResult       []*dto.PasskeyDTO
+		expectError        error
+		setMockPasskeyRepo func(*repository.MockPasskeyRepository)
+	}{
+		{
+			name:         "successfully got",
+			expectResult: []*dto.PasskeyDTO{{ID: passkey.ID, Name: passkey.Name, LastUsedAt: passkey.LastUsedAt, CreatedAt: passkey.CreatedAt}},
+			expectError:  nil,
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), passkey.AccountID).
+					Return([]*entity.Passkey{passkey}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:         "find passkeys error",
+			expectResult: nil,
+			expectError:  sql.ErrConnDone,
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindByAccountID(gomock.Any(), passkey.AccountID).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find passkeys by account id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			passkeyRepo := repository.NewMockPasskeyRepository(ctrl)
+			tt.setMockPasskeyRepo(passkeyRepo)
+
+			uc := usecase.NewPasskeyUsecase(nil, nil, passkeyRepo, nil, relyingParty)
+			result, err := uc.GetAll(ctx, passkey.AccountID)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(tt.expectResult, result); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestPasskey_Rename(t *testing.T) {
+	passkey := &entity.Passkey{
+		ID:           uuid.New(),
+		AccountID:    uuid.New(),
+		CredentialID: []byte("credential"),
+		PublicKey:    []byte("public key"),
+		Name:         "laptop",
+		CreatedAt:    time.Now(),
+	}
+
+	tests := []struct {
+		name                  string
+		inputName             string
+		expectResult          *dto.PasskeyDTO
+		expectError           error
+		setMockTransactionObj func(*transaction.MockTransactionObject)
+		setMockPasskeyRepo    func(*repository.MockPasskeyRepository)
+	}{
+		{
+			name:         "successfully renamed",
+			inputName:    "phone",
+			expectResult: &dto.PasskeyDTO{ID: passkey.ID, Name: "phone", CreatedAt: passkey.CreatedAt},
+			expectError:  nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindOneByIDAndAccountID(gomock.Any(), passkey.ID, passkey.AccountID).
+					Return(entity.RestorePasskey(passkey.ID, passkey.AccountID, passkey.CredentialID, passkey.PublicKey, passkey.SignCount, passkey.Name, passkey.LastUsedAt, passkey.CreatedAt), nil).
+					Times(1)
+				passkeyRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:         "passkey not found",
+			inputName:    "phone",
+			expectResult: nil,
+			expectError:  usecase.ErrPasskeyNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindOneByIDAndAccountID(gomock.Any(), passkey.ID, passkey.AccountID).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:         "invalid name",
+			inputName:    "",
+			expectResult: nil,
+			expectError:  entity.ErrPasskeyNameInvalidLength,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindOneByIDAndAccountID(gomock.Any(), passkey.ID, passkey.AccountID).
+					Return(entity.RestorePasskey(passkey.ID, passkey.AccountID, passkey.CredentialID, passkey.PublicKey, passkey.SignCount, passkey.Name, passkey.LastUsedAt, passkey.CreatedAt), nil).
+					Times(1)
+			},
+		},
+		{
+			name:         "update error",
+			inputName:    "phone",
+			expectResult: nil,
+			expectError:  sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindOneByIDAndAccountID(gomock.Any(), passkey.ID, passkey.AccountID).
+					Return(entity.RestorePasskey(passkey.ID, passkey.AccountID, passkey.CredentialID, passkey.PublicKey, passkey.SignCount, passkey.Name, passkey.LastUsedAt, passkey.CreatedAt), nil).
+					Times(1)
+				passkeyRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to update passkey")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			passkeyRepo := repository.NewMockPasskeyRepository(ctrl)
+			tt.setMockPasskeyRepo(passkeyRepo)
+
+			uc := usecase.NewPasskeyUsecase(transactionObj, nil, passkeyRepo, nil, relyingParty)
+			result, err := uc.Rename(ctx, passkey.AccountID, passkey.ID, tt.inputName)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(tt.expectResult, result); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestPasskey_Delete(t *testing.T) {
+	passkey := &entity.Passkey{
+		ID:           uuid.New(),
+		AccountID:    uuid.New(),
+		CredentialID: []byte("credential"),
+		PublicKey:    []byte("public key"),
+		Name:         "laptop",
+		CreatedAt:    time.Now(),
+	}
+
+	tests := []struct {
+		name                  string
+		expectError           error
+		setMockTransactionObj func(*transaction.MockTransactionObject)
+		setMockPasskeyRepo    func(*repository.MockPasskeyRepository)
+	}{
+		{
+			name:        "successfully deleted",
+			expectError: nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindOneByIDAndAccountID(gomock.Any(), passkey.ID, passkey.AccountID).
+					Return(passkey, nil).
+					Times(1)
+				passkeyRepo.
+					EXPECT().
+					Delete(gomock.Any(), passkey).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "passkey not found",
+			expectError: nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindOneByIDAndAccountID(gomock.Any(), passkey.ID, passkey.AccountID).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "delete error",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockPasskeyRepo: func(passkeyRepo *repository.MockPasskeyRepository) {
+				passkeyRepo.
+					EXPECT().
+					FindOneByIDAndAccountID(gomock.Any(), passkey.ID, passkey.AccountID).
+					Return(passkey, nil).
+					Times(1)
+				passkeyRepo.
+					EXPECT().
+					Delete(gomock.Any(), passkey).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete passkey")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			passkeyRepo := repository.NewMockPasskeyRepository(ctrl)
+			tt.setMockPasskeyRepo(passkeyRepo)
+
+			uc := usecase.NewPasskeyUsecase(transactionObj, nil, passkeyRepo, nil, relyingParty)
+			err := uc.Delete(ctx, passkey.AccountID, passkey.ID)
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../test/mock/$GOPACKAGE/$GOFILE
+package usecase
+
+import (
+	"context"
+	stderr "errors"
+	"fmt"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/hasher"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/mailer"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/mapper"
+)
+
+var ErrPasswordResetNotFound = stderr.New("password reset not found")
+
+type PasswordResetUsecase interface {
+	Request(context.Context, string) error
+	Confirm(context.Context, string, string, string) (*dto.AccountDTO, error)
+}
+
+type passwordResetUsecase struct {
+	transactionObj    transaction.TransactionObject
+	accountRepo       repository.AccountRepository
+	passwordResetRepo repository.PasswordResetRepository
+	sessionRepo       repository.SessionRepository
+	mailer            mailer.Mailer
+	passwordPolicy    entity.PasswordPolicy
+	passwordHasher    hasher.PasswordHasher
+}
+
+func NewPasswordResetUsecase(
+	transactionObj transaction.TransactionObject,
+	accountRepo repository.AccountRepository,
+	passwordResetRepo repository.PasswordResetRepository,
+	sessionRepo repository.SessionRepository,
+	mailer mailer.Mailer,
+	passwordPolicy entity.PasswordPolicy,
+	passwordHasher hasher.PasswordHasher,
+) PasswordResetUsecase {
+	return &passwordResetUsecase{
+		transactionObj:    transactionObj,
+		accountRepo:       accountRepo,
+		passwordResetRepo: passwordResetRepo,
+		sessionRepo:       sessionRepo,
+		mailer:            mailer,
+		passwordPolicy:    passwordPolicy,
+		passwordHasher:    passwordHasher,
+	}
+}
+
+// NOTE: アカウントの存在を推測されないよう, メールアドレスに一致するアカウントが存在しない場合もエラーとしない.
+// メールアドレスは確認後にのみ設定されるため, 確認済みのメールアドレス宛にのみ送信される.
+// 送信に要する時間や送信結果からも推測されないよう, メーラーには非同期に送信するものを渡す.
+func (u *passwordResetUsecase) Request(ctx context.Context, email string) error {
+	var mail *mailer.Mail
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		account, err := u.accountRepo.FindOneByEmail(ctx, email)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return nil
+		}
+
+		reset, err := entity.NewPasswordReset(account)
+		if err != nil {
+			return err
+		}
+
+		if err := u.passwordResetRepo.Save(ctx, reset); err != nil {
+			return err
+		}
+
+		mail = newPasswordResetMail(account, reset)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if mail == nil {
+		return nil
+	}
+	return u.mailer.Send(ctx, mail)
+}
+
+// NOTE: 再設定前のパスワードで作成されたセッションが残らないよう, 同一トランザクション内でセッションを全て失効させる.
+func (u *passwordResetUsecase) Confirm(ctx context.Context, token, password, confirmPassword string) (*dto.AccountDTO, error) {
+	const errMessage = "failed to reset password"
+
+	var account *entity.Account
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		reset, err := u.passwordResetRepo.FindOneByTokenAndNotExpired(ctx, token)
+		if err != nil {
+			return err
+		}
+		if reset == nil {
+			return errors.Wrap(ErrPasswordResetNotFound, errors.CodeBadRequest, errMessage)
+		}
+
+		account, err = u.accountRepo.FindOneByID(ctx, reset.AccountID)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrPasswordResetNotFound, errors.CodeBadRequest, errMessage)
+		}
+
+		if err := account.SetPassword(password, confirmPassword, u.passwordPolicy, u.passwordHasher); err != nil {
+			return err
+		}
+
+		if err := u.accountRepo.Update(ctx, account); err != nil {
+			return err
+		}
+
+		if err := u.passwordResetRepo.Delete(ctx, reset); err != nil {
+			return err
+		}
+
+		return u.sessionRepo.DeleteByAccountID(ctx, account.ID)
+	}); err != nil {
+		return nil, err
+	}
+
+	return mapper.ToAccountDTO(account), nil
+}
+
+func newPasswordResetMail(account *entity.Account, reset *entity.PasswordReset) *mailer.Mail {
+	return &mailer.Mail{
+		To:      account.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Use the following token to reset the password for %s.\n\n%s\n\nThis token expires at %s.\nIf you did not request a password reset, you can ignore this email.\n",
+			account.Name,
+			reset.Token,
+			reset.ExpiresAt.UTC().Format(time.RFC3339),
+		),
+	}
+}
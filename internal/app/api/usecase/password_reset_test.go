@@ -0,0 +1,508 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/mailer"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	mockMailer "github.com/atsumarukun/holos-account-api/test/mock/domain/pkg/mailer"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository/pkg/transaction"
+)
+
+func TestPasswordReset_Request(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		Email:    "name@example.com",
+	}
+
+	var token string
+
+	tests := []struct {
+		name                     string
+		expectError              error
+		setMockTransactionObj    func(*transaction.MockTransactionObject)
+		setMockAccountRepo       func(*repository.MockAccountRepository)
+		setMockPasswordResetRepo func(*repository.MockPasswordResetRepository)
+		setMockMailer            func(*mockMailer.MockMailer)
+	}{
+		{
+			name:        "successfully requested",
+			expectError: nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByEmail(gomock.Any(), "name@example.com").
+					Return(account, nil).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(passwordResetRepo *repository.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, reset *entity.PasswordReset) error {
+						if reset.AccountID != account.ID {
+							t.Errorf("\nexpect: %v\ngot: %v", account.ID, reset.AccountID)
+						}
+						token = reset.Token
+						return nil
+					}).
+					Times(1)
+			},
+			setMockMailer: func(m *mockMailer.MockMailer) {
+				m.
+					EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, mail *mailer.Mail) error {
+						if mail.To != "name@example.com" {
+							t.Errorf("\nexpect: %v\ngot: %v", "name@example.com", mail.To)
+						}
+						if !strings.Contains(mail.Body, token) {
+							t.Error("token is not contained in mail body")
+						}
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name:        "account not found",
+			expectError: nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByEmail(gomock.Any(), "name@example.com").
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(*repository.MockPasswordResetRepository) {},
+			setMockMailer:            func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:        "find error",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByEmail(gomock.Any(), "name@example.com").
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find account by email")).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(*repository.MockPasswordResetRepository) {},
+			setMockMailer:            func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:        "save error",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByEmail(gomock.Any(), "name@example.com").
+					Return(account, nil).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(passwordResetRepo *repository.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to save password reset")).
+					Times(1)
+			},
+			setMockMailer: func(*mockMailer.MockMailer) {},
+		},
+		{
+			name:        "send error",
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByEmail(gomock.Any(), "name@example.com").
+					Return(account, nil).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(passwordResetRepo *repository.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockMailer: func(m *mockMailer.MockMailer) {
+				m.
+					EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to send mail")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := repository.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			passwordResetRepo := repository.NewMockPasswordResetRepository(ctrl)
+			tt.setMockPasswordResetRepo(passwordResetRepo)
+
+			m := mockMailer.NewMockMailer(ctrl)
+			tt.setMockMailer(m)
+
+			uc := usecase.NewPasswordResetUsecase(transactionObj, accountRepo, passwordResetRepo, nil, m, passwordPolicy, passwordHasher)
+			err := uc.Request(ctx, "name@example.com")
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
+
+func TestPasswordReset_Confirm(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+		Email:    "name@example.com",
+	}
+	reset := &entity.PasswordReset{
+		AccountID: account.ID,
+		Token:     "1Ty1HKTPKTt8xEi-_3HTbWf2SCHOdqOS",
+		ExpiresAt: time.Now().Add(time.Minute * 30),
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name                     string
+		inputPassword            string
+		inputConfirmPassword     string
+		expectError              error
+		setMockTransactionObj    func(*transaction.MockTransactionObject)
+		setMockAccountRepo       func(*repository.MockAccountRepository)
+		setMockPasswordResetRepo func(*repository.MockPasswordResetRepository)
+		setMockSessionRepo       func(*repository.MockSessionRepository)
+	}{
+		{
+			name:                 "successfully reset",
+			inputPassword:        "new_password",
+			inputConfirmPassword: "new_password",
+			expectError:          nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					DoAndReturn(func(context.Context, uuid.UUID) (*entity.Account, error) {
+						acc := *account
+						return &acc, nil
+					}).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, acc *entity.Account) error {
+						if acc.Password == account.Password {
+							t.Error("password is not changed")
+						}
+						return nil
+					}).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(passwordResetRepo *repository.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					FindOneByTokenAndNotExpired(gomock.Any(), reset.Token).
+					Return(reset, nil).
+					Times(1)
+				passwordResetRepo.
+					EXPECT().
+					Delete(gomock.Any(), reset).
+					Return(nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *repository.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                 "reset not found",
+			inputPassword:        "new_password",
+			inputConfirmPassword: "new_password",
+			expectError:          usecase.ErrPasswordResetNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(*repository.MockAccountRepository) {},
+			setMockPasswordResetRepo: func(passwordResetRepo *repository.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					FindOneByTokenAndNotExpired(gomock.Any(), reset.Token).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(*repository.MockSessionRepository) {},
+		},
+		{
+			name:                 "account not found",
+			inputPassword:        "new_password",
+			inputConfirmPassword: "new_password",
+			expectError:          usecase.ErrPasswordResetNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(passwordResetRepo *repository.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					FindOneByTokenAndNotExpired(gomock.Any(), reset.Token).
+					Return(reset, nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(*repository.MockSessionRepository) {},
+		},
+		{
+			name:                 "password mismatch",
+			inputPassword:        "new_password",
+			inputConfirmPassword: "mismatch",
+			expectError:          entity.ErrAccountPasswordMismatch,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					DoAndReturn(func(context.Context, uuid.UUID) (*entity.Account, error) {
+						acc := *account
+						return &acc, nil
+					}).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(passwordResetRepo *repository.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					FindOneByTokenAndNotExpired(gomock.Any(), reset.Token).
+					Return(reset, nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(*repository.MockSessionRepository) {},
+		},
+		{
+			name:                 "update error",
+			inputPassword:        "new_password",
+			inputConfirmPassword: "new_password",
+			expectError:          sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					DoAndReturn(func(context.Context, uuid.UUID) (*entity.Account, error) {
+						acc := *account
+						return &acc, nil
+					}).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to update account")).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(passwordResetRepo *repository.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					FindOneByTokenAndNotExpired(gomock.Any(), reset.Token).
+					Return(reset, nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(*repository.MockSessionRepository) {},
+		},
+		{
+			name:                 "delete sessions error",
+			inputPassword:        "new_password",
+			inputConfirmPassword: "new_password",
+			expectError:          sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					DoAndReturn(func(context.Context, uuid.UUID) (*entity.Account, error) {
+						acc := *account
+						return &acc, nil
+					}).
+					Times(1)
+				accountRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, acc *entity.Account) error {
+						if acc.Password == account.Password {
+							t.Error("password is not changed")
+						}
+						return nil
+					}).
+					Times(1)
+			},
+			setMockPasswordResetRepo: func(passwordResetRepo *repository.MockPasswordResetRepository) {
+				passwordResetRepo.
+					EXPECT().
+					FindOneByTokenAndNotExpired(gomock.Any(), reset.Token).
+					Return(reset, nil).
+					Times(1)
+				passwordResetRepo.
+					EXPECT().
+					Delete(gomock.Any(), reset).
+					Return(nil).
+					Times(1)
+			},
+			setMockSessionRepo: func(sessionRepo *repository.MockSessionRepository) {
+				sessionRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete sessions")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := repository.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			passwordResetRepo := repository.NewMockPasswordResetRepository(ctrl)
+			tt.setMockPasswordResetRepo(passwordResetRepo)
+
+			sessionRepo := repository.NewMockSessionRepository(ctrl)
+			tt.setMockSessionRepo(sessionRepo)
+
+			uc := usecase.NewPasswordResetUsecase(transactionObj, accountRepo, passwordResetRepo, sessionRepo, nil, passwordPolicy, passwordHasher)
+			result, err := uc.Confirm(ctx, reset.Token, tt.inputPassword, tt.inputConfirmPassword)
+			assert.Error(t, err, tt.expectError)
+
+			if tt.expectError == nil && result.ID != account.ID {
+				t.Errorf("\nexpect: %v\ngot: %v", account.ID, result.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../test/mock/$GOPACKAGE/$GOFILE
+package usecase
+
+import (
+	"context"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/hasher"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/mapper"
+)
+
+type RecoveryCodeUsecase interface {
+	Regenerate(context.Context, uuid.UUID, string) (*dto.RecoveryCodesDTO, error)
+	Count(context.Context, uuid.UUID) (*dto.RecoveryCodeCountDTO, error)
+}
+
+type recoveryCodeUsecase struct {
+	transactionObj   transaction.TransactionObject
+	accountRepo      repository.AccountRepository
+	totpRepo         repository.TOTPRepository
+	recoveryCodeRepo repository.RecoveryCodeRepository
+	passwordHasher   hasher.PasswordHasher
+}
+
+func NewRecoveryCodeUsecase(
+	transactionObj transaction.TransactionObject,
+	accountRepo repository.AccountRepository,
+	totpRepo repository.TOTPRepository,
+	recoveryCodeRepo repository.RecoveryCodeRepository,
+	passwordHasher hasher.PasswordHasher,
+) RecoveryCodeUsecase {
+	return &recoveryCodeUsecase{
+		transactionObj:   transactionObj,
+		accountRepo:      accountRepo,
+		totpRepo:         totpRepo,
+		recoveryCodeRepo: recoveryCodeRepo,
+		passwordHasher:   passwordHasher,
+	}
+}
+
+// NOTE: 2要素認証が有効なアカウントにのみ発行し, 再発行時は既存のコードを全て無効にする.
+func (u *recoveryCodeUsecase) Regenerate(ctx context.Context, accountID uuid.UUID, password string) (*dto.RecoveryCodesDTO, error) {
+	const errMessage = "failed to regenerate recovery codes"
+
+	var codes []*entity.RecoveryCode
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		account, err := u.accountRepo.FindOneByID(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, errMessage)
+		}
+
+		if err := account.VerifyPassword(password, u.passwordHasher); err != nil {
+			return err
+		}
+
+		totp, err := u.totpRepo.FindOneByAccountIDForUpdate(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if totp == nil || !totp.Enabled {
+			return errors.Wrap(ErrTOTPNotEnrolled, errors.CodeConstraintViolation, errMessage)
+		}
+
+		codes, err = entity.NewRecoveryCodes(account)
+		if err != nil {
+			return err
+		}
+
+		return u.replaceRecoveryCodes(ctx, accountID, codes)
+	}); err != nil {
+		return nil, err
+	}
+
+	return mapper.ToRecoveryCodesDTO(codes), nil
+}
+
+func (u *recoveryCodeUsecase) Count(ctx context.Context, accountID uuid.UUID) (*dto.RecoveryCodeCountDTO, error) {
+	remaining, err := u.recoveryCodeRepo.CountByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToRecoveryCodeCountDTO(remaining), nil
+}
+
+func (u *recoveryCodeUsecase) replaceRecoveryCodes(ctx context.Context, accountID uuid.UUID, codes []*entity.RecoveryCode) error {
+	if err := u.recoveryCodeRepo.DeleteByAccountID(ctx, accountID); err != nil {
+		return err
+	}
+
+	for _, code := range codes {
+		if err := u.recoveryCodeRepo.Create(ctx, code); err != nil {
+			return err
+		}
+	}
+	return nil
+}
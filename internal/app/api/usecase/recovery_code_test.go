@@ -0,0 +1,333 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository/pkg/transaction"
+)
+
+func TestRecoveryCode_Regenerate(t *testing.T) {
+	hashed, err := passwordHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: hashed,
+	}
+	totp := &entity.TOTP{
+		AccountID: account.ID,
+		Secret:    []byte("12345678901234567890"),
+		Enabled:   true,
+	}
+
+	tests := []struct {
+		name                    string
+		inputPassword           string
+		expectCount             int
+		expectError             error
+		setMockTransactionObj   func(*transaction.MockTransactionObject)
+		setMockAccountRepo      func(*repository.MockAccountRepository)
+		setMockTOTPRepo         func(*repository.MockTOTPRepository)
+		setMockRecoveryCodeRepo func(*repository.MockRecoveryCodeRepository)
+	}{
+		{
+			name:          "successfully regenerated",
+			inputPassword: "password",
+			expectCount:   10,
+			expectError:   nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), account.ID).
+					Return(totp, nil).
+					Times(1)
+			},
+			setMockRecoveryCodeRepo: func(recoveryCodeRepo *repository.MockRecoveryCodeRepository) {
+				gomock.InOrder(
+					recoveryCodeRepo.
+						EXPECT().
+						DeleteByAccountID(gomock.Any(), account.ID).
+						Return(nil).
+						Times(1),
+					recoveryCodeRepo.
+						EXPECT().
+						Create(gomock.Any(), gomock.Any()).
+						Return(nil).
+						Times(10),
+				)
+			},
+		},
+		{
+			name:          "totp not enabled",
+			inputPassword: "password",
+			expectCount:   0,
+			expectError:   usecase.ErrTOTPNotEnrolled,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), account.ID).
+					Return(&entity.TOTP{AccountID: account.ID, Secret: totp.Secret}, nil).
+					Times(1)
+			},
+			setMockRecoveryCodeRepo: func(*repository.MockRecoveryCodeRepository) {},
+		},
+		{
+			name:          "account not found",
+			inputPassword: "password",
+			expectCount:   0,
+			expectError:   usecase.ErrAccountNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockTOTPRepo:         func(*repository.MockTOTPRepository) {},
+			setMockRecoveryCodeRepo: func(*repository.MockRecoveryCodeRepository) {},
+		},
+		{
+			name:          "authentication failed",
+			inputPassword: "PASSWORD",
+			expectCount:   0,
+			expectError:   entity.ErrAccountPasswordIncorrect,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo:         func(*repository.MockTOTPRepository) {},
+			setMockRecoveryCodeRepo: func(*repository.MockRecoveryCodeRepository) {},
+		},
+		{
+			name:          "delete recovery codes error",
+			inputPassword: "password",
+			expectCount:   0,
+			expectError:   sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), account.ID).
+					Return(totp, nil).
+					Times(1)
+			},
+			setMockRecoveryCodeRepo: func(recoveryCodeRepo *repository.MockRecoveryCodeRepository) {
+				recoveryCodeRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete recovery codes by account id")).
+					Times(1)
+			},
+		},
+		{
+			name:          "create recovery code error",
+			inputPassword: "password",
+			expectCount:   0,
+			expectError:   sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), account.ID).
+					Return(totp, nil).
+					Times(1)
+			},
+			setMockRecoveryCodeRepo: func(recoveryCodeRepo *repository.MockRecoveryCodeRepository) {
+				recoveryCodeRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(nil).
+					Times(1)
+				recoveryCodeRepo.
+					EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to create recovery code")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := repository.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			totpRepo := repository.NewMockTOTPRepository(ctrl)
+			tt.setMockTOTPRepo(totpRepo)
+
+			recoveryCodeRepo := repository.NewMockRecoveryCodeRepository(ctrl)
+			tt.setMockRecoveryCodeRepo(recoveryCodeRepo)
+
+			uc := usecase.NewRecoveryCodeUsecase(transactionObj, accountRepo, totpRepo, recoveryCodeRepo, passwordHasher)
+			result, err := uc.Regenerate(ctx, account.ID, tt.inputPassword)
+			assert.Error(t, err, tt.expectError)
+
+			var count int
+			if result != nil {
+				count = len(result.Codes)
+			}
+			if count != tt.expectCount {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectCount, count)
+			}
+		})
+	}
+}
+
+func TestRecoveryCode_Count(t *testing.T) {
+	accountID := uuid.New()
+
+	tests := []struct {
+		name                    string
+		expectResult            *dto.RecoveryCodeCountDTO
+		expectError             error
+		setMockRecoveryCodeRepo func(*repository.MockRecoveryCodeRepository)
+	}{
+		{
+			name:         "successfully counted",
+			expectResult: &dto.RecoveryCodeCountDTO{Remaining: 8},
+			expectError:  nil,
+			setMockRecoveryCodeRepo: func(recoveryCodeRepo *repository.MockRecoveryCodeRepository) {
+				recoveryCodeRepo.
+					EXPECT().
+					CountByAccountID(gomock.Any(), accountID).
+					Return(8, nil).
+					Times(1)
+			},
+		},
+		{
+			name:         "count error",
+			expectResult: nil,
+			expectError:  sql.ErrConnDone,
+			setMockRecoveryCodeRepo: func(recoveryCodeRepo *repository.MockRecoveryCodeRepository) {
+				recoveryCodeRepo.
+					EXPECT().
+					CountByAccountID(gomock.Any(), accountID).
+					Return(0, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to count recovery codes by account id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			recoveryCodeRepo := repository.NewMockRecoveryCodeRepository(ctrl)
+			tt.setMockRecoveryCodeRepo(recoveryCodeRepo)
+
+			uc := usecase.NewRecoveryCodeUsecase(nil, nil, nil, recoveryCodeRepo, passwordHasher)
+			result, err := uc.Count(ctx, accountID)
+			assert.Error(t, err, tt.expectError)
+
+			if diff := cmp.Diff(tt.expectResult, result); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../test/mock/$GOPACKAGE/$GOFILE
+package usecase
+
+import (
+	"context"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/lock"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/transaction"
+)
+
+const bootstrapAdminLockName = "holos_account_bootstrap_admin"
+
+var ErrRoleNotFound = stderr.New("role not found")
+
+type RoleUsecase interface {
+	BootstrapAdmin(context.Context, string) error
+}
+
+type roleUsecase struct {
+	lockObj        lock.LockObject
+	transactionObj transaction.TransactionObject
+	accountRepo    repository.AccountRepository
+	roleRepo       repository.RoleRepository
+}
+
+func NewRoleUsecase(
+	lockObj lock.LockObject,
+	transactionObj transaction.TransactionObject,
+	accountRepo repository.AccountRepository,
+	roleRepo repository.RoleRepository,
+) RoleUsecase {
+	return &roleUsecase{
+		lockObj:        lockObj,
+		transactionObj: transactionObj,
+		accountRepo:    accountRepo,
+		roleRepo:       roleRepo,
+	}
+}
+
+// NOTE: 管理者が1人も存在しない場合のみ, 指定されたアカウントに管理者ロールを割り当てる.
+// 管理者が存在する状態で設定が残っていても, 権限が付与され直さないようにするため.
+func (u *roleUsecase) BootstrapAdmin(ctx context.Context, accountName string) error {
+	const errMessage = "failed to bootstrap admin"
+
+	if accountName == "" {
+		return nil
+	}
+
+	return u.lockObj.Lock(ctx, bootstrapAdminLockName, func(ctx context.Context) error {
+		return u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+			role, err := u.roleRepo.FindOneByName(ctx, entity.RoleNameAdmin)
+			if err != nil {
+				return err
+			}
+			if role == nil {
+				return errors.Wrap(ErrRoleNotFound, errors.CodeInternalServerError, errMessage)
+			}
+
+			count, err := u.roleRepo.CountAccountsByID(ctx, role.ID)
+			if err != nil {
+				return err
+			}
+			if 0 < count {
+				return nil
+			}
+
+			account, err := u.accountRepo.FindOneByName(ctx, accountName)
+			if err != nil {
+				return err
+			}
+			if account == nil {
+				return errors.Wrap(ErrAccountNotFound, errors.CodeNotFound, errMessage)
+			}
+
+			return u.roleRepo.Assign(ctx, role, account)
+		})
+	})
+}
@@ -0,0 +1,403 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository/pkg/lock"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository/pkg/transaction"
+)
+
+func TestRole_BootstrapAdmin(t *testing.T) {
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: "$2a$10$o7qO5pbzyAfDkBcx7Mbw9.cNCyY9V/jTjPzdSMbbwb6IixUHg3PZK",
+	}
+	role := &entity.Role{
+		ID:          uuid.New(),
+		Name:        entity.RoleNameAdmin,
+		Permissions: []entity.Permission{entity.PermissionAccountsRead, entity.PermissionAccountsWrite},
+	}
+
+	tests := []struct {
+		name                  string
+		inputAccountName      string
+		expectError           error
+		setMockLockObj        func(*lock.MockLockObject)
+		setMockTransactionObj func(*transaction.MockTransactionObject)
+		setMockAccountRepo    func(*repository.MockAccountRepository)
+		setMockRoleRepo       func(*repository.MockRoleRepository)
+	}{
+		{
+			name:             "successfully bootstrapped",
+			inputAccountName: "name",
+			expectError:      nil,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByName(gomock.Any(), "name").
+					Return(account, nil).
+					Times(1)
+			},
+			setMockRoleRepo: func(roleRepo *repository.MockRoleRepository) {
+				roleRepo.
+					EXPECT().
+					FindOneByName(gomock.Any(), entity.RoleNameAdmin).
+					Return(role, nil).
+					Times(1)
+				roleRepo.
+					EXPECT().
+					CountAccountsByID(gomock.Any(), role.ID).
+					Return(0, nil).
+					Times(1)
+				roleRepo.
+					EXPECT().
+					Assign(gomock.Any(), role, account).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "account name not set",
+			inputAccountName:      "",
+			expectError:           nil,
+			setMockLockObj:        func(*lock.MockLockObject) {},
+			setMockTransactionObj: func(*transaction.MockTransactionObject) {},
+			setMockAccountRepo:    func(*repository.MockAccountRepository) {},
+			setMockRoleRepo:       func(*repository.MockRoleRepository) {},
+		},
+		{
+			name:             "admin already exists",
+			inputAccountName: "name",
+			expectError:      nil,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(*repository.MockAccountRepository) {},
+			setMockRoleRepo: func(roleRepo *repository.MockRoleRepository) {
+				roleRepo.
+					EXPECT().
+					FindOneByName(gomock.Any(), entity.RoleNameAdmin).
+					Return(role, nil).
+					Times(1)
+				roleRepo.
+					EXPECT().
+					CountAccountsByID(gomock.Any(), role.ID).
+					Return(1, nil).
+					Times(1)
+			},
+		},
+		{
+			name:             "lock not acquired",
+			inputAccountName: "name",
+			expectError:      nil,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			setMockTransactionObj: func(*transaction.MockTransactionObject) {},
+			setMockAccountRepo:    func(*repository.MockAccountRepository) {},
+			setMockRoleRepo:       func(*repository.MockRoleRepository) {},
+		},
+		{
+			name:             "role not found",
+			inputAccountName: "name",
+			expectError:      usecase.ErrRoleNotFound,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(*repository.MockAccountRepository) {},
+			setMockRoleRepo: func(roleRepo *repository.MockRoleRepository) {
+				roleRepo.
+					EXPECT().
+					FindOneByName(gomock.Any(), entity.RoleNameAdmin).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:             "account not found",
+			inputAccountName: "name",
+			expectError:      usecase.ErrAccountNotFound,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByName(gomock.Any(), "name").
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockRoleRepo: func(roleRepo *repository.MockRoleRepository) {
+				roleRepo.
+					EXPECT().
+					FindOneByName(gomock.Any(), entity.RoleNameAdmin).
+					Return(role, nil).
+					Times(1)
+				roleRepo.
+					EXPECT().
+					CountAccountsByID(gomock.Any(), role.ID).
+					Return(0, nil).
+					Times(1)
+			},
+		},
+		{
+			name:             "find role error",
+			inputAccountName: "name",
+			expectError:      sql.ErrConnDone,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(*repository.MockAccountRepository) {},
+			setMockRoleRepo: func(roleRepo *repository.MockRoleRepository) {
+				roleRepo.
+					EXPECT().
+					FindOneByName(gomock.Any(), entity.RoleNameAdmin).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find role by name")).
+					Times(1)
+			},
+		},
+		{
+			name:             "count accounts error",
+			inputAccountName: "name",
+			expectError:      sql.ErrConnDone,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(*repository.MockAccountRepository) {},
+			setMockRoleRepo: func(roleRepo *repository.MockRoleRepository) {
+				roleRepo.
+					EXPECT().
+					FindOneByName(gomock.Any(), entity.RoleNameAdmin).
+					Return(role, nil).
+					Times(1)
+				roleRepo.
+					EXPECT().
+					CountAccountsByID(gomock.Any(), role.ID).
+					Return(0, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to count accounts by role id")).
+					Times(1)
+			},
+		},
+		{
+			name:             "find account error",
+			inputAccountName: "name",
+			expectError:      sql.ErrConnDone,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByName(gomock.Any(), "name").
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find account by name")).
+					Times(1)
+			},
+			setMockRoleRepo: func(roleRepo *repository.MockRoleRepository) {
+				roleRepo.
+					EXPECT().
+					FindOneByName(gomock.Any(), entity.RoleNameAdmin).
+					Return(role, nil).
+					Times(1)
+				roleRepo.
+					EXPECT().
+					CountAccountsByID(gomock.Any(), role.ID).
+					Return(0, nil).
+					Times(1)
+			},
+		},
+		{
+			name:             "assign error",
+			inputAccountName: "name",
+			expectError:      sql.ErrConnDone,
+			setMockLockObj: func(lockObj *lock.MockLockObject) {
+				lockObj.
+					EXPECT().
+					Lock(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByName(gomock.Any(), "name").
+					Return(account, nil).
+					Times(1)
+			},
+			setMockRoleRepo: func(roleRepo *repository.MockRoleRepository) {
+				roleRepo.
+					EXPECT().
+					FindOneByName(gomock.Any(), entity.RoleNameAdmin).
+					Return(role, nil).
+					Times(1)
+				roleRepo.
+					EXPECT().
+					CountAccountsByID(gomock.Any(), role.ID).
+					Return(0, nil).
+					Times(1)
+				roleRepo.
+					EXPECT().
+					Assign(gomock.Any(), role, account).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to assign role")).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			lockObj := lock.NewMockLockObject(ctrl)
+			tt.setMockLockObj(lockObj)
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := repository.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			roleRepo := repository.NewMockRoleRepository(ctrl)
+			tt.setMockRoleRepo(roleRepo)
+
+			uc := usecase.NewRoleUsecase(lockObj, transactionObj, accountRepo, roleRepo)
+			err := uc.BootstrapAdmin(ctx, tt.inputAccountName)
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
@@ -2,6 +2,7 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
 	stderr "errors"
 
@@ -9,40 +10,115 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/hasher"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/token"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/transaction"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
 	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/mapper"
 )
 
-var ErrSessionNotFound = stderr.New("session not found")
+var (
+	ErrSessionNotFound        = stderr.New("session not found")
+	ErrRefreshTokenReused     = stderr.New("refresh token reused")
+	ErrLoginChallengeNotFound = stderr.New("login challenge not found")
+	ErrLoginChallengeMethod   = stderr.New("login challenge method is not supported")
+)
+
+// NOTE: 失効させたセッションのキャッシュを破棄できるよう, セッションIDを保持する.
+type RefreshTokenReusedError struct {
+	SessionID uuid.UUID
+}
+
+func (e *RefreshTokenReusedError) Error() string {
+	return ErrRefreshTokenReused.Error()
+}
+
+func (e *RefreshTokenReusedError) Unwrap() error {
+	return ErrRefreshTokenReused
+}
 
 type SessionUsecase interface {
-	Create(context.Context, string, string) (*dto.SessionDTO, error)
-	Delete(context.Context, uuid.UUID) error
-	Verify(context.Context, string) (*dto.AccountDTO, error)
+	Create(context.Context, string, string, bool, string, string) (*dto.SessionDTO, *dto.LoginChallengeDTO, error)
+	Challenge(context.Context, string, string, string, string, string) (*dto.SessionDTO, error)
+	CreatePasskeyOptions(context.Context) (*dto.PasskeyAuthenticationOptionsDTO, error)
+	CreateWithPasskey(context.Context, *webauthn.Assertion, bool, string, string) (*dto.SessionDTO, error)
+	Delete(context.Context, uuid.UUID, uuid.UUID) error
+	GetAll(context.Context, uuid.UUID) ([]*dto.SessionDTO, error)
+	Refresh(context.Context, string) (*dto.SessionDTO, error)
+	Verify(context.Context, string) (*dto.VerifiedSessionDTO, error)
 }
 
 type sessionUsecase struct {
-	transactionObj transaction.TransactionObject
-	sessionRepo    repository.SessionRepository
-	accountRepo    repository.AccountRepository
+	transactionObj       transaction.TransactionObject
+	sessionRepo          repository.SessionRepository
+	accountRepo          repository.AccountRepository
+	totpRepo             repository.TOTPRepository
+	loginChallengeRepo   repository.LoginChallengeRepository
+	recoveryCodeRepo     repository.RecoveryCodeRepository
+	passkeyRepo          repository.PasskeyRepository
+	passkeyChallengeRepo repository.PasskeyChallengeRepository
+	roleRepo             repository.RoleRepository
+	relyingParty         webauthn.RelyingParty
+	accessTokenIssuer    token.AccessTokenIssuer
+	passwordHasher       hasher.PasswordHasher
+	policy               entity.SessionPolicy
+	rememberMePolicy     entity.SessionPolicy
+	loginThrottle        *loginThrottle
 }
 
+// NOTE: accessTokenIssuerがnilの場合は署名付きアクセストークンを発行しない.
+// ログイン試行の制限は対象毎のポリシーの閾値が0以下の場合は行わない.
 func NewSessionUsecase(
 	transactionObj transaction.TransactionObject,
 	sessionRepo repository.SessionRepository,
 	accountRepo repository.AccountRepository,
+	loginFailureRepo repository.LoginFailureRepository,
+	totpRepo repository.TOTPRepository,
+	loginChallengeRepo repository.LoginChallengeRepository,
+	recoveryCodeRepo repository.RecoveryCodeRepository,
+	passkeyRepo repository.PasskeyRepository,
+	passkeyChallengeRepo repository.PasskeyChallengeRepository,
+	roleRepo repository.RoleRepository,
+	relyingParty webauthn.RelyingParty,
+	accessTokenIssuer token.AccessTokenIssuer,
+	passwordHasher hasher.PasswordHasher,
+	policy entity.SessionPolicy,
+	rememberMePolicy entity.SessionPolicy,
+	accountLoginPolicy entity.LoginThrottlePolicy,
+	ipLoginPolicy entity.LoginThrottlePolicy,
 ) SessionUsecase {
 	return &sessionUsecase{
-		transactionObj: transactionObj,
-		sessionRepo:    sessionRepo,
-		accountRepo:    accountRepo,
+		transactionObj:       transactionObj,
+		sessionRepo:          sessionRepo,
+		accountRepo:          accountRepo,
+		totpRepo:             totpRepo,
+		loginChallengeRepo:   loginChallengeRepo,
+		recoveryCodeRepo:     recoveryCodeRepo,
+		passkeyRepo:          passkeyRepo,
+		passkeyChallengeRepo: passkeyChallengeRepo,
+		roleRepo:             roleRepo,
+		relyingParty:         relyingParty,
+		accessTokenIssuer:    accessTokenIssuer,
+		passwordHasher:       passwordHasher,
+		policy:               policy,
+		rememberMePolicy:     rememberMePolicy,
+		loginThrottle:        newLoginThrottle(transactionObj, loginFailureRepo, accountLoginPolicy, ipLoginPolicy),
 	}
 }
 
-func (u *sessionUsecase) Create(ctx context.Context, accountName, password string) (*dto.SessionDTO, error) {
+// NOTE: 2要素認証が有効なアカウントの場合はセッションを作成せず, 2要素目の認証を待つチャレンジを返す.
+func (u *sessionUsecase) Create(ctx context.Context, accountName, password string, rememberMe bool, userAgent, ipAddress string) (*dto.SessionDTO, *dto.LoginChallengeDTO, error) {
+	targets := u.loginThrottle.targets(accountName, ipAddress)
+	if err := u.loginThrottle.check(ctx, targets); err != nil {
+		return nil, nil, err
+	}
+
 	var session *entity.Session
+	var accessToken string
+	var challenge *entity.LoginChallenge
+	var methods []string
 
 	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
 		account, err := u.accountRepo.FindOneByName(ctx, accountName)
@@ -53,26 +129,147 @@ func (u *sessionUsecase) Create(ctx context.Context, accountName, password strin
 			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, "failed to create session")
 		}
 
-		if err := account.VerifyPassword(password); err != nil {
+		if err := account.VerifyPassword(password, u.passwordHasher); err != nil {
+			return err
+		}
+
+		if err := u.upgradePasswordHash(ctx, account, password); err != nil {
+			return err
+		}
+
+		challenge, methods, err = u.createLoginChallenge(ctx, account, rememberMe)
+		if err != nil || challenge != nil {
+			return err
+		}
+
+		if err := u.loginThrottle.reset(ctx, targets); err != nil {
 			return err
 		}
 
-		session, err = entity.NewSession(account)
+		session, accessToken, err = u.startSession(ctx, account, rememberMe, userAgent, ipAddress)
+		return err
+	}); err != nil {
+		if stderr.Is(err, ErrAccountNotFound) || stderr.Is(err, entity.ErrAccountPasswordIncorrect) {
+			if err := u.loginThrottle.record(ctx, targets); err != nil {
+				return nil, nil, err
+			}
+		}
+		return nil, nil, err
+	}
+
+	if challenge != nil {
+		return nil, mapper.ToLoginChallengeDTO(challenge, methods), nil
+	}
+	return mapper.ToSessionDTOWithAccessToken(session, accessToken), nil, nil
+}
+
+// NOTE: 2要素目の認証に失敗した場合もパスワード認証と同様にログイン失敗として記録する.
+func (u *sessionUsecase) Challenge(ctx context.Context, token, method, code, userAgent, ipAddress string) (*dto.SessionDTO, error) {
+	challenge, account, err := u.findLoginChallenge(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := u.loginThrottle.targets(account.Name, ipAddress)
+	if err := u.loginThrottle.check(ctx, targets); err != nil {
+		return nil, err
+	}
+
+	var session *entity.Session
+	var accessToken string
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		// NOTE: 同一のチャレンジに対する同時リクエストで複数のセッションが作成されないよう, 行をロックして未使用であることを確認する.
+		locked, err := u.loginChallengeRepo.FindOneByIDForUpdate(ctx, challenge.ID)
 		if err != nil {
 			return err
 		}
+		if locked == nil {
+			return errors.Wrap(ErrLoginChallengeNotFound, errors.CodeUnauthenticated, "failed to complete login challenge")
+		}
+
+		if err := u.verifySecondFactor(ctx, account, method, code); err != nil {
+			return err
+		}
 
-		return u.sessionRepo.Save(ctx, session)
+		if err := u.loginChallengeRepo.Delete(ctx, challenge); err != nil {
+			return err
+		}
+
+		if err := u.loginThrottle.reset(ctx, targets); err != nil {
+			return err
+		}
+
+		session, accessToken, err = u.startSession(ctx, account, challenge.RememberMe, userAgent, ipAddress)
+		return err
 	}); err != nil {
+		if stderr.Is(err, entity.ErrTOTPCodeInvalid) || stderr.Is(err, entity.ErrRecoveryCodeInvalid) {
+			if err := u.recordChallengeFailure(ctx, challenge, targets); err != nil {
+				return nil, err
+			}
+		}
 		return nil, err
 	}
 
-	return mapper.ToSessionDTO(session), nil
+	return mapper.ToSessionDTOWithAccessToken(session, accessToken), nil
 }
 
-func (u *sessionUsecase) Delete(ctx context.Context, accountID uuid.UUID) error {
+func (u *sessionUsecase) CreatePasskeyOptions(ctx context.Context) (*dto.PasskeyAuthenticationOptionsDTO, error) {
+	challenge, err := entity.NewPasskeyAuthenticationChallenge()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.passkeyChallengeRepo.Create(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	return mapper.ToPasskeyAuthenticationOptionsDTO(challenge, u.relyingParty), nil
+}
+
+// NOTE: ユーザー検証を伴うパスキーはそれ自体が多要素認証となるため, TOTPによる2要素目の認証は求めない.
+// パスワードの総当たりの対象とならないため, ログイン試行の制限も行わない.
+func (u *sessionUsecase) CreateWithPasskey(ctx context.Context, assertion *webauthn.Assertion, rememberMe bool, userAgent, ipAddress string) (*dto.SessionDTO, error) {
+	const errMessage = "failed to create session with passkey"
+
+	if assertion == nil {
+		return nil, errors.Wrap(webauthn.ErrAuthenticatorDataInvalid, errors.CodeUnauthenticated, errMessage)
+	}
+
+	clientData, err := u.relyingParty.ParseClientData(assertion.ClientDataJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeUnauthenticated, errMessage)
+	}
+
+	var session *entity.Session
+	var accessToken string
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		passkey, err := u.verifyPasskey(ctx, clientData.Challenge, assertion)
+		if err != nil {
+			return err
+		}
+
+		account, err := u.accountRepo.FindOneByID(ctx, passkey.AccountID)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, errMessage)
+		}
+
+		session, accessToken, err = u.startSession(ctx, account, rememberMe, userAgent, ipAddress)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return mapper.ToSessionDTOWithAccessToken(session, accessToken), nil
+}
+
+func (u *sessionUsecase) Delete(ctx context.Context, accountID, id uuid.UUID) error {
 	return u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
-		session, err := u.sessionRepo.FindOneByAccountID(ctx, accountID)
+		session, err := u.sessionRepo.FindOneByIDAndAccountID(ctx, id, accountID)
 		if err != nil {
 			return err
 		}
@@ -84,11 +281,71 @@ func (u *sessionUsecase) Delete(ctx context.Context, accountID uuid.UUID) error
 	})
 }
 
-func (u *sessionUsecase) Verify(ctx context.Context, token string) (*dto.AccountDTO, error) {
+func (u *sessionUsecase) GetAll(ctx context.Context, accountID uuid.UUID) ([]*dto.SessionDTO, error) {
+	sessions, err := u.sessionRepo.FindByAccountIDAndNotExpired(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToSessionDTOs(sessions), nil
+}
+
+func (u *sessionUsecase) Refresh(ctx context.Context, refreshToken string) (*dto.SessionDTO, error) {
+	var session *entity.Session
+	var accessToken string
+	var revoked *entity.Session
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		session, err = u.sessionRepo.FindOneByRefreshTokenAndNotExpiredForUpdate(ctx, refreshToken)
+		if err != nil {
+			return err
+		}
+		if session == nil {
+			// NOTE: 使用済みトークンの場合は漏洩とみなしセッションを失効させるため, 削除をコミットした上でエラーを返す.
+			revoked, err = u.revokeReusedSession(ctx, refreshToken)
+			if err != nil || revoked != nil {
+				return err
+			}
+			return errors.Wrap(ErrSessionNotFound, errors.CodeUnauthenticated, "failed to refresh session")
+		}
+
+		account, err := u.accountRepo.FindOneByID(ctx, session.AccountID)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, "failed to refresh session")
+		}
+
+		if err := session.GenerateToken(); err != nil {
+			return err
+		}
+
+		if err := u.sessionRepo.Update(ctx, session); err != nil {
+			return err
+		}
+
+		accessToken, err = u.issueAccessToken(account, session)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if revoked != nil {
+		return nil, errors.Wrap(&RefreshTokenReusedError{SessionID: revoked.ID}, errors.CodeUnauthenticated, "failed to refresh session")
+	}
+
+	return mapper.ToSessionDTOWithAccessToken(session, accessToken), nil
+}
+
+func (u *sessionUsecase) Verify(ctx context.Context, token string) (*dto.VerifiedSessionDTO, error) {
+	var session *entity.Session
 	var account *entity.Account
 
 	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
-		session, err := u.sessionRepo.FindOneByTokenAndNotExpired(ctx, token)
+		var err error
+		session, err = u.sessionRepo.FindOneByTokenAndNotExpired(ctx, token)
 		if err != nil {
 			return err
 		}
@@ -104,10 +361,239 @@ func (u *sessionUsecase) Verify(ctx context.Context, token string) (*dto.Account
 			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, "failed to verify")
 		}
 
-		return nil
+		account.Roles, err = u.roleRepo.FindByAccountID(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		session.Touch()
+
+		return u.sessionRepo.UpdateLastUsedAt(ctx, session)
 	}); err != nil {
 		return nil, err
 	}
 
-	return mapper.ToAccountDTO(account), nil
+	return mapper.ToVerifiedSessionDTO(session, account), nil
+}
+
+func (u *sessionUsecase) revokeReusedSession(ctx context.Context, refreshToken string) (*entity.Session, error) {
+	session, err := u.sessionRepo.FindOneByUsedRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, nil
+	}
+
+	if err := u.sessionRepo.Delete(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// NOTE: ログイン時にのみ平文のパスワードが得られるため, 旧方式や旧パラメータのハッシュは同一トランザクション内で再生成する.
+func (u *sessionUsecase) upgradePasswordHash(ctx context.Context, account *entity.Account, password string) error {
+	rehashed, err := account.RehashPassword(password, u.passwordHasher)
+	if err != nil || !rehashed {
+		return err
+	}
+	return u.accountRepo.Update(ctx, account)
+}
+
+func (u *sessionUsecase) startSession(ctx context.Context, account *entity.Account, rememberMe bool, userAgent, ipAddress string) (*entity.Session, string, error) {
+	policy := u.policy
+	if rememberMe {
+		policy = u.rememberMePolicy
+	}
+
+	session, err := entity.NewSession(account, policy, userAgent, ipAddress)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := u.sessionRepo.Create(ctx, session); err != nil {
+		return nil, "", err
+	}
+
+	accessToken, err := u.issueAccessToken(account, session)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return session, accessToken, nil
+}
+
+// NOTE: 有効化前のTOTPは2要素目として扱わないため, 有効なTOTPが登録されている場合にのみチャレンジを作成する.
+func (u *sessionUsecase) createLoginChallenge(ctx context.Context, account *entity.Account, rememberMe bool) (*entity.LoginChallenge, []string, error) {
+	totp, err := u.totpRepo.FindOneByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if totp == nil || !totp.Enabled {
+		return nil, nil, nil
+	}
+
+	methods, err := u.loginChallengeMethods(ctx, account)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	challenge, err := entity.NewLoginChallenge(account, rememberMe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := u.loginChallengeRepo.Create(ctx, challenge); err != nil {
+		return nil, nil, err
+	}
+
+	return challenge, methods, nil
+}
+
+// NOTE: リカバリーコードは未使用のコードが残っている場合にのみ選択肢として返す.
+func (u *sessionUsecase) loginChallengeMethods(ctx context.Context, account *entity.Account) ([]string, error) {
+	remaining, err := u.recoveryCodeRepo.CountByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	methods := []string{entity.LoginChallengeMethodTOTP}
+	if 0 < remaining {
+		methods = append(methods, entity.LoginChallengeMethodRecoveryCode)
+	}
+	return methods, nil
+}
+
+func (u *sessionUsecase) findLoginChallenge(ctx context.Context, token string) (*entity.LoginChallenge, *entity.Account, error) {
+	const errMessage = "failed to complete login challenge"
+
+	challenge, err := u.loginChallengeRepo.FindOneByTokenAndNotExpired(ctx, token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if challenge == nil {
+		return nil, nil, errors.Wrap(ErrLoginChallengeNotFound, errors.CodeUnauthenticated, errMessage)
+	}
+
+	account, err := u.accountRepo.FindOneByID(ctx, challenge.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if account == nil {
+		return nil, nil, errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, errMessage)
+	}
+
+	return challenge, account, nil
+}
+
+func (u *sessionUsecase) verifySecondFactor(ctx context.Context, account *entity.Account, method, code string) error {
+	switch method {
+	case entity.LoginChallengeMethodTOTP:
+		return u.verifyTOTP(ctx, account, code)
+	case entity.LoginChallengeMethodRecoveryCode:
+		return u.useRecoveryCode(ctx, account, code)
+	default:
+		return errors.Wrap(ErrLoginChallengeMethod, errors.CodeBadRequest, "failed to complete login challenge")
+	}
+}
+
+// NOTE: 同一コードの再利用を防ぐため, 検証に成功したステップを同一トランザクション内で記録する.
+func (u *sessionUsecase) verifyTOTP(ctx context.Context, account *entity.Account, code string) error {
+	totp, err := u.totpRepo.FindOneByAccountIDForUpdate(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+	if totp == nil || !totp.Enabled {
+		return errors.Wrap(ErrTOTPNotEnrolled, errors.CodeUnauthenticated, "failed to verify totp")
+	}
+
+	if err := totp.Verify(code); err != nil {
+		return err
+	}
+
+	return u.totpRepo.Save(ctx, totp)
+}
+
+// NOTE: リカバリーコードは使い捨てのため, 検証に成功したコードは同一トランザクション内で削除する.
+func (u *sessionUsecase) useRecoveryCode(ctx context.Context, account *entity.Account, code string) error {
+	recoveryCode, err := u.recoveryCodeRepo.FindOneByAccountIDAndCodeForUpdate(ctx, account.ID, entity.NormalizeRecoveryCode(code))
+	if err != nil {
+		return err
+	}
+	if recoveryCode == nil {
+		return errors.Wrap(entity.ErrRecoveryCodeInvalid, errors.CodeUnauthenticated, "failed to verify recovery code")
+	}
+
+	return u.recoveryCodeRepo.Delete(ctx, recoveryCode)
+}
+
+// NOTE: 認証器の複製を検知するため, 署名カウンタの検証と更新を同一トランザクション内で行う.
+func (u *sessionUsecase) verifyPasskey(ctx context.Context, value string, assertion *webauthn.Assertion) (*entity.Passkey, error) {
+	const errMessage = "failed to verify passkey"
+
+	challenge, err := u.passkeyChallengeRepo.FindOneByPurposeAndChallengeAndNotExpiredForUpdate(ctx, entity.PasskeyChallengePurposeAuthentication, value)
+	if err != nil {
+		return nil, err
+	}
+	if challenge == nil {
+		return nil, errors.Wrap(ErrPasskeyChallengeNotFound, errors.CodeUnauthenticated, errMessage)
+	}
+
+	passkey, err := u.passkeyRepo.FindOneByCredentialIDForUpdate(ctx, assertion.CredentialID)
+	if err != nil {
+		return nil, err
+	}
+	if passkey == nil || (len(assertion.UserHandle) != 0 && !bytes.Equal(assertion.UserHandle, passkey.AccountID[:])) {
+		return nil, errors.Wrap(ErrPasskeyNotFound, errors.CodeUnauthenticated, errMessage)
+	}
+
+	signCount, err := u.relyingParty.VerifyAssertion(challenge.Challenge, passkey.PublicKey, assertion)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeUnauthenticated, errMessage)
+	}
+
+	if err := passkey.Use(signCount); err != nil {
+		return nil, err
+	}
+
+	if err := u.passkeyRepo.Update(ctx, passkey); err != nil {
+		return nil, err
+	}
+
+	if err := u.passkeyChallengeRepo.Delete(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	return passkey, nil
+}
+
+// NOTE: コードの誤りでセッション作成がロールバックされても試行回数が残るよう, チャレンジは別トランザクションで再取得して更新し, 上限に達した場合は削除する.
+func (u *sessionUsecase) recordChallengeFailure(ctx context.Context, challenge *entity.LoginChallenge, targets []loginTarget) error {
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		challenge, err := u.loginChallengeRepo.FindOneByIDForUpdate(ctx, challenge.ID)
+		if err != nil {
+			return err
+		}
+		if challenge == nil {
+			return nil
+		}
+
+		challenge.RecordAttempt()
+		if challenge.Exhausted() {
+			return u.loginChallengeRepo.Delete(ctx, challenge)
+		}
+		return u.loginChallengeRepo.Update(ctx, challenge)
+	}); err != nil {
+		return err
+	}
+
+	return u.loginThrottle.record(ctx, targets)
+}
+
+func (u *sessionUsecase) issueAccessToken(account *entity.Account, session *entity.Session) (string, error) {
+	if u.accessTokenIssuer == nil {
+		return "", nil
+	}
+	return u.accessTokenIssuer.Issue(account, session)
 }
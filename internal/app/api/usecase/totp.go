@@ -0,0 +1,143 @@
+//go:generate mockgen -source=$GOFILE -package=$GOPACKAGE -destination=../../../../test/mock/$GOPACKAGE/$GOFILE
+package usecase
+
+import (
+	"context"
+	stderr "errors"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/hasher"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository/pkg/transaction"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase/mapper"
+)
+
+var ErrTOTPNotEnrolled = stderr.New("totp is not enrolled")
+
+type TOTPUsecase interface {
+	Enroll(context.Context, uuid.UUID, string) (*dto.TOTPEnrollmentDTO, error)
+	Confirm(context.Context, uuid.UUID, string) error
+	Disable(context.Context, uuid.UUID, string) error
+}
+
+type totpUsecase struct {
+	transactionObj   transaction.TransactionObject
+	accountRepo      repository.AccountRepository
+	totpRepo         repository.TOTPRepository
+	recoveryCodeRepo repository.RecoveryCodeRepository
+	passwordHasher   hasher.PasswordHasher
+	issuer           string
+}
+
+func NewTOTPUsecase(
+	transactionObj transaction.TransactionObject,
+	accountRepo repository.AccountRepository,
+	totpRepo repository.TOTPRepository,
+	recoveryCodeRepo repository.RecoveryCodeRepository,
+	passwordHasher hasher.PasswordHasher,
+	issuer string,
+) TOTPUsecase {
+	return &totpUsecase{
+		transactionObj:   transactionObj,
+		accountRepo:      accountRepo,
+		totpRepo:         totpRepo,
+		recoveryCodeRepo: recoveryCodeRepo,
+		passwordHasher:   passwordHasher,
+		issuer:           issuer,
+	}
+}
+
+// NOTE: 有効化前のシークレットは登録をやり直せるよう, 再度呼び出された場合は新しいシークレットで上書きする.
+func (u *totpUsecase) Enroll(ctx context.Context, accountID uuid.UUID, password string) (*dto.TOTPEnrollmentDTO, error) {
+	const errMessage = "failed to enroll totp"
+
+	var account *entity.Account
+	var totp *entity.TOTP
+
+	if err := u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		account, err = u.accountRepo.FindOneByID(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, errMessage)
+		}
+
+		if err := account.VerifyPassword(password, u.passwordHasher); err != nil {
+			return err
+		}
+
+		current, err := u.totpRepo.FindOneByAccountIDForUpdate(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if current != nil && current.Enabled {
+			return errors.Wrap(entity.ErrTOTPAlreadyEnabled, errors.CodeConstraintViolation, errMessage)
+		}
+
+		totp, err = entity.NewTOTP(account)
+		if err != nil {
+			return err
+		}
+
+		return u.totpRepo.Save(ctx, totp)
+	}); err != nil {
+		return nil, err
+	}
+
+	return mapper.ToTOTPEnrollmentDTO(totp, u.issuer, account.Name), nil
+}
+
+func (u *totpUsecase) Confirm(ctx context.Context, accountID uuid.UUID, code string) error {
+	return u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		totp, err := u.totpRepo.FindOneByAccountIDForUpdate(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if totp == nil {
+			return errors.Wrap(ErrTOTPNotEnrolled, errors.CodeNotFound, "failed to confirm totp")
+		}
+
+		if err := totp.Enable(code); err != nil {
+			return err
+		}
+
+		return u.totpRepo.Save(ctx, totp)
+	})
+}
+
+// NOTE: 2要素認証を無効にした場合は, 代替手段であるリカバリーコードも同時に削除する.
+func (u *totpUsecase) Disable(ctx context.Context, accountID uuid.UUID, password string) error {
+	return u.transactionObj.Transaction(ctx, func(ctx context.Context) error {
+		account, err := u.accountRepo.FindOneByID(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return errors.Wrap(ErrAccountNotFound, errors.CodeUnauthenticated, "failed to disable totp")
+		}
+
+		if err := account.VerifyPassword(password, u.passwordHasher); err != nil {
+			return err
+		}
+
+		totp, err := u.totpRepo.FindOneByAccountIDForUpdate(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if totp == nil {
+			return nil
+		}
+
+		if err := u.recoveryCodeRepo.DeleteByAccountID(ctx, accountID); err != nil {
+			return err
+		}
+
+		return u.totpRepo.Delete(ctx, totp)
+	})
+}
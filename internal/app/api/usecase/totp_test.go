@@ -0,0 +1,649 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/atsumarukun/holos-api-pkg/errors"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/usecase"
+	"github.com/atsumarukun/holos-account-api/test/assert"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository"
+	"github.com/atsumarukun/holos-account-api/test/mock/domain/repository/pkg/transaction"
+)
+
+func TestTOTP_Enroll(t *testing.T) {
+	hashed, err := passwordHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: hashed,
+	}
+
+	tests := []struct {
+		name                  string
+		inputPassword         string
+		expectResult          bool
+		expectError           error
+		setMockTransactionObj func(*transaction.MockTransactionObject)
+		setMockAccountRepo    func(*repository.MockAccountRepository)
+		setMockTOTPRepo       func(*repository.MockTOTPRepository)
+	}{
+		{
+			name:          "successfully enrolled",
+			inputPassword: "password",
+			expectResult:  true,
+			expectError:   nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+				totpRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, totp *entity.TOTP) error {
+						if totp.Enabled {
+							t.Error("totp is enabled before confirmation")
+						}
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name:          "successfully re-enrolled",
+			inputPassword: "password",
+			expectResult:  true,
+			expectError:   nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), account.ID).
+					Return(&entity.TOTP{AccountID: account.ID, Secret: []byte("12345678901234567890")}, nil).
+					Times(1)
+				totpRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:          "already enabled",
+			inputPassword: "password",
+			expectResult:  false,
+			expectError:   entity.ErrTOTPAlreadyEnabled,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), account.ID).
+					Return(&entity.TOTP{AccountID: account.ID, Secret: []byte("12345678901234567890"), Enabled: true}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:          "account not found",
+			inputPassword: "password",
+			expectResult:  false,
+			expectError:   usecase.ErrAccountNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(*repository.MockTOTPRepository) {},
+		},
+		{
+			name:          "authentication failed",
+			inputPassword: "PASSWORD",
+			expectResult:  false,
+			expectError:   entity.ErrAccountPasswordIncorrect,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(*repository.MockTOTPRepository) {},
+		},
+		{
+			name:          "save totp error",
+			inputPassword: "password",
+			expectResult:  false,
+			expectError:   sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+				totpRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to save totp")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := repository.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			totpRepo := repository.NewMockTOTPRepository(ctrl)
+			tt.setMockTOTPRepo(totpRepo)
+
+			uc := usecase.NewTOTPUsecase(transactionObj, accountRepo, totpRepo, nil, passwordHasher, "holos")
+			result, err := uc.Enroll(ctx, account.ID, tt.inputPassword)
+			assert.Error(t, err, tt.expectError)
+
+			if (result != nil) != tt.expectResult {
+				t.Errorf("\nexpect: %v\ngot: %v", tt.expectResult, result)
+			}
+			if result != nil && !strings.HasPrefix(result.URI, "otpauth://totp/holos:name?") {
+				t.Errorf("unexpected uri: %s", result.URI)
+			}
+		})
+	}
+}
+
+func TestTOTP_Confirm(t *testing.T) {
+	accountID := uuid.New()
+	secret := []byte("12345678901234567890")
+	newTOTP := func(enabled bool) *entity.TOTP {
+		return &entity.TOTP{
+			AccountID: accountID,
+			Secret:    secret,
+			Enabled:   enabled,
+		}
+	}
+	code := newTOTP(false).Code(time.Now())
+
+	tests := []struct {
+		name                  string
+		inputCode             string
+		expectError           error
+		setMockTransactionObj func(*transaction.MockTransactionObject)
+		setMockTOTPRepo       func(*repository.MockTOTPRepository)
+	}{
+		{
+			name:        "successfully confirmed",
+			inputCode:   code,
+			expectError: nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), accountID).
+					Return(newTOTP(false), nil).
+					Times(1)
+				totpRepo.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, totp *entity.TOTP) error {
+						if !totp.Enabled {
+							t.Error("totp is not enabled")
+						}
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name:        "not enrolled",
+			inputCode:   code,
+			expectError: usecase.ErrTOTPNotEnrolled,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), accountID).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "already enabled",
+			inputCode:   code,
+			expectError: entity.ErrTOTPAlreadyEnabled,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), accountID).
+					Return(newTOTP(true), nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "invalid code",
+			inputCode:   "abcdef",
+			expectError: entity.ErrTOTPCodeInvalid,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), accountID).
+					Return(newTOTP(false), nil).
+					Times(1)
+			},
+		},
+		{
+			name:        "find totp error",
+			inputCode:   code,
+			expectError: sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), accountID).
+					Return(nil, errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "faild to find totp by account id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			totpRepo := repository.NewMockTOTPRepository(ctrl)
+			tt.setMockTOTPRepo(totpRepo)
+
+			uc := usecase.NewTOTPUsecase(transactionObj, nil, totpRepo, nil, passwordHasher, "holos")
+			err := uc.Confirm(ctx, accountID, tt.inputCode)
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
+
+func TestTOTP_Disable(t *testing.T) {
+	hashed, err := passwordHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := &entity.Account{
+		ID:       uuid.New(),
+		Name:     "name",
+		Password: hashed,
+	}
+	totp := &entity.TOTP{
+		AccountID: account.ID,
+		Secret:    []byte("12345678901234567890"),
+		Enabled:   true,
+	}
+
+	tests := []struct {
+		name                    string
+		inputPassword           string
+		expectError             error
+		setMockTransactionObj   func(*transaction.MockTransactionObject)
+		setMockAccountRepo      func(*repository.MockAccountRepository)
+		setMockTOTPRepo         func(*repository.MockTOTPRepository)
+		setMockRecoveryCodeRepo func(*repository.MockRecoveryCodeRepository)
+	}{
+		{
+			name:          "successfully disabled",
+			inputPassword: "password",
+			expectError:   nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), account.ID).
+					Return(totp, nil).
+					Times(1)
+				totpRepo.
+					EXPECT().
+					Delete(gomock.Any(), totp).
+					Return(nil).
+					Times(1)
+			},
+			setMockRecoveryCodeRepo: func(recoveryCodeRepo *repository.MockRecoveryCodeRepository) {
+				recoveryCodeRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:          "not enrolled",
+			inputPassword: "password",
+			expectError:   nil,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockRecoveryCodeRepo: func(*repository.MockRecoveryCodeRepository) {},
+		},
+		{
+			name:          "account not found",
+			inputPassword: "password",
+			expectError:   usecase.ErrAccountNotFound,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(nil, nil).
+					Times(1)
+			},
+			setMockTOTPRepo:         func(*repository.MockTOTPRepository) {},
+			setMockRecoveryCodeRepo: func(*repository.MockRecoveryCodeRepository) {},
+		},
+		{
+			name:          "authentication failed",
+			inputPassword: "PASSWORD",
+			expectError:   entity.ErrAccountPasswordIncorrect,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo:         func(*repository.MockTOTPRepository) {},
+			setMockRecoveryCodeRepo: func(*repository.MockRecoveryCodeRepository) {},
+		},
+		{
+			name:          "delete totp error",
+			inputPassword: "password",
+			expectError:   sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), account.ID).
+					Return(totp, nil).
+					Times(1)
+				totpRepo.
+					EXPECT().
+					Delete(gomock.Any(), totp).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete totp")).
+					Times(1)
+			},
+			setMockRecoveryCodeRepo: func(recoveryCodeRepo *repository.MockRecoveryCodeRepository) {
+				recoveryCodeRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:          "delete recovery codes error",
+			inputPassword: "password",
+			expectError:   sql.ErrConnDone,
+			setMockTransactionObj: func(transactionObj *transaction.MockTransactionObject) {
+				transactionObj.
+					EXPECT().
+					Transaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+			},
+			setMockAccountRepo: func(accountRepo *repository.MockAccountRepository) {
+				accountRepo.
+					EXPECT().
+					FindOneByID(gomock.Any(), account.ID).
+					Return(account, nil).
+					Times(1)
+			},
+			setMockTOTPRepo: func(totpRepo *repository.MockTOTPRepository) {
+				totpRepo.
+					EXPECT().
+					FindOneByAccountIDForUpdate(gomock.Any(), account.ID).
+					Return(totp, nil).
+					Times(1)
+			},
+			setMockRecoveryCodeRepo: func(recoveryCodeRepo *repository.MockRecoveryCodeRepository) {
+				recoveryCodeRepo.
+					EXPECT().
+					DeleteByAccountID(gomock.Any(), account.ID).
+					Return(errors.Wrap(sql.ErrConnDone, errors.CodeInternalServerError, "failed to delete recovery codes by account id")).
+					Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := t.Context()
+
+			transactionObj := transaction.NewMockTransactionObject(ctrl)
+			tt.setMockTransactionObj(transactionObj)
+
+			accountRepo := repository.NewMockAccountRepository(ctrl)
+			tt.setMockAccountRepo(accountRepo)
+
+			totpRepo := repository.NewMockTOTPRepository(ctrl)
+			tt.setMockTOTPRepo(totpRepo)
+
+			recoveryCodeRepo := repository.NewMockRecoveryCodeRepository(ctrl)
+			tt.setMockRecoveryCodeRepo(recoveryCodeRepo)
+
+			uc := usecase.NewTOTPUsecase(transactionObj, accountRepo, totpRepo, recoveryCodeRepo, passwordHasher, "holos")
+			err := uc.Disable(ctx, account.ID, tt.inputPassword)
+			assert.Error(t, err, tt.expectError)
+		})
+	}
+}
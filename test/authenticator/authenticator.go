@@ -0,0 +1,142 @@
+package authenticator
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"slices"
+
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
+	"github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn/cbor"
+)
+
+const (
+	FlagUserPresent  = 0x01
+	FlagUserVerified = 0x04
+
+	flagAttestedCredentialData = 0x40
+)
+
+// NOTE: テスト用にES256の鍵で登録と認証の応答を生成する, ソフトウェア実装の認証器を表す.
+// 各フィールドを書き換えることで不正な応答を生成できる.
+type Authenticator struct {
+	RPID         string
+	Origin       string
+	CredentialID []byte
+	UserHandle   []byte
+	Flags        byte
+	SignCount    uint32
+	Counter      bool
+	key          *ecdsa.PrivateKey
+}
+
+func New(rpID, origin string, userHandle []byte) (*Authenticator, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialID := make([]byte, 16)
+	if _, err := rand.Read(credentialID); err != nil {
+		return nil, err
+	}
+
+	return &Authenticator{
+		RPID:         rpID,
+		Origin:       origin,
+		CredentialID: credentialID,
+		UserHandle:   userHandle,
+		Flags:        FlagUserPresent | FlagUserVerified,
+		Counter:      true,
+		key:          key,
+	}, nil
+}
+
+func (a *Authenticator) PublicKey() []byte {
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	a.key.X.FillBytes(x)
+	a.key.Y.FillBytes(y)
+
+	coseKey, err := cbor.Marshal(map[any]any{
+		1:  2,
+		3:  webauthn.AlgorithmES256,
+		-1: 1,
+		-2: x,
+		-3: y,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return coseKey
+}
+
+func (a *Authenticator) Register(challenge string) (*webauthn.Attestation, error) {
+	credentialIDLength := binary.BigEndian.AppendUint16(nil, uint16(len(a.CredentialID))) // nolint:gosec // 認証情報IDは16バイトで生成する.
+	attestedCredentialData := slices.Concat(make([]byte, 16), credentialIDLength, a.CredentialID, a.PublicKey())
+	authData := slices.Concat(a.authenticatorData(a.Flags|flagAttestedCredentialData), attestedCredentialData)
+
+	attestationObject, err := cbor.Marshal(map[any]any{
+		"fmt":      webauthn.AttestationFormatNone,
+		"attStmt":  map[any]any{},
+		"authData": authData,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &webauthn.Attestation{
+		ClientDataJSON:    a.ClientDataJSON(webauthn.ClientDataTypeCreate, challenge),
+		AttestationObject: attestationObject,
+	}, nil
+}
+
+func (a *Authenticator) Assert(challenge string) (*webauthn.Assertion, error) {
+	if a.Counter {
+		a.SignCount++
+	}
+
+	authData := a.authenticatorData(a.Flags)
+	clientDataJSON := a.ClientDataJSON(webauthn.ClientDataTypeGet, challenge)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(slices.Concat(authData, clientDataHash[:]))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &webauthn.Assertion{
+		CredentialID:      a.CredentialID,
+		ClientDataJSON:    clientDataJSON,
+		AuthenticatorData: authData,
+		Signature:         signature,
+		UserHandle:        a.UserHandle,
+	}, nil
+}
+
+func (a *Authenticator) ClientDataJSON(clientDataType, challenge string) []byte {
+	clientDataJSON, err := json.Marshal(webauthn.ClientData{
+		Type:      clientDataType,
+		Challenge: challenge,
+		Origin:    a.Origin,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return clientDataJSON
+}
+
+func (a *Authenticator) authenticatorData(flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+	return slices.Concat(rpIDHash[:], []byte{flags}, binary.BigEndian.AppendUint32(nil, a.SignCount))
+}
+
+// NOTE: クライアントから送信される値と同様に, バイト列をパディングなしのbase64urlで表す.
+func Encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
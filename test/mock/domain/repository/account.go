@@ -12,8 +12,10 @@ package repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	repository "github.com/atsumarukun/holos-account-api/internal/app/api/domain/repository"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -70,6 +72,51 @@ func (mr *MockAccountRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAccountRepository)(nil).Delete), arg0, arg1)
 }
 
+// FindByDeletedAtBefore mocks base method.
+func (m *MockAccountRepository) FindByDeletedAtBefore(arg0 context.Context, arg1 time.Time) ([]*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByDeletedAtBefore", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByDeletedAtBefore indicates an expected call of FindByDeletedAtBefore.
+func (mr *MockAccountRepositoryMockRecorder) FindByDeletedAtBefore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDeletedAtBefore", reflect.TypeOf((*MockAccountRepository)(nil).FindByDeletedAtBefore), arg0, arg1)
+}
+
+// FindOneByEmail mocks base method.
+func (m *MockAccountRepository) FindOneByEmail(arg0 context.Context, arg1 string) (*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByEmail", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByEmail indicates an expected call of FindOneByEmail.
+func (mr *MockAccountRepositoryMockRecorder) FindOneByEmail(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByEmail", reflect.TypeOf((*MockAccountRepository)(nil).FindOneByEmail), arg0, arg1)
+}
+
+// FindOneByEmailIncludingDeleted mocks base method.
+func (m *MockAccountRepository) FindOneByEmailIncludingDeleted(arg0 context.Context, arg1 string) (*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByEmailIncludingDeleted", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByEmailIncludingDeleted indicates an expected call of FindOneByEmailIncludingDeleted.
+func (mr *MockAccountRepositoryMockRecorder) FindOneByEmailIncludingDeleted(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByEmailIncludingDeleted", reflect.TypeOf((*MockAccountRepository)(nil).FindOneByEmailIncludingDeleted), arg0, arg1)
+}
+
 // FindOneByID mocks base method.
 func (m *MockAccountRepository) FindOneByID(arg0 context.Context, arg1 uuid.UUID) (*entity.Account, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +132,36 @@ func (mr *MockAccountRepositoryMockRecorder) FindOneByID(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByID", reflect.TypeOf((*MockAccountRepository)(nil).FindOneByID), arg0, arg1)
 }
 
+// FindOneByIDAndDeletedAtAfter mocks base method.
+func (m *MockAccountRepository) FindOneByIDAndDeletedAtAfter(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) (*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByIDAndDeletedAtAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByIDAndDeletedAtAfter indicates an expected call of FindOneByIDAndDeletedAtAfter.
+func (mr *MockAccountRepositoryMockRecorder) FindOneByIDAndDeletedAtAfter(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByIDAndDeletedAtAfter", reflect.TypeOf((*MockAccountRepository)(nil).FindOneByIDAndDeletedAtAfter), arg0, arg1, arg2)
+}
+
+// FindOneByIDIncludingDeleted mocks base method.
+func (m *MockAccountRepository) FindOneByIDIncludingDeleted(arg0 context.Context, arg1 uuid.UUID) (*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByIDIncludingDeleted", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByIDIncludingDeleted indicates an expected call of FindOneByIDIncludingDeleted.
+func (mr *MockAccountRepositoryMockRecorder) FindOneByIDIncludingDeleted(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByIDIncludingDeleted", reflect.TypeOf((*MockAccountRepository)(nil).FindOneByIDIncludingDeleted), arg0, arg1)
+}
+
 // FindOneByName mocks base method.
 func (m *MockAccountRepository) FindOneByName(arg0 context.Context, arg1 string) (*entity.Account, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +177,36 @@ func (mr *MockAccountRepositoryMockRecorder) FindOneByName(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByName", reflect.TypeOf((*MockAccountRepository)(nil).FindOneByName), arg0, arg1)
 }
 
+// FindOneByNameAndDeletedAtAfter mocks base method.
+func (m *MockAccountRepository) FindOneByNameAndDeletedAtAfter(arg0 context.Context, arg1 string, arg2 time.Time) (*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByNameAndDeletedAtAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByNameAndDeletedAtAfter indicates an expected call of FindOneByNameAndDeletedAtAfter.
+func (mr *MockAccountRepositoryMockRecorder) FindOneByNameAndDeletedAtAfter(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByNameAndDeletedAtAfter", reflect.TypeOf((*MockAccountRepository)(nil).FindOneByNameAndDeletedAtAfter), arg0, arg1, arg2)
+}
+
+// FindOneByNameExcludingDeletedBefore mocks base method.
+func (m *MockAccountRepository) FindOneByNameExcludingDeletedBefore(arg0 context.Context, arg1 string, arg2 time.Time) (*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByNameExcludingDeletedBefore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByNameExcludingDeletedBefore indicates an expected call of FindOneByNameExcludingDeletedBefore.
+func (mr *MockAccountRepositoryMockRecorder) FindOneByNameExcludingDeletedBefore(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByNameExcludingDeletedBefore", reflect.TypeOf((*MockAccountRepository)(nil).FindOneByNameExcludingDeletedBefore), arg0, arg1, arg2)
+}
+
 // FindOneByNameIncludingDeleted mocks base method.
 func (m *MockAccountRepository) FindOneByNameIncludingDeleted(arg0 context.Context, arg1 string) (*entity.Account, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +222,49 @@ func (mr *MockAccountRepositoryMockRecorder) FindOneByNameIncludingDeleted(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByNameIncludingDeleted", reflect.TypeOf((*MockAccountRepository)(nil).FindOneByNameIncludingDeleted), arg0, arg1)
 }
 
+// Purge mocks base method.
+func (m *MockAccountRepository) Purge(arg0 context.Context, arg1 *entity.Account) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockAccountRepositoryMockRecorder) Purge(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockAccountRepository)(nil).Purge), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *MockAccountRepository) Restore(arg0 context.Context, arg1 *entity.Account) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAccountRepositoryMockRecorder) Restore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAccountRepository)(nil).Restore), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockAccountRepository) Search(arg0 context.Context, arg1 repository.AccountSearchCondition) ([]*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockAccountRepositoryMockRecorder) Search(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockAccountRepository)(nil).Search), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockAccountRepository) Update(arg0 context.Context, arg1 *entity.Account) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: account_name_tombstone.go
+//
+// Generated by this command:
+//
+//	mockgen -source=account_name_tombstone.go -package=repository -destination=../../../../../test/mock/domain/repository/account_name_tombstone.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccountNameTombstoneRepository is a mock of AccountNameTombstoneRepository interface.
+type MockAccountNameTombstoneRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountNameTombstoneRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountNameTombstoneRepositoryMockRecorder is the mock recorder for MockAccountNameTombstoneRepository.
+type MockAccountNameTombstoneRepositoryMockRecorder struct {
+	mock *MockAccountNameTombstoneRepository
+}
+
+// NewMockAccountNameTombstoneRepository creates a new mock instance.
+func NewMockAccountNameTombstoneRepository(ctrl *gomock.Controller) *MockAccountNameTombstoneRepository {
+	mock := &MockAccountNameTombstoneRepository{ctrl: ctrl}
+	mock.recorder = &MockAccountNameTombstoneRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountNameTombstoneRepository) EXPECT() *MockAccountNameTombstoneRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByReleasedAtBefore mocks base method.
+func (m *MockAccountNameTombstoneRepository) DeleteByReleasedAtBefore(arg0 context.Context, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByReleasedAtBefore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByReleasedAtBefore indicates an expected call of DeleteByReleasedAtBefore.
+func (mr *MockAccountNameTombstoneRepositoryMockRecorder) DeleteByReleasedAtBefore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByReleasedAtBefore", reflect.TypeOf((*MockAccountNameTombstoneRepository)(nil).DeleteByReleasedAtBefore), arg0, arg1)
+}
+
+// FindOneByNameAndReleasedAtAfter mocks base method.
+func (m *MockAccountNameTombstoneRepository) FindOneByNameAndReleasedAtAfter(arg0 context.Context, arg1 string, arg2 time.Time) (*entity.AccountNameTombstone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByNameAndReleasedAtAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.AccountNameTombstone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByNameAndReleasedAtAfter indicates an expected call of FindOneByNameAndReleasedAtAfter.
+func (mr *MockAccountNameTombstoneRepositoryMockRecorder) FindOneByNameAndReleasedAtAfter(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByNameAndReleasedAtAfter", reflect.TypeOf((*MockAccountNameTombstoneRepository)(nil).FindOneByNameAndReleasedAtAfter), arg0, arg1, arg2)
+}
+
+// Save mocks base method.
+func (m *MockAccountNameTombstoneRepository) Save(arg0 context.Context, arg1 *entity.AccountNameTombstone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAccountNameTombstoneRepositoryMockRecorder) Save(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAccountNameTombstoneRepository)(nil).Save), arg0, arg1)
+}
@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: login_failure.go
+//
+// Generated by this command:
+//
+//	mockgen -source=login_failure.go -package=repository -destination=../../../../../test/mock/domain/repository/login_failure.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginFailureRepository is a mock of LoginFailureRepository interface.
+type MockLoginFailureRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginFailureRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginFailureRepositoryMockRecorder is the mock recorder for MockLoginFailureRepository.
+type MockLoginFailureRepositoryMockRecorder struct {
+	mock *MockLoginFailureRepository
+}
+
+// NewMockLoginFailureRepository creates a new mock instance.
+func NewMockLoginFailureRepository(ctrl *gomock.Controller) *MockLoginFailureRepository {
+	mock := &MockLoginFailureRepository{ctrl: ctrl}
+	mock.recorder = &MockLoginFailureRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginFailureRepository) EXPECT() *MockLoginFailureRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockLoginFailureRepository) Delete(arg0 context.Context, arg1 *entity.LoginFailure) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLoginFailureRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLoginFailureRepository)(nil).Delete), arg0, arg1)
+}
+
+// DeleteByLastFailedAtBefore mocks base method.
+func (m *MockLoginFailureRepository) DeleteByLastFailedAtBefore(arg0 context.Context, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByLastFailedAtBefore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByLastFailedAtBefore indicates an expected call of DeleteByLastFailedAtBefore.
+func (mr *MockLoginFailureRepositoryMockRecorder) DeleteByLastFailedAtBefore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByLastFailedAtBefore", reflect.TypeOf((*MockLoginFailureRepository)(nil).DeleteByLastFailedAtBefore), arg0, arg1)
+}
+
+// Ensure mocks base method.
+func (m *MockLoginFailureRepository) Ensure(arg0 context.Context, arg1 *entity.LoginFailure) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ensure", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ensure indicates an expected call of Ensure.
+func (mr *MockLoginFailureRepositoryMockRecorder) Ensure(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ensure", reflect.TypeOf((*MockLoginFailureRepository)(nil).Ensure), arg0, arg1)
+}
+
+// FindOneByTarget mocks base method.
+func (m *MockLoginFailureRepository) FindOneByTarget(arg0 context.Context, arg1, arg2 string) (*entity.LoginFailure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByTarget", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.LoginFailure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByTarget indicates an expected call of FindOneByTarget.
+func (mr *MockLoginFailureRepositoryMockRecorder) FindOneByTarget(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTarget", reflect.TypeOf((*MockLoginFailureRepository)(nil).FindOneByTarget), arg0, arg1, arg2)
+}
+
+// FindOneByTargetForUpdate mocks base method.
+func (m *MockLoginFailureRepository) FindOneByTargetForUpdate(arg0 context.Context, arg1, arg2 string) (*entity.LoginFailure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByTargetForUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.LoginFailure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByTargetForUpdate indicates an expected call of FindOneByTargetForUpdate.
+func (mr *MockLoginFailureRepositoryMockRecorder) FindOneByTargetForUpdate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTargetForUpdate", reflect.TypeOf((*MockLoginFailureRepository)(nil).FindOneByTargetForUpdate), arg0, arg1, arg2)
+}
+
+// Save mocks base method.
+func (m *MockLoginFailureRepository) Save(arg0 context.Context, arg1 *entity.LoginFailure) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockLoginFailureRepositoryMockRecorder) Save(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockLoginFailureRepository)(nil).Save), arg0, arg1)
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: passkey_challenge.go
+//
+// Generated by this command:
+//
+//	mockgen -source=passkey_challenge.go -package=repository -destination=../../../../../test/mock/domain/repository/passkey_challenge.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPasskeyChallengeRepository is a mock of PasskeyChallengeRepository interface.
+type MockPasskeyChallengeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasskeyChallengeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPasskeyChallengeRepositoryMockRecorder is the mock recorder for MockPasskeyChallengeRepository.
+type MockPasskeyChallengeRepositoryMockRecorder struct {
+	mock *MockPasskeyChallengeRepository
+}
+
+// NewMockPasskeyChallengeRepository creates a new mock instance.
+func NewMockPasskeyChallengeRepository(ctrl *gomock.Controller) *MockPasskeyChallengeRepository {
+	mock := &MockPasskeyChallengeRepository{ctrl: ctrl}
+	mock.recorder = &MockPasskeyChallengeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasskeyChallengeRepository) EXPECT() *MockPasskeyChallengeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPasskeyChallengeRepository) Create(arg0 context.Context, arg1 *entity.PasskeyChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPasskeyChallengeRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPasskeyChallengeRepository)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockPasskeyChallengeRepository) Delete(arg0 context.Context, arg1 *entity.PasskeyChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPasskeyChallengeRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPasskeyChallengeRepository)(nil).Delete), arg0, arg1)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockPasskeyChallengeRepository) DeleteExpired(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockPasskeyChallengeRepositoryMockRecorder) DeleteExpired(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockPasskeyChallengeRepository)(nil).DeleteExpired), arg0)
+}
+
+// FindOneByPurposeAndChallengeAndNotExpiredForUpdate mocks base method.
+func (m *MockPasskeyChallengeRepository) FindOneByPurposeAndChallengeAndNotExpiredForUpdate(arg0 context.Context, arg1, arg2 string) (*entity.PasskeyChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByPurposeAndChallengeAndNotExpiredForUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.PasskeyChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByPurposeAndChallengeAndNotExpiredForUpdate indicates an expected call of FindOneByPurposeAndChallengeAndNotExpiredForUpdate.
+func (mr *MockPasskeyChallengeRepositoryMockRecorder) FindOneByPurposeAndChallengeAndNotExpiredForUpdate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByPurposeAndChallengeAndNotExpiredForUpdate", reflect.TypeOf((*MockPasskeyChallengeRepository)(nil).FindOneByPurposeAndChallengeAndNotExpiredForUpdate), arg0, arg1, arg2)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lock.go
+//
+// Generated by this command:
+//
+//	mockgen -source=lock.go -package=lock -destination=../../../../../../../test/mock/domain/repository/pkg/lock/lock.go
+//
+
+// Package lock is a generated GoMock package.
+package lock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLockObject is a mock of LockObject interface.
+type MockLockObject struct {
+	ctrl     *gomock.Controller
+	recorder *MockLockObjectMockRecorder
+	isgomock struct{}
+}
+
+// MockLockObjectMockRecorder is the mock recorder for MockLockObject.
+type MockLockObjectMockRecorder struct {
+	mock *MockLockObject
+}
+
+// NewMockLockObject creates a new mock instance.
+func NewMockLockObject(ctrl *gomock.Controller) *MockLockObject {
+	mock := &MockLockObject{ctrl: ctrl}
+	mock.recorder = &MockLockObjectMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLockObject) EXPECT() *MockLockObjectMockRecorder {
+	return m.recorder
+}
+
+// Lock mocks base method.
+func (m *MockLockObject) Lock(arg0 context.Context, arg1 string, arg2 func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockLockObjectMockRecorder) Lock(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockLockObject)(nil).Lock), arg0, arg1, arg2)
+}
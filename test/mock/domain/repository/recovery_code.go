@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: recovery_code.go
+//
+// Generated by this command:
+//
+//	mockgen -source=recovery_code.go -package=repository -destination=../../../../../test/mock/domain/repository/recovery_code.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/atsumarukun/holos-account-api/internal/app/api/domain/entity"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecoveryCodeRepository is a mock of RecoveryCodeRepository interface.
+type MockRecoveryCodeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecoveryCodeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRecoveryCodeRepositoryMockRecorder is the mock recorder for MockRecoveryCodeRepository.
+type MockRecoveryCodeRepositoryMockRecorder struct {
+	mock *MockRecoveryCodeRepository
+}
+
+// NewMockRecoveryCodeRepository creates a new mock instance.
+func NewMockRecoveryCodeRepository(ctrl *gomock.Controller) *MockRecoveryCodeRepository {
+	mock := &MockRecoveryCodeRepository{ctrl: ctrl}
+	mock.recorder = &MockRecoveryCodeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecoveryCodeRepository) EXPECT() *MockRecoveryCodeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByAccountID mocks base method.
+func (m *MockRecoveryCodeRepository) CountByAccountID(arg0 context.Context, arg1 uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByAccountID", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByAccountID indicates an expected call of CountByAccountID.
+func (mr *MockRecoveryCodeRepositoryMockRecorder) CountByAccountID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByAccountID", reflect.TypeOf((*MockRecoveryCodeRepository)(nil).CountByAccountID), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockRecoveryCodeRepository) Create(arg0 context.Context, arg1 *entity.RecoveryCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRecoveryCodeRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecoveryCodeRepository)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockRecoveryCodeRepository) Delete(arg0 context.Context, arg1 *entity.RecoveryCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRecoveryCodeRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecoveryCodeRepository)(nil).Delete), arg0, arg1)
+}
+
+// DeleteByAccountID mocks base method.
+func (m *MockRecoveryCodeRepository) DeleteByAccountID(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByAccountID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByAccountID indicates an expected call of DeleteByAccountID.
+func (mr *MockRecoveryCodeRepositoryMockRecorder) DeleteByAccountID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByAccountID", reflect.TypeOf((*MockRecoveryCodeRepository)(nil).DeleteByAccountID), arg0, arg1)
+}
+
+// FindOneByAccountIDAndCodeForUpdate mocks base method.
+func (m *MockRecoveryCodeRepository) FindOneByAccountIDAndCodeForUpdate(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*entity.RecoveryCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByAccountIDAndCodeForUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.RecoveryCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByAccountIDAndCodeForUpdate indicates an expected call of FindOneByAccountIDAndCodeForUpdate.
+func (mr *MockRecoveryCodeRepositoryMockRecorder) FindOneByAccountIDAndCodeForUpdate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByAccountIDAndCodeForUpdate", reflect.TypeOf((*MockRecoveryCodeRepository)(nil).FindOneByAccountIDAndCodeForUpdate), arg0, arg1, arg2)
+}
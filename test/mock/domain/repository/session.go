@@ -42,6 +42,20 @@ func (m *MockSessionRepository) EXPECT() *MockSessionRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Create mocks base method.
+func (m *MockSessionRepository) Create(arg0 context.Context, arg1 *entity.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSessionRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionRepository)(nil).Create), arg0, arg1)
+}
+
 // Delete mocks base method.
 func (m *MockSessionRepository) Delete(arg0 context.Context, arg1 *entity.Session) error {
 	m.ctrl.T.Helper()
@@ -56,19 +70,91 @@ func (mr *MockSessionRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSessionRepository)(nil).Delete), arg0, arg1)
 }
 
-// FindOneByAccountID mocks base method.
-func (m *MockSessionRepository) FindOneByAccountID(arg0 context.Context, arg1 uuid.UUID) (*entity.Session, error) {
+// DeleteByAccountID mocks base method.
+func (m *MockSessionRepository) DeleteByAccountID(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByAccountID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByAccountID indicates an expected call of DeleteByAccountID.
+func (mr *MockSessionRepositoryMockRecorder) DeleteByAccountID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByAccountID", reflect.TypeOf((*MockSessionRepository)(nil).DeleteByAccountID), arg0, arg1)
+}
+
+// DeleteByAccountIDExcludingID mocks base method.
+func (m *MockSessionRepository) DeleteByAccountIDExcludingID(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByAccountIDExcludingID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByAccountIDExcludingID indicates an expected call of DeleteByAccountIDExcludingID.
+func (mr *MockSessionRepositoryMockRecorder) DeleteByAccountIDExcludingID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByAccountIDExcludingID", reflect.TypeOf((*MockSessionRepository)(nil).DeleteByAccountIDExcludingID), arg0, arg1, arg2)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockSessionRepository) DeleteExpired(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockSessionRepositoryMockRecorder) DeleteExpired(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockSessionRepository)(nil).DeleteExpired), arg0)
+}
+
+// FindByAccountIDAndNotExpired mocks base method.
+func (m *MockSessionRepository) FindByAccountIDAndNotExpired(arg0 context.Context, arg1 uuid.UUID) ([]*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAccountIDAndNotExpired", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAccountIDAndNotExpired indicates an expected call of FindByAccountIDAndNotExpired.
+func (mr *MockSessionRepositoryMockRecorder) FindByAccountIDAndNotExpired(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAccountIDAndNotExpired", reflect.TypeOf((*MockSessionRepository)(nil).FindByAccountIDAndNotExpired), arg0, arg1)
+}
+
+// FindOneByIDAndAccountID mocks base method.
+func (m *MockSessionRepository) FindOneByIDAndAccountID(arg0 context.Context, arg1, arg2 uuid.UUID) (*entity.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneByAccountID", arg0, arg1)
+	ret := m.ctrl.Call(m, "FindOneByIDAndAccountID", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*entity.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindOneByAccountID indicates an expected call of FindOneByAccountID.
-func (mr *MockSessionRepositoryMockRecorder) FindOneByAccountID(arg0, arg1 any) *gomock.Call {
+// FindOneByIDAndAccountID indicates an expected call of FindOneByIDAndAccountID.
+func (mr *MockSessionRepositoryMockRecorder) FindOneByIDAndAccountID(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByAccountID", reflect.TypeOf((*MockSessionRepository)(nil).FindOneByAccountID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByIDAndAccountID", reflect.TypeOf((*MockSessionRepository)(nil).FindOneByIDAndAccountID), arg0, arg1, arg2)
+}
+
+// FindOneByRefreshTokenAndNotExpiredForUpdate mocks base method.
+func (m *MockSessionRepository) FindOneByRefreshTokenAndNotExpiredForUpdate(arg0 context.Context, arg1 string) (*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByRefreshTokenAndNotExpiredForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByRefreshTokenAndNotExpiredForUpdate indicates an expected call of FindOneByRefreshTokenAndNotExpiredForUpdate.
+func (mr *MockSessionRepositoryMockRecorder) FindOneByRefreshTokenAndNotExpiredForUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByRefreshTokenAndNotExpiredForUpdate", reflect.TypeOf((*MockSessionRepository)(nil).FindOneByRefreshTokenAndNotExpiredForUpdate), arg0, arg1)
 }
 
 // FindOneByTokenAndNotExpired mocks base method.
@@ -86,16 +172,45 @@ func (mr *MockSessionRepositoryMockRecorder) FindOneByTokenAndNotExpired(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTokenAndNotExpired", reflect.TypeOf((*MockSessionRepository)(nil).FindOneByTokenAndNotExpired), arg0, arg1)
 }
 
-// Save mocks base method.
-func (m *MockSessionRepository) Save(arg0 context.Context, arg1 *entity.Session) error {
+// FindOneByUsedRefreshToken mocks base method.
+func (m *MockSessionRepository) FindOneByUsedRefreshToken(arg0 context.Context, arg1 string) (*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByUsedRefreshToken", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByUsedRefreshToken indicates an expected call of FindOneByUsedRefreshToken.
+func (mr *MockSessionRepositoryMockRecorder) FindOneByUsedRefreshToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByUsedRefreshToken", reflect.TypeOf((*MockSessionRepository)(nil).FindOneByUsedRefreshToken), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockSessionRepository) Update(arg0 context.Context, arg1 *entity.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSessionRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSessionRepository)(nil).Update), arg0, arg1)
+}
+
+// UpdateLastUsedAt mocks base method.
+func (m *MockSessionRepository) UpdateLastUsedAt(arg0 context.Context, arg1 *entity.Session) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdateLastUsedAt", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Save indicates an expected call of Save.
-func (mr *MockSessionRepositoryMockRecorder) Save(arg0, arg1 any) *gomock.Call {
+// UpdateLastUsedAt indicates an expected call of UpdateLastUsedAt.
+func (mr *MockSessionRepositoryMockRecorder) UpdateLastUsedAt(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSessionRepository)(nil).Save), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastUsedAt", reflect.TypeOf((*MockSessionRepository)(nil).UpdateLastUsedAt), arg0, arg1)
 }
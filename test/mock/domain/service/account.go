@@ -41,6 +41,20 @@ func (m *MockAccountService) EXPECT() *MockAccountServiceMockRecorder {
 	return m.recorder
 }
 
+// EmailExists mocks base method.
+func (m *MockAccountService) EmailExists(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmailExists", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EmailExists indicates an expected call of EmailExists.
+func (mr *MockAccountServiceMockRecorder) EmailExists(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmailExists", reflect.TypeOf((*MockAccountService)(nil).EmailExists), arg0, arg1)
+}
+
 // Exists mocks base method.
 func (m *MockAccountService) Exists(arg0 context.Context, arg1 *entity.Account) error {
 	m.ctrl.T.Helper()
@@ -54,3 +68,17 @@ func (mr *MockAccountServiceMockRecorder) Exists(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockAccountService)(nil).Exists), arg0, arg1)
 }
+
+// Release mocks base method.
+func (m *MockAccountService) Release(arg0 context.Context, arg1 *entity.Account) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockAccountServiceMockRecorder) Release(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockAccountService)(nil).Release), arg0, arg1)
+}
@@ -58,11 +58,12 @@ func (mr *MockAccountUsecaseMockRecorder) Create(arg0, arg1, arg2, arg3 any) *go
 }
 
 // Delete mocks base method.
-func (m *MockAccountUsecase) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+func (m *MockAccountUsecase) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*dto.DeletedAccountDTO, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*dto.DeletedAccountDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
@@ -71,6 +72,37 @@ func (mr *MockAccountUsecaseMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAccountUsecase)(nil).Delete), arg0, arg1, arg2)
 }
 
+// Get mocks base method.
+func (m *MockAccountUsecase) Get(arg0 context.Context, arg1 uuid.UUID) (*dto.AccountDTO, *dto.AccountSecurityDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*dto.AccountDTO)
+	ret1, _ := ret[1].(*dto.AccountSecurityDTO)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAccountUsecaseMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAccountUsecase)(nil).Get), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *MockAccountUsecase) Restore(arg0 context.Context, arg1, arg2, arg3 string) (*dto.AccountDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*dto.AccountDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAccountUsecaseMockRecorder) Restore(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAccountUsecase)(nil).Restore), arg0, arg1, arg2, arg3)
+}
+
 // UpdateName mocks base method.
 func (m *MockAccountUsecase) UpdateName(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) (*dto.AccountDTO, error) {
 	m.ctrl.T.Helper()
@@ -87,16 +119,17 @@ func (mr *MockAccountUsecaseMockRecorder) UpdateName(arg0, arg1, arg2, arg3 any)
 }
 
 // UpdatePassword mocks base method.
-func (m *MockAccountUsecase) UpdatePassword(arg0 context.Context, arg1 uuid.UUID, arg2, arg3, arg4 string) (*dto.AccountDTO, error) {
+func (m *MockAccountUsecase) UpdatePassword(arg0 context.Context, arg1, arg2 uuid.UUID, arg3, arg4, arg5 string) (*dto.AccountDTO, *dto.SessionDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePassword", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "UpdatePassword", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(*dto.AccountDTO)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*dto.SessionDTO)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // UpdatePassword indicates an expected call of UpdatePassword.
-func (mr *MockAccountUsecaseMockRecorder) UpdatePassword(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+func (mr *MockAccountUsecaseMockRecorder) UpdatePassword(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockAccountUsecase)(nil).UpdatePassword), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockAccountUsecase)(nil).UpdatePassword), arg0, arg1, arg2, arg3, arg4, arg5)
 }
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: admin_account.go
+//
+// Generated by this command:
+//
+//	mockgen -source=admin_account.go -package=usecase -destination=../../../../test/mock/usecase/admin_account.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminAccountUsecase is a mock of AdminAccountUsecase interface.
+type MockAdminAccountUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminAccountUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminAccountUsecaseMockRecorder is the mock recorder for MockAdminAccountUsecase.
+type MockAdminAccountUsecaseMockRecorder struct {
+	mock *MockAdminAccountUsecase
+}
+
+// NewMockAdminAccountUsecase creates a new mock instance.
+func NewMockAdminAccountUsecase(ctrl *gomock.Controller) *MockAdminAccountUsecase {
+	mock := &MockAdminAccountUsecase{ctrl: ctrl}
+	mock.recorder = &MockAdminAccountUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminAccountUsecase) EXPECT() *MockAdminAccountUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAdminAccountUsecase) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAdminAccountUsecaseMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAdminAccountUsecase)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockAdminAccountUsecase) Get(arg0 context.Context, arg1 uuid.UUID) (*dto.AccountDTO, *dto.AccountSecurityDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*dto.AccountDTO)
+	ret1, _ := ret[1].(*dto.AccountSecurityDTO)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAdminAccountUsecaseMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAdminAccountUsecase)(nil).Get), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockAdminAccountUsecase) GetAll(arg0 context.Context, arg1 *dto.AccountSearchDTO) (*dto.AccountPageDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].(*dto.AccountPageDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockAdminAccountUsecaseMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAdminAccountUsecase)(nil).GetAll), arg0, arg1)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAdminAccountUsecase) ResetPassword(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAdminAccountUsecaseMockRecorder) ResetPassword(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAdminAccountUsecase)(nil).ResetPassword), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *MockAdminAccountUsecase) Restore(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAdminAccountUsecaseMockRecorder) Restore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAdminAccountUsecase)(nil).Restore), arg0, arg1)
+}
+
+// RevokeSessions mocks base method.
+func (m *MockAdminAccountUsecase) RevokeSessions(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSessions indicates an expected call of RevokeSessions.
+func (mr *MockAdminAccountUsecaseMockRecorder) RevokeSessions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessions", reflect.TypeOf((*MockAdminAccountUsecase)(nil).RevokeSessions), arg0, arg1)
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cleanup.go
+//
+// Generated by this command:
+//
+//	mockgen -source=cleanup.go -package=usecase -destination=../../../../test/mock/usecase/cleanup.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCleanupUsecase is a mock of CleanupUsecase interface.
+type MockCleanupUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCleanupUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCleanupUsecaseMockRecorder is the mock recorder for MockCleanupUsecase.
+type MockCleanupUsecaseMockRecorder struct {
+	mock *MockCleanupUsecase
+}
+
+// NewMockCleanupUsecase creates a new mock instance.
+func NewMockCleanupUsecase(ctrl *gomock.Controller) *MockCleanupUsecase {
+	mock := &MockCleanupUsecase{ctrl: ctrl}
+	mock.recorder = &MockCleanupUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCleanupUsecase) EXPECT() *MockCleanupUsecaseMockRecorder {
+	return m.recorder
+}
+
+// PurgeDeletedAccounts mocks base method.
+func (m *MockCleanupUsecase) PurgeDeletedAccounts(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedAccounts", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeletedAccounts indicates an expected call of PurgeDeletedAccounts.
+func (mr *MockCleanupUsecaseMockRecorder) PurgeDeletedAccounts(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedAccounts", reflect.TypeOf((*MockCleanupUsecase)(nil).PurgeDeletedAccounts), arg0)
+}
+
+// PurgeExpiredSessions mocks base method.
+func (m *MockCleanupUsecase) PurgeExpiredSessions(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpiredSessions", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeExpiredSessions indicates an expected call of PurgeExpiredSessions.
+func (mr *MockCleanupUsecaseMockRecorder) PurgeExpiredSessions(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpiredSessions", reflect.TypeOf((*MockCleanupUsecase)(nil).PurgeExpiredSessions), arg0)
+}
+
+// PurgeLoginFailures mocks base method.
+func (m *MockCleanupUsecase) PurgeLoginFailures(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeLoginFailures", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeLoginFailures indicates an expected call of PurgeLoginFailures.
+func (mr *MockCleanupUsecaseMockRecorder) PurgeLoginFailures(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeLoginFailures", reflect.TypeOf((*MockCleanupUsecase)(nil).PurgeLoginFailures), arg0)
+}
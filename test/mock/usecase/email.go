@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: email.go
+//
+// Generated by this command:
+//
+//	mockgen -source=email.go -package=usecase -destination=../../../../test/mock/usecase/email.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEmailUsecase is a mock of EmailUsecase interface.
+type MockEmailUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailUsecaseMockRecorder is the mock recorder for MockEmailUsecase.
+type MockEmailUsecaseMockRecorder struct {
+	mock *MockEmailUsecase
+}
+
+// NewMockEmailUsecase creates a new mock instance.
+func NewMockEmailUsecase(ctrl *gomock.Controller) *MockEmailUsecase {
+	mock := &MockEmailUsecase{ctrl: ctrl}
+	mock.recorder = &MockEmailUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailUsecase) EXPECT() *MockEmailUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Change mocks base method.
+func (m *MockEmailUsecase) Change(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Change", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Change indicates an expected call of Change.
+func (mr *MockEmailUsecaseMockRecorder) Change(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Change", reflect.TypeOf((*MockEmailUsecase)(nil).Change), arg0, arg1, arg2, arg3)
+}
+
+// Verify mocks base method.
+func (m *MockEmailUsecase) Verify(arg0 context.Context, arg1 string) (*dto.AccountDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", arg0, arg1)
+	ret0, _ := ret[0].(*dto.AccountDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockEmailUsecaseMockRecorder) Verify(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockEmailUsecase)(nil).Verify), arg0, arg1)
+}
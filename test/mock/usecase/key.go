@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: key.go
+//
+// Generated by this command:
+//
+//	mockgen -source=key.go -package=usecase -destination=../../../../test/mock/usecase/key.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	reflect "reflect"
+
+	dto "github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockKeyUsecase is a mock of KeyUsecase interface.
+type MockKeyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockKeyUsecaseMockRecorder is the mock recorder for MockKeyUsecase.
+type MockKeyUsecaseMockRecorder struct {
+	mock *MockKeyUsecase
+}
+
+// NewMockKeyUsecase creates a new mock instance.
+func NewMockKeyUsecase(ctrl *gomock.Controller) *MockKeyUsecase {
+	mock := &MockKeyUsecase{ctrl: ctrl}
+	mock.recorder = &MockKeyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyUsecase) EXPECT() *MockKeyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetPublicKeys mocks base method.
+func (m *MockKeyUsecase) GetPublicKeys() []*dto.PublicKeyDTO {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicKeys")
+	ret0, _ := ret[0].([]*dto.PublicKeyDTO)
+	return ret0
+}
+
+// GetPublicKeys indicates an expected call of GetPublicKeys.
+func (mr *MockKeyUsecaseMockRecorder) GetPublicKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicKeys", reflect.TypeOf((*MockKeyUsecase)(nil).GetPublicKeys))
+}
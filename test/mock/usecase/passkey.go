@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: passkey.go
+//
+// Generated by this command:
+//
+//	mockgen -source=passkey.go -package=usecase -destination=../../../../test/mock/usecase/passkey.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	webauthn "github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
+	dto "github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPasskeyUsecase is a mock of PasskeyUsecase interface.
+type MockPasskeyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasskeyUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPasskeyUsecaseMockRecorder is the mock recorder for MockPasskeyUsecase.
+type MockPasskeyUsecaseMockRecorder struct {
+	mock *MockPasskeyUsecase
+}
+
+// NewMockPasskeyUsecase creates a new mock instance.
+func NewMockPasskeyUsecase(ctrl *gomock.Controller) *MockPasskeyUsecase {
+	mock := &MockPasskeyUsecase{ctrl: ctrl}
+	mock.recorder = &MockPasskeyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasskeyUsecase) EXPECT() *MockPasskeyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateRegistrationOptions mocks base method.
+func (m *MockPasskeyUsecase) CreateRegistrationOptions(arg0 context.Context, arg1 uuid.UUID) (*dto.PasskeyRegistrationOptionsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRegistrationOptions", arg0, arg1)
+	ret0, _ := ret[0].(*dto.PasskeyRegistrationOptionsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRegistrationOptions indicates an expected call of CreateRegistrationOptions.
+func (mr *MockPasskeyUsecaseMockRecorder) CreateRegistrationOptions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRegistrationOptions", reflect.TypeOf((*MockPasskeyUsecase)(nil).CreateRegistrationOptions), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockPasskeyUsecase) Delete(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPasskeyUsecaseMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPasskeyUsecase)(nil).Delete), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockPasskeyUsecase) GetAll(arg0 context.Context, arg1 uuid.UUID) ([]*dto.PasskeyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*dto.PasskeyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockPasskeyUsecaseMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPasskeyUsecase)(nil).GetAll), arg0, arg1)
+}
+
+// Register mocks base method.
+func (m *MockPasskeyUsecase) Register(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 *webauthn.Attestation) (*dto.PasskeyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*dto.PasskeyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockPasskeyUsecaseMockRecorder) Register(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockPasskeyUsecase)(nil).Register), arg0, arg1, arg2, arg3)
+}
+
+// Rename mocks base method.
+func (m *MockPasskeyUsecase) Rename(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 string) (*dto.PasskeyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*dto.PasskeyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockPasskeyUsecaseMockRecorder) Rename(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockPasskeyUsecase)(nil).Rename), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: password_reset.go
+//
+// Generated by this command:
+//
+//	mockgen -source=password_reset.go -package=usecase -destination=../../../../test/mock/usecase/password_reset.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPasswordResetUsecase is a mock of PasswordResetUsecase interface.
+type MockPasswordResetUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPasswordResetUsecaseMockRecorder is the mock recorder for MockPasswordResetUsecase.
+type MockPasswordResetUsecaseMockRecorder struct {
+	mock *MockPasswordResetUsecase
+}
+
+// NewMockPasswordResetUsecase creates a new mock instance.
+func NewMockPasswordResetUsecase(ctrl *gomock.Controller) *MockPasswordResetUsecase {
+	mock := &MockPasswordResetUsecase{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetUsecase) EXPECT() *MockPasswordResetUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockPasswordResetUsecase) Confirm(arg0 context.Context, arg1, arg2, arg3 string) (*dto.AccountDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*dto.AccountDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockPasswordResetUsecaseMockRecorder) Confirm(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockPasswordResetUsecase)(nil).Confirm), arg0, arg1, arg2, arg3)
+}
+
+// Request mocks base method.
+func (m *MockPasswordResetUsecase) Request(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Request", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockPasswordResetUsecaseMockRecorder) Request(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockPasswordResetUsecase)(nil).Request), arg0, arg1)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: recovery_code.go
+//
+// Generated by this command:
+//
+//	mockgen -source=recovery_code.go -package=usecase -destination=../../../../test/mock/usecase/recovery_code.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecoveryCodeUsecase is a mock of RecoveryCodeUsecase interface.
+type MockRecoveryCodeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecoveryCodeUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRecoveryCodeUsecaseMockRecorder is the mock recorder for MockRecoveryCodeUsecase.
+type MockRecoveryCodeUsecaseMockRecorder struct {
+	mock *MockRecoveryCodeUsecase
+}
+
+// NewMockRecoveryCodeUsecase creates a new mock instance.
+func NewMockRecoveryCodeUsecase(ctrl *gomock.Controller) *MockRecoveryCodeUsecase {
+	mock := &MockRecoveryCodeUsecase{ctrl: ctrl}
+	mock.recorder = &MockRecoveryCodeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecoveryCodeUsecase) EXPECT() *MockRecoveryCodeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockRecoveryCodeUsecase) Count(arg0 context.Context, arg1 uuid.UUID) (*dto.RecoveryCodeCountDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0, arg1)
+	ret0, _ := ret[0].(*dto.RecoveryCodeCountDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockRecoveryCodeUsecaseMockRecorder) Count(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRecoveryCodeUsecase)(nil).Count), arg0, arg1)
+}
+
+// Regenerate mocks base method.
+func (m *MockRecoveryCodeUsecase) Regenerate(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*dto.RecoveryCodesDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Regenerate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.RecoveryCodesDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Regenerate indicates an expected call of Regenerate.
+func (mr *MockRecoveryCodeUsecaseMockRecorder) Regenerate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Regenerate", reflect.TypeOf((*MockRecoveryCodeUsecase)(nil).Regenerate), arg0, arg1, arg2)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: role.go
+//
+// Generated by this command:
+//
+//	mockgen -source=role.go -package=usecase -destination=../../../../test/mock/usecase/role.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoleUsecase is a mock of RoleUsecase interface.
+type MockRoleUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleUsecaseMockRecorder is the mock recorder for MockRoleUsecase.
+type MockRoleUsecaseMockRecorder struct {
+	mock *MockRoleUsecase
+}
+
+// NewMockRoleUsecase creates a new mock instance.
+func NewMockRoleUsecase(ctrl *gomock.Controller) *MockRoleUsecase {
+	mock := &MockRoleUsecase{ctrl: ctrl}
+	mock.recorder = &MockRoleUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleUsecase) EXPECT() *MockRoleUsecaseMockRecorder {
+	return m.recorder
+}
+
+// BootstrapAdmin mocks base method.
+func (m *MockRoleUsecase) BootstrapAdmin(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BootstrapAdmin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BootstrapAdmin indicates an expected call of BootstrapAdmin.
+func (mr *MockRoleUsecaseMockRecorder) BootstrapAdmin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrapAdmin", reflect.TypeOf((*MockRoleUsecase)(nil).BootstrapAdmin), arg0, arg1)
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	webauthn "github.com/atsumarukun/holos-account-api/internal/app/api/domain/pkg/webauthn"
 	dto "github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -42,40 +43,116 @@ func (m *MockSessionUsecase) EXPECT() *MockSessionUsecaseMockRecorder {
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *MockSessionUsecase) Create(arg0 context.Context, arg1, arg2 string) (*dto.SessionDTO, error) {
+// Challenge mocks base method.
+func (m *MockSessionUsecase) Challenge(arg0 context.Context, arg1, arg2, arg3, arg4, arg5 string) (*dto.SessionDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Challenge", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(*dto.SessionDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// Challenge indicates an expected call of Challenge.
+func (mr *MockSessionUsecaseMockRecorder) Challenge(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Challenge", reflect.TypeOf((*MockSessionUsecase)(nil).Challenge), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Create mocks base method.
+func (m *MockSessionUsecase) Create(arg0 context.Context, arg1, arg2 string, arg3 bool, arg4, arg5 string) (*dto.SessionDTO, *dto.LoginChallengeDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*dto.SessionDTO)
+	ret1, _ := ret[1].(*dto.LoginChallengeDTO)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // Create indicates an expected call of Create.
-func (mr *MockSessionUsecaseMockRecorder) Create(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockSessionUsecaseMockRecorder) Create(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionUsecase)(nil).Create), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// CreatePasskeyOptions mocks base method.
+func (m *MockSessionUsecase) CreatePasskeyOptions(arg0 context.Context) (*dto.PasskeyAuthenticationOptionsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasskeyOptions", arg0)
+	ret0, _ := ret[0].(*dto.PasskeyAuthenticationOptionsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasskeyOptions indicates an expected call of CreatePasskeyOptions.
+func (mr *MockSessionUsecaseMockRecorder) CreatePasskeyOptions(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionUsecase)(nil).Create), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasskeyOptions", reflect.TypeOf((*MockSessionUsecase)(nil).CreatePasskeyOptions), arg0)
+}
+
+// CreateWithPasskey mocks base method.
+func (m *MockSessionUsecase) CreateWithPasskey(arg0 context.Context, arg1 *webauthn.Assertion, arg2 bool, arg3, arg4 string) (*dto.SessionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithPasskey", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*dto.SessionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithPasskey indicates an expected call of CreateWithPasskey.
+func (mr *MockSessionUsecaseMockRecorder) CreateWithPasskey(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithPasskey", reflect.TypeOf((*MockSessionUsecase)(nil).CreateWithPasskey), arg0, arg1, arg2, arg3, arg4)
 }
 
 // Delete mocks base method.
-func (m *MockSessionUsecase) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+func (m *MockSessionUsecase) Delete(arg0 context.Context, arg1, arg2 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockSessionUsecaseMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+func (mr *MockSessionUsecaseMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSessionUsecase)(nil).Delete), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockSessionUsecase) GetAll(arg0 context.Context, arg1 uuid.UUID) ([]*dto.SessionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*dto.SessionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSessionUsecaseMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSessionUsecase)(nil).GetAll), arg0, arg1)
+}
+
+// Refresh mocks base method.
+func (m *MockSessionUsecase) Refresh(arg0 context.Context, arg1 string) (*dto.SessionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", arg0, arg1)
+	ret0, _ := ret[0].(*dto.SessionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockSessionUsecaseMockRecorder) Refresh(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSessionUsecase)(nil).Delete), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockSessionUsecase)(nil).Refresh), arg0, arg1)
 }
 
 // Verify mocks base method.
-func (m *MockSessionUsecase) Verify(arg0 context.Context, arg1 string) (*dto.AccountDTO, error) {
+func (m *MockSessionUsecase) Verify(arg0 context.Context, arg1 string) (*dto.VerifiedSessionDTO, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Verify", arg0, arg1)
-	ret0, _ := ret[0].(*dto.AccountDTO)
+	ret0, _ := ret[0].(*dto.VerifiedSessionDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
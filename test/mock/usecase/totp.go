@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: totp.go
+//
+// Generated by this command:
+//
+//	mockgen -source=totp.go -package=usecase -destination=../../../../test/mock/usecase/totp.go
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/atsumarukun/holos-account-api/internal/app/api/usecase/dto"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTOTPUsecase is a mock of TOTPUsecase interface.
+type MockTOTPUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTOTPUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockTOTPUsecaseMockRecorder is the mock recorder for MockTOTPUsecase.
+type MockTOTPUsecaseMockRecorder struct {
+	mock *MockTOTPUsecase
+}
+
+// NewMockTOTPUsecase creates a new mock instance.
+func NewMockTOTPUsecase(ctrl *gomock.Controller) *MockTOTPUsecase {
+	mock := &MockTOTPUsecase{ctrl: ctrl}
+	mock.recorder = &MockTOTPUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTOTPUsecase) EXPECT() *MockTOTPUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockTOTPUsecase) Confirm(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockTOTPUsecaseMockRecorder) Confirm(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockTOTPUsecase)(nil).Confirm), arg0, arg1, arg2)
+}
+
+// Disable mocks base method.
+func (m *MockTOTPUsecase) Disable(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disable indicates an expected call of Disable.
+func (mr *MockTOTPUsecaseMockRecorder) Disable(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockTOTPUsecase)(nil).Disable), arg0, arg1, arg2)
+}
+
+// Enroll mocks base method.
+func (m *MockTOTPUsecase) Enroll(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*dto.TOTPEnrollmentDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enroll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.TOTPEnrollmentDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enroll indicates an expected call of Enroll.
+func (mr *MockTOTPUsecaseMockRecorder) Enroll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enroll", reflect.TypeOf((*MockTOTPUsecase)(nil).Enroll), arg0, arg1, arg2)
+}